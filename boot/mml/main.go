@@ -1,4799 +1,6517 @@
 // Generated code
 package main
 
-import "github.com/aryszka/mml"
-var _args interface{} = mml.Args;
-var _close interface{} = mml.Close;
-var _error interface{} = mml.Error;
-var _format interface{} = mml.Format;
-var _has interface{} = mml.Has;
-var _isBool interface{} = mml.IsBool;
-var _isError interface{} = mml.IsError;
-var _isFloat interface{} = mml.IsFloat;
-var _isInt interface{} = mml.IsInt;
-var _isString interface{} = mml.IsString;
-var _keys interface{} = mml.Keys;
-var _len interface{} = mml.Len;
-var _open interface{} = mml.Open;
-var _panic interface{} = mml.Panic;
-var _parseAST interface{} = mml.ParseAST;
-var _parseFloat interface{} = mml.ParseFloat;
-var _parseInt interface{} = mml.ParseInt;
-var _stderr interface{} = mml.Stderr;
-var _stdin interface{} = mml.Stdin;
-var _stdout interface{} = mml.Stdout;
-var _string interface{} = mml.String
-func init() {
-	var modulePath string
-modulePath = "main.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
+import (
+	"github.com/aryszka/mml"
+	__interop_github_46_com_47_aryszka_47_mml_47_errors "github.com/aryszka/mml/errors"
+	__interop_github_46_com_47_aryszka_47_mml_47_goast "github.com/aryszka/mml/goast"
+	__interop_github_46_com_47_aryszka_47_mml_47_interpret "github.com/aryszka/mml/interpret"
+	__interop_github_46_com_47_aryszka_47_mml_47_os "github.com/aryszka/mml/os"
+)
 
-		var c interface{}
-		mml.Nop(c)
-var _printValidationErrors interface{};
-var _validateDefinitions interface{};
-var _compileModuleCode interface{};
-var _compileModules interface{};
-var _modules interface{};
-var _validation interface{};
-var _builtins interface{};
-var _code interface{};
-var _parse interface{};
-var _definitions interface{};
-var _snippets interface{};
-var _compile interface{};
-var _fold interface{};
-var _foldr interface{};
-var _map interface{};
-var _filter interface{};
-var _contains interface{};
-var _sort interface{};
-var _flat interface{};
-var _uniq interface{};
-var _join interface{};
-var _joins interface{};
-var _formats interface{};
-var _enum interface{};
-var _log interface{};
-var _onlyErr interface{};
-var _passErr interface{};
-mml.Nop(_printValidationErrors, _validateDefinitions, _compileModuleCode, _compileModules, _modules, _validation, _builtins, _code, _parse, _definitions, _snippets, _compile, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _uniq, _join, _joins, _formats, _enum, _log, _onlyErr, _passErr);
-var __lang = mml.Modules.Use("lang.mml");;_fold = __lang.Values["fold"];
-_foldr = __lang.Values["foldr"];
-_map = __lang.Values["map"];
-_filter = __lang.Values["filter"];
-_contains = __lang.Values["contains"];
-_sort = __lang.Values["sort"];
-_flat = __lang.Values["flat"];
-_uniq = __lang.Values["uniq"];
-_join = __lang.Values["join"];
-_joins = __lang.Values["joins"];
-_formats = __lang.Values["formats"];
-_enum = __lang.Values["enum"];
-_log = __lang.Values["log"];
-_onlyErr = __lang.Values["onlyErr"];
-_passErr = __lang.Values["passErr"];
-_code = mml.Modules.Use("code.mml");
-_parse = mml.Modules.Use("parse.mml");
-_definitions = mml.Modules.Use("definitions.mml");
-_snippets = mml.Modules.Use("snippets.mml");
-_compile = mml.Modules.Use("compile.mml");
-_printValidationErrors = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0];
-var _errors = a[1];
-				;
-				mml.Nop(_m, _errors);
-				;
-mml.Nop();
-_log.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s:", mml.Ref(_m, "path"))}).Values))}).Values);
-for _, _e := range _errors.(*mml.List).Values {
-;
-mml.Nop();
-_log.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _e)}).Values)
-};
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_validateDefinitions = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _modules = a[0];
-				;
-				mml.Nop(_modules);
-				var _hasErrors interface{};
-mml.Nop(_hasErrors);
-_hasErrors = false;
-for _, _m := range _modules.(*mml.List).Values {
-var _errors interface{};
-mml.Nop(_errors);
-_errors = mml.Ref(_definitions, "validate").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _m)}).Values);
-c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _errors)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-_hasErrors = true;
-_printValidationErrors.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _m, _errors)}).Values) }
-};
-c = _hasErrors; if c.(bool) { ;
-mml.Nop();
-return _error.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "undefined reference(s) found")}).Values) };
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_compileModuleCode = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _moduleCode = a[0];
-				;
-				mml.Nop(_moduleCode);
-				;
-mml.Nop();
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "modulePath = \"%s\"", mml.Ref(_moduleCode, "path"))}).Values))}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_snippets, "moduleHead"))}).Values);
-_onlyErr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _log)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _passErr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _stdout)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_compile, "do").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _moduleCode)}).Values))}).Values))}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_snippets, "moduleFooter"))}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_compileModules = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0];
-				;
-				mml.Nop(_m);
-				;
-mml.Nop();
-for _, _mi := range _m.(*mml.List).Values {
-;
-mml.Nop();
-_compileModuleCode.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _mi)}).Values)
-};
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_modules = mml.Ref(_parse, "modules").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_args, 1))}).Values);
-c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _modules)}).Values); if c.(bool) { ;
-mml.Nop();
-_panic.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _modules)}).Values) };
-_validation = _validateDefinitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _modules)}).Values);
-c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _validation)}).Values); if c.(bool) { ;
-mml.Nop();
-_panic.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _validation)}).Values) };
-_builtins = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _k = a[0];
-				;
-				mml.Nop(_k);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var _%s interface{} = mml.%s", _k, mml.Ref(mml.Ref(_code, "builtin"), _k))}).Values)
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _sort.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _left = a[0];
-var _right = a[1];
-				;
-				mml.Nop(_left, _right);
-				return mml.BinaryOp(13, _left, _right)
-			},
-			FixedArgs: 2,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _keys.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_code, "builtin"))}).Values))}).Values))}).Values))}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_snippets, "head"))}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _builtins)}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_snippets, "initHead"))}).Values);
-_compileModules.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _modules)}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_snippets, "initFooter"))}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_snippets, "mainHead"))}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_args, 1))}).Values);
-_stdout.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_snippets, "mainFooter"))}).Values)
+var (
+	_len            interface{} = mml.Len
+	_runeLen        interface{} = mml.RuneLen
+	_runes          interface{} = mml.Runes
+	_runeAt         interface{} = mml.RuneAt
+	_runeSlice      interface{} = mml.RuneSlice
+	_codePoints     interface{} = mml.CodePoints
+	_fromCodePoints interface{} = mml.FromCodePoints
+	_bytes          interface{} = mml.Bytes
+	_fromBytes      interface{} = mml.FromBytes
+	_isError        interface{} = mml.IsError
+	_keys           interface{} = mml.Keys
+	_format         interface{} = mml.Format
+	_stdin          interface{} = mml.Stdin
+	_stdout         interface{} = mml.Stdout
+	_stderr         interface{} = mml.Stderr
+	_string         interface{} = mml.String
+	_has            interface{} = mml.Has
+	_chan           interface{} = mml.Chan
+	_bufchan        interface{} = mml.BufChan
+	_isBool         interface{} = mml.IsBool
+	_isInt          interface{} = mml.IsInt
+	_isFloat        interface{} = mml.IsFloat
+	_isString       interface{} = mml.IsString
+	_error          interface{} = mml.Error
+	_panic          interface{} = mml.Panic
+	_open           interface{} = mml.Open
+	_create         interface{} = mml.Create
+	_openAppend     interface{} = mml.OpenAppend
+	_readLine       interface{} = mml.ReadLine
+	_readAll        interface{} = mml.ReadAll
+	_read           interface{} = mml.Read
+	_write          interface{} = mml.Write
+	_close          interface{} = mml.Close
+	_eof            interface{} = mml.EOF
+	_exec           interface{} = mml.Exec
+	_args           interface{} = mml.Args
+	_parseAST       interface{} = mml.ParseAST
+	_parseInt       interface{} = mml.ParseInt
+	_parseFloat     interface{} = mml.ParseFloat
+)
+
+func main() {
+	mml.Modules.Use("main.mml")
+}
+func init() {
+	mml.Modules.Set("main.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _printValidationErrors interface{}
+		var _validateDefinitions interface{}
+		var _usage string
+		var _imports interface{}
+		var _moduleInit interface{}
+		var _render interface{}
+		var _baseName interface{}
+		var _goMod interface{}
+		var _writeFile interface{}
+		var _load interface{}
+		var _mainDecls interface{}
+		var _renderFile interface{}
+		var _moduleFile interface{}
+		var _relativePath interface{}
+		var _writeDir interface{}
+		var _generate interface{}
+		var _check interface{}
+		var _binaryName interface{}
+		var _modulesEnabled interface{}
+		var _goCommand interface{}
+		var _build interface{}
+		var _run interface{}
+		var _isScript interface{}
+		var _interpretFile interface{}
+		var _exit interface{}
+		var _code interface{}
+		var _parse interface{}
+		var _definitions interface{}
+		var _snippets interface{}
+		var _compile interface{}
+		var _types interface{}
+		var _goast interface{}
+		var _os interface{}
+		var _interpret interface{}
+		var _repl interface{}
+		var _map interface{}
+		var _flat interface{}
+		var _uniq interface{}
+		var _join interface{}
+		var _formats interface{}
+		var _log interface{}
+		var __lang = loader.Use("lang.mml")
+		_map = mml.Ref(mml.Pos{Path: "main.mml", Line: 2, Column: 2}, __lang, "map")
+		_flat = mml.Ref(mml.Pos{Path: "main.mml", Line: 2, Column: 2}, __lang, "flat")
+		_uniq = mml.Ref(mml.Pos{Path: "main.mml", Line: 2, Column: 2}, __lang, "uniq")
+		_join = mml.Ref(mml.Pos{Path: "main.mml", Line: 2, Column: 2}, __lang, "join")
+		_formats = mml.Ref(mml.Pos{Path: "main.mml", Line: 2, Column: 2}, __lang, "formats")
+		_log = mml.Ref(mml.Pos{Path: "main.mml", Line: 2, Column: 2}, __lang, "log")
+		_code = loader.Use("code.mml")
+		_parse = loader.Use("parse.mml")
+		_definitions = loader.Use("definitions.mml")
+		_snippets = loader.Use("snippets.mml")
+		_compile = loader.Use("compile.mml")
+		_types = loader.Use("types.mml")
+		_goast = loader.Use("goast.mml")
+		_os = loader.Use("os.mml")
+		_interpret = loader.Use("interpret.mml")
+		_repl = loader.Use("repl.mml")
+//line main.mml:15:1
+		_printValidationErrors = &mml.Function{F: func(a []interface{}) interface{} {
+			var _m = a[0]
+			var _errors = a[1]
+//line main.mml:16:2
+			_log.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 16, Column: 2}, []interface{}{_formats.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 16, Column: 6}, []interface{}{"%s:", mml.Ref(mml.Pos{Path: "main.mml", Line: 16, Column: 21}, _m, "path")})})
+//line main.mml:17:2
+			for __iter := mml.Iterate(mml.Pos{Path: "main.mml", Line: 17, Column: 6}, _errors); __iter.Next(); {
+				_e := __iter.Value()
+//line main.mml:18:3
+				_log.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 18, Column: 3}, []interface{}{_e})
+			}
+			return nil
+		}, FixedArgs: 2}
+//line main.mml:22:1
+		_validateDefinitions = &mml.Function{F: func(a []interface{}) interface{} {
+			var _modules = a[0]
+			var _hasErrors bool
+//line main.mml:23:2
+			_hasErrors = false
+//line main.mml:24:2
+			for __iter := mml.Iterate(mml.Pos{Path: "main.mml", Line: 24, Column: 6}, _modules); __iter.Next(); {
+				_m := __iter.Value()
+				var _errors interface{}
+//line main.mml:25:3
+				_errors = mml.Ref(mml.Pos{Path: "main.mml", Line: 25, Column: 14}, _definitions, "validate").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 25, Column: 14}, []interface{}{_m})
+//line main.mml:26:3
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 26, Column: 6}, []interface{}{_errors}).(int) > 0) {
+//line main.mml:27:4
+					_hasErrors = true
+//line main.mml:28:4
+					_printValidationErrors.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 28, Column: 4}, []interface{}{_m, _errors})
+				}
+			}
+//line main.mml:32:2
+			if _hasErrors {
+//line main.mml:33:3
+				return _error.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 33, Column: 10}, []interface{}{"undefined reference(s) found"})
+			}
+			return nil
+		}, FixedArgs: 1}
+//line main.mml:49:1
+		_usage = "usage: mml | mml [-o dir] main.mml | mml check main.mml | mml build [-o binary] main.mml | mml run main.mml [args...] | mml interpret main.mml [args...] | mml script.mml [args...]"
+//line main.mml:51:1
+		_imports = &mml.Function{F: func(a []interface{}) interface{} {
+			var _modules = a[0]
+			var _interop interface{}
+//line main.mml:52:2
+			_interop = _map.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 57, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _p = a[0]
+//line main.mml:57:16
+				return mml.Ref(mml.Pos{Path: "main.mml", Line: 57, Column: 16}, _goast, "importSpec").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 57, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 57, Column: 33}, _code, "interopAlias").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 57, Column: 33}, []interface{}{_p}), _p})
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 52, Column: 14}, []interface{}{_uniq.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 56, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _left = a[0]
+				var _right = a[1]
+//line main.mml:56:27
+				return mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 56, Column: 27}, 11, _left, _right)
+			}, FixedArgs: 2}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 55, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _i = a[0]
+//line main.mml:55:16
+				return mml.Ref(mml.Pos{Path: "main.mml", Line: 55, Column: 16}, mml.Ref(mml.Pos{}, _i, "args"), 0)
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_flat.(*mml.Function).CallAt(mml.Pos{}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 53, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _m = a[0]
+//line main.mml:53:16
+				return mml.Ref(mml.Pos{Path: "main.mml", Line: 53, Column: 16}, _code, "findCode").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 53, Column: 16}, []interface{}{"interop", mml.Ref(mml.Pos{Path: "main.mml", Line: 53, Column: 41}, _m, "statements")})
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_modules})})})})})
+//line main.mml:59:2
+			return mml.NewList(mml.Ref(mml.Pos{Path: "main.mml", Line: 59, Column: 10}, _goast, "importSpec").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 59, Column: 10}, []interface{}{"", "github.com/aryszka/mml"})).Concat(_interop.(*mml.List))
+		}, FixedArgs: 1}
+//line main.mml:62:1
+		_moduleInit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _m = a[0]
+//line main.mml:62:18
+			return mml.Ref(mml.Pos{Path: "main.mml", Line: 62, Column: 18}, _goast, "funcDecl").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 62, Column: 18}, []interface{}{"init", mml.Ref(mml.Pos{Path: "main.mml", Line: 62, Column: 41}, _snippets, "moduleInit").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 62, Column: 41}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 62, Column: 61}, _m, "path"), mml.Ref(mml.Pos{Path: "main.mml", Line: 62, Column: 69}, _compile, "do").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 62, Column: 69}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 62, Column: 80}, _types, "annotate").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 62, Column: 80}, []interface{}{_m})})})})
+		}, FixedArgs: 1}
+//line main.mml:64:1
+		_render = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _imports = a[1]
+			var _decls = a[2]
+//line main.mml:64:33
+			return mml.Ref(mml.Pos{Path: "main.mml", Line: 64, Column: 33}, _goast, "render").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 64, Column: 33}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 64, Column: 46}, _goast, "file").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 64, Column: 46}, []interface{}{"main", _imports, _decls}), _name})
+		}, FixedArgs: 3}
+//line main.mml:66:1
+		_baseName = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _name interface{}
+			var _trail bool
+//line main.mml:67:2
+			_name = ""
+			_trail = true
+//line main.mml:72:2
+			for _i := 0; _i < _len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 72, Column: 12}, []interface{}{_path}).(int); _i++ {
+				var _c interface{}
+//line main.mml:73:3
+				_c = mml.Ref(mml.Pos{Path: "main.mml", Line: 73, Column: 9}, _path, ((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 73, Column: 14}, []interface{}{_path}).(int) - 1) - _i))
+//line main.mml:74:3
+				if (mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 74, Column: 6}, 11, _c, "/").(bool) && !_trail) {
+//line main.mml:75:4
+					return _name
+				}
+//line main.mml:78:3
+				_trail = (_trail && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 78, Column: 20}, 11, _c, "/").(bool))
+//line main.mml:79:3
+				_name = func() interface{} {
+					if _trail {
+						return _name
+					}
+					return mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 79, Column: 25}, 9, _c, _name)
+				}()
+			}
+//line main.mml:82:2
+			return _name
+		}, FixedArgs: 1}
+//line main.mml:87:1
+		_goMod = &mml.Function{F: func(a []interface{}) interface{} {
+			var _dir = a[0]
+			var _runtime = a[1]
+			var _name interface{}
+			var _module interface{}
+//line main.mml:88:2
+			_name = _baseName.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 88, Column: 11}, []interface{}{_dir})
+//line main.mml:89:2
+			_module = _formats.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 89, Column: 13}, []interface{}{"module %s\n\ngo 1.21\n", func() interface{} {
+				if ((mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 89, Column: 47}, 11, _name, "").(bool) || mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 89, Column: 61}, 11, _name, ".").(bool)) || mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 89, Column: 76}, 11, _name, "..").(bool)) {
+					return "main"
+				}
+				return _name
+			}()})
+//line main.mml:90:2
+			switch {
+			case mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 91, Column: 7}, 12, mml.Ref(mml.Pos{Path: "main.mml", Line: 91, Column: 7}, _runtime, "version"), ""):
+//line main.mml:92:3
+				return mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 92, Column: 10}, 9, _module, _formats.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 92, Column: 19}, []interface{}{"\nrequire %s %s\n", mml.Ref(mml.Pos{Path: "main.mml", Line: 92, Column: 48}, _runtime, "module"), mml.Ref(mml.Pos{Path: "main.mml", Line: 92, Column: 64}, _runtime, "version")}))
+			case mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 93, Column: 7}, 12, mml.Ref(mml.Pos{Path: "main.mml", Line: 93, Column: 7}, _runtime, "dir"), ""):
+//line main.mml:94:3
+				return mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 94, Column: 10}, 9, _module, _formats.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 94, Column: 19}, []interface{}{"\nrequire %s v0.0.0\n\nreplace %s => %s\n", mml.Ref(mml.Pos{Path: "main.mml", Line: 96, Column: 4}, _runtime, "module"), mml.Ref(mml.Pos{Path: "main.mml", Line: 97, Column: 4}, _runtime, "module"), mml.Ref(mml.Pos{Path: "main.mml", Line: 98, Column: 4}, _runtime, "dir")}))
+			default:
+//line main.mml:101:3
+				return _module
+			}
+			return nil
+		}, FixedArgs: 2}
+//line main.mml:105:1
+		_writeFile = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _content = a[1]
+			var _f interface{}
+//line main.mml:106:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 106, Column: 5}, []interface{}{_content}).(bool) {
+//line main.mml:107:3
+				return _content
+			}
+//line main.mml:110:2
+			_f = _create.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 110, Column: 8}, []interface{}{_path})
+//line main.mml:111:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 111, Column: 5}, []interface{}{_f}).(bool) {
+//line main.mml:112:3
+				return _f
+			}
+//line main.mml:115:2
+			defer _close.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 115, Column: 8}, []interface{}{_f})
+//line main.mml:116:2
+			return _write.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 116, Column: 9}, []interface{}{_f, _content})
+		}, FixedArgs: 2}
+//line main.mml:119:1
+		_load = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _modules interface{}
+			var _validation interface{}
+//line main.mml:120:2
+			_modules = mml.Ref(mml.Pos{Path: "main.mml", Line: 120, Column: 14}, _parse, "modules").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 120, Column: 14}, []interface{}{_path})
+//line main.mml:121:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 121, Column: 5}, []interface{}{_modules}).(bool) {
+//line main.mml:122:3
+				return _modules
+			}
+//line main.mml:125:2
+			_validation = _validateDefinitions.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 125, Column: 17}, []interface{}{_modules})
+//line main.mml:126:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 126, Column: 5}, []interface{}{_validation}).(bool) {
+//line main.mml:127:3
+				return _validation
+			}
+//line main.mml:130:2
+			return _modules
+		}, FixedArgs: 1}
+//line main.mml:133:1
+		_mainDecls = &mml.Function{F: func(a []interface{}) interface{} {
+			var _mainPath = a[0]
+			var _builtins interface{}
+//line main.mml:134:2
+			_builtins = _map.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 136, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _k = a[0]
+//line main.mml:136:16
+				return mml.Ref(mml.Pos{Path: "main.mml", Line: 136, Column: 16}, _goast, "declareTyped").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 136, Column: 16}, []interface{}{mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 136, Column: 35}, 9, "_", _k), "interface{}", mml.Ref(mml.Pos{Path: "main.mml", Line: 136, Column: 59}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 136, Column: 59}, []interface{}{"mml", mml.Ref(mml.Pos{Path: "main.mml", Line: 136, Column: 81}, mml.Ref(mml.Pos{}, _code, "builtin"), _k)})})
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 134, Column: 15}, []interface{}{_keys.(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 134, Column: 15}, _code, "builtin")})})
+//line main.mml:138:2
+			return mml.NewList().Concat(_builtins.(*mml.List)).Append(mml.Ref(mml.Pos{Path: "main.mml", Line: 138, Column: 23}, _snippets, "main").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 138, Column: 23}, []interface{}{_mainPath}))
+		}, FixedArgs: 1}
+//line main.mml:141:1
+		_renderFile = &mml.Function{F: func(a []interface{}) interface{} {
+			var _modules = a[0]
+			var _mainPath = a[1]
+//line main.mml:141:34
+			return _render.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 141, Column: 34}, []interface{}{"main.go", _imports.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 141, Column: 52}, []interface{}{_modules}), mml.NewList().Concat(_mainDecls.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 141, Column: 71}, []interface{}{_mainPath}).(*mml.List)).Concat(_map.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 141, Column: 95}, []interface{}{_moduleInit, _modules}).(*mml.List))})
+		}, FixedArgs: 2}
+//line main.mml:143:1
+		_moduleFile = &mml.Function{F: func(a []interface{}) interface{} {
+			var _m = a[0]
+			var _name = a[1]
+//line main.mml:143:24
+			return mml.NewStruct(nil).With("path", _name).With("content", _render.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 145, Column: 11}, []interface{}{_name, _imports.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 145, Column: 24}, []interface{}{mml.NewList(_m)}), mml.NewList(_moduleInit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 145, Column: 39}, []interface{}{_m}))}))
+		}, FixedArgs: 2}
+//line main.mml:149:1
+		_relativePath = &mml.Function{F: func(a []interface{}) interface{} {
+			var _dir = a[0]
+			var _path = a[1]
+//line main.mml:149:28
+			return func() interface{} {
+				if ((mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 149, Column: 28}, 12, _dir, "").(bool) && (_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 149, Column: 41}, []interface{}{_path}).(int) > _len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 149, Column: 53}, []interface{}{_dir}).(int))) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 149, Column: 65}, 11, mml.RefRange(mml.Pos{Path: "main.mml", Line: 149, Column: 65}, _path, nil, (_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 149, Column: 71}, []interface{}{_dir}).(int)+1)), mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 149, Column: 88}, 9, _dir, "/")).(bool)) {
+					return mml.RefRange(mml.Pos{Path: "main.mml", Line: 150, Column: 2}, _path, (_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 150, Column: 7}, []interface{}{_dir}).(int) + 1), nil)
+				}
+				return _path
+			}()
+		}, FixedArgs: 2}
+//line main.mml:154:1
+		_writeDir = &mml.Function{F: func(a []interface{}) interface{} {
+			var _outputDir = a[0]
+			var _modules = a[1]
+			var _mainPath = a[2]
+			var _created interface{}
+			var _names interface{}
+			var _files interface{}
+//line main.mml:155:2
+			_created = mml.Ref(mml.Pos{Path: "main.mml", Line: 155, Column: 14}, _os, "mkdir").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 155, Column: 14}, []interface{}{_outputDir})
+//line main.mml:156:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 156, Column: 5}, []interface{}{_created}).(bool) {
+//line main.mml:157:3
+				return _created
+			}
+//line main.mml:160:2
+			_names = mml.Ref(mml.Pos{Path: "main.mml", Line: 162, Column: 5}, _code, "goFileNames").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 160, Column: 12}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 161, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _m = a[0]
+//line main.mml:161:16
+				return _relativePath.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 161, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 161, Column: 29}, _code, "dirName").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 161, Column: 29}, []interface{}{_mainPath}), mml.Ref(mml.Pos{Path: "main.mml", Line: 161, Column: 53}, _m, "path")})
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_modules})})
+//line main.mml:163:2
+			_files = mml.NewList(mml.NewStruct(nil).With("path", "go.mod").With("content", _goMod.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 164, Column: 29}, []interface{}{_outputDir, mml.Ref(mml.Pos{Path: "main.mml", Line: 164, Column: 46}, _os, "runtime").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 164, Column: 46}, []interface{}{})})), mml.NewStruct(nil).With("path", "main.go").With("content", _render.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 165, Column: 30}, []interface{}{"main.go", _imports.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 165, Column: 48}, []interface{}{mml.NewList()}), _mainDecls.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 165, Column: 61}, []interface{}{_mainPath})})))
+//line main.mml:168:2
+			for _i := 0; _i < _len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 168, Column: 12}, []interface{}{_modules}).(int); _i++ {
+//line main.mml:169:3
+				_files = mml.NewList().Concat(_files.(*mml.List)).Append(_moduleFile.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 169, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 169, Column: 33}, _modules, _i), mml.Ref(mml.Pos{Path: "main.mml", Line: 169, Column: 45}, _names, _i)}))
+			}
+//line main.mml:172:2
+			for __iter := mml.Iterate(mml.Pos{Path: "main.mml", Line: 172, Column: 6}, _files); __iter.Next(); {
+				_f := __iter.Value()
+				var _written interface{}
+//line main.mml:173:3
+				_written = _writeFile.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 173, Column: 15}, []interface{}{mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 173, Column: 25}, 9, mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 173, Column: 25}, 9, _outputDir, "/"), mml.Ref(mml.Pos{Path: "main.mml", Line: 173, Column: 43}, _f, "path")), mml.Ref(mml.Pos{Path: "main.mml", Line: 173, Column: 51}, _f, "content")})
+//line main.mml:174:3
+				if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 174, Column: 6}, []interface{}{_written}).(bool) {
+//line main.mml:175:4
+					return _written
+				}
+			}
+			return nil
+		}, FixedArgs: 3}
+//line main.mml:181:1
+		_generate = &mml.Function{F: func(a []interface{}) interface{} {
+			var _outputDir = a[0]
+			var _mainPath = a[1]
+			var _modules interface{}
+//line main.mml:182:2
+			_modules = _load.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 182, Column: 14}, []interface{}{_mainPath})
+//line main.mml:183:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 183, Column: 5}, []interface{}{_modules}).(bool) {
+//line main.mml:184:3
+				return _modules
+			}
+//line main.mml:187:2
+			if mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 187, Column: 5}, 11, _outputDir, "").(bool) {
+				var _generated interface{}
+//line main.mml:188:3
+				_generated = _renderFile.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 188, Column: 17}, []interface{}{_modules, _mainPath})
+//line main.mml:189:3
+				return func() interface{} {
+					if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 189, Column: 10}, []interface{}{_generated}).(bool) {
+						return _generated
+					}
+					return _stdout.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 189, Column: 43}, []interface{}{_generated})
+				}()
+			}
+//line main.mml:192:2
+			return _writeDir.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 192, Column: 9}, []interface{}{_outputDir, _modules, _mainPath})
+		}, FixedArgs: 2}
+//line main.mml:195:1
+		_check = &mml.Function{F: func(a []interface{}) interface{} {
+			var _mainPath = a[0]
+			var _modules interface{}
+//line main.mml:196:2
+			_modules = _load.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 196, Column: 14}, []interface{}{_mainPath})
+//line main.mml:197:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 197, Column: 5}, []interface{}{_modules}).(bool) {
+//line main.mml:198:3
+				return _modules
+			}
+			return nil
+		}, FixedArgs: 1}
+//line main.mml:202:1
+		_binaryName = &mml.Function{F: func(a []interface{}) interface{} {
+			var _mainPath = a[0]
+			var _name interface{}
+//line main.mml:203:2
+			_name = _baseName.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 203, Column: 11}, []interface{}{_mainPath})
+//line main.mml:204:2
+			return func() interface{} {
+				if ((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 204, Column: 9}, []interface{}{_name}).(int) > 4) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 204, Column: 26}, 11, mml.RefRange(mml.Pos{Path: "main.mml", Line: 204, Column: 26}, _name, (_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 204, Column: 31}, []interface{}{_name}).(int)-4), nil), ".mml").(bool)) {
+					return mml.RefRange(mml.Pos{Path: "main.mml", Line: 204, Column: 59}, _name, nil, (_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 204, Column: 65}, []interface{}{_name}).(int) - 4))
+				}
+				return _name
+			}()
+		}, FixedArgs: 1}
+//line main.mml:208:1
+		_modulesEnabled = &mml.Function{F: func(a []interface{}) interface{} {
+			var _p interface{}
+			var _mode interface{}
+			var _waited interface{}
+//line main.mml:209:2
+			_p = _exec.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 209, Column: 8}, []interface{}{mml.NewList("go", "env", "GO111MODULE")})
+//line main.mml:210:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 210, Column: 5}, []interface{}{_p}).(bool) {
+//line main.mml:211:3
+				return _p
+			}
+//line main.mml:214:2
+			_mode = _readAll.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 214, Column: 11}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 214, Column: 19}, _p, "stdout")})
+//line main.mml:215:2
+			_waited = mml.Ref(mml.Pos{Path: "main.mml", Line: 215, Column: 13}, _p, "wait").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 215, Column: 13}, []interface{}{})
+//line main.mml:216:2
+			switch {
+			case _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 217, Column: 7}, []interface{}{_mode}):
+//line main.mml:218:3
+				return _mode
+			case _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 219, Column: 7}, []interface{}{_waited}):
+//line main.mml:220:3
+				return _waited
+			default:
+//line main.mml:222:3
+				return mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 222, Column: 10}, 12, _mode, "off\n")
+			}
+			return nil
+		}, FixedArgs: 0}
+//line main.mml:226:1
+		_goCommand = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _dir = a[1]
+			var _args = a[2]
+			var _status interface{}
+//line main.mml:227:2
+			_status = mml.Ref(mml.Pos{Path: "main.mml", Line: 227, Column: 13}, _os, "run").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 227, Column: 13}, []interface{}{mml.NewList("go").Concat(_name.(*mml.List)).Append("-C", _dir).Concat(_args.(*mml.List))})
+//line main.mml:228:2
+			if (_isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 228, Column: 5}, []interface{}{_status}).(bool) || mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 228, Column: 24}, 11, _status, 0).(bool)) {
+//line main.mml:229:3
+				return _status
+			}
+//line main.mml:232:2
+			return _error.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 232, Column: 9}, []interface{}{_formats.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 232, Column: 15}, []interface{}{"go %s failed", _join.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 232, Column: 39}, []interface{}{" ", _name})})})
+		}, FixedArgs: 3}
+//line main.mml:237:1
+		_build = &mml.Function{F: func(a []interface{}) interface{} {
+			var _output = a[0]
+			var _mainPath = a[1]
+			var _modules interface{}
+			var _binary interface{}
+			var _dir interface{}
+			var _written interface{}
+			var _modulesOn interface{}
+			var _runtime interface{}
+//line main.mml:238:2
+			_modules = _load.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 238, Column: 14}, []interface{}{_mainPath})
+//line main.mml:239:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 239, Column: 5}, []interface{}{_modules}).(bool) {
+//line main.mml:240:3
+				return _modules
+			}
+//line main.mml:243:2
+			_binary = mml.Ref(mml.Pos{Path: "main.mml", Line: 243, Column: 13}, _os, "abs").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 243, Column: 13}, []interface{}{_output})
+//line main.mml:244:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 244, Column: 5}, []interface{}{_binary}).(bool) {
+//line main.mml:245:3
+				return _binary
+			}
+//line main.mml:248:2
+			_dir = mml.Ref(mml.Pos{Path: "main.mml", Line: 248, Column: 10}, _os, "tempDir").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 248, Column: 10}, []interface{}{})
+//line main.mml:249:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 249, Column: 5}, []interface{}{_dir}).(bool) {
+//line main.mml:250:3
+				return _dir
+			}
+//line main.mml:253:2
+			defer mml.Ref(mml.Pos{Path: "main.mml", Line: 253, Column: 8}, _os, "remove").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 253, Column: 8}, []interface{}{_dir})
+//line main.mml:255:2
+			_written = _writeDir.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 255, Column: 14}, []interface{}{_dir, _modules, _mainPath})
+//line main.mml:256:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 256, Column: 5}, []interface{}{_written}).(bool) {
+//line main.mml:257:3
+				return _written
+			}
+//line main.mml:260:2
+			_modulesOn = _modulesEnabled.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 260, Column: 16}, []interface{}{})
+//line main.mml:261:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 261, Column: 5}, []interface{}{_modulesOn}).(bool) {
+//line main.mml:262:3
+				return _modulesOn
+			}
+//line main.mml:265:2
+			_runtime = mml.Ref(mml.Pos{Path: "main.mml", Line: 265, Column: 14}, _os, "runtime").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 265, Column: 14}, []interface{}{})
+//line main.mml:266:2
+			if ((_modulesOn.(bool) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 266, Column: 18}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 266, Column: 18}, _runtime, "version"), "").(bool)) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 266, Column: 43}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 266, Column: 43}, _runtime, "dir"), "").(bool)) {
+//line main.mml:267:3
+				return _error.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 267, Column: 10}, []interface{}{_formats.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 267, Column: 16}, []interface{}{mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 268, Column: 4}, 9, "unknown version of the runtime, %s, that mml was built with: build in GOPATH mode, ", "or generate the code with -o, and add the dependency to its go.mod"), mml.Ref(mml.Pos{Path: "main.mml", Line: 270, Column: 4}, _runtime, "module")})})
+			}
+//line main.mml:274:2
+			if _modulesOn.(bool) {
+				var _tidy interface{}
+//line main.mml:275:3
+				_tidy = _goCommand.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 275, Column: 12}, []interface{}{mml.NewList("mod", "tidy"), _dir, mml.NewList()})
+//line main.mml:276:3
+				if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 276, Column: 6}, []interface{}{_tidy}).(bool) {
+//line main.mml:277:4
+					return _tidy
+				}
+			}
+//line main.mml:281:2
+			return _goCommand.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 281, Column: 9}, []interface{}{mml.NewList("build"), _dir, mml.NewList("-o", _binary, ".")})
+		}, FixedArgs: 2}
+//line main.mml:284:1
+		_run = &mml.Function{F: func(a []interface{}) interface{} {
+			var _mainPath = a[0]
+			var _programArgs = a[1]
+			var _dir interface{}
+			var _binary interface{}
+			var _built interface{}
+//line main.mml:285:2
+			_dir = mml.Ref(mml.Pos{Path: "main.mml", Line: 285, Column: 10}, _os, "tempDir").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 285, Column: 10}, []interface{}{})
+//line main.mml:286:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 286, Column: 5}, []interface{}{_dir}).(bool) {
+//line main.mml:287:3
+				return _dir
+			}
+//line main.mml:290:2
+			defer mml.Ref(mml.Pos{Path: "main.mml", Line: 290, Column: 8}, _os, "remove").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 290, Column: 8}, []interface{}{_dir})
+//line main.mml:292:2
+			_binary = mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 292, Column: 13}, 9, mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 292, Column: 13}, 9, _dir, "/"), _binaryName.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 292, Column: 25}, []interface{}{_mainPath}))
+//line main.mml:293:2
+			_built = _build.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 293, Column: 12}, []interface{}{_binary, _mainPath})
+//line main.mml:294:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 294, Column: 5}, []interface{}{_built}).(bool) {
+//line main.mml:295:3
+				return _built
+			}
+//line main.mml:298:2
+			return mml.Ref(mml.Pos{Path: "main.mml", Line: 298, Column: 9}, _os, "run").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 298, Column: 9}, []interface{}{mml.NewList(_binary).Concat(_programArgs.(*mml.List))})
+		}, FixedArgs: 2}
+//line main.mml:302:1
+		_isScript = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _f interface{}
+//line main.mml:303:2
+			_f = _open.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 303, Column: 8}, []interface{}{_path})
+//line main.mml:304:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 304, Column: 5}, []interface{}{_f}).(bool) {
+//line main.mml:305:3
+				return false
+			}
+//line main.mml:308:2
+			defer _close.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 308, Column: 8}, []interface{}{_f})
+//line main.mml:309:2
+			return mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 309, Column: 9}, 11, _read.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 309, Column: 9}, []interface{}{2, _f}), "#!")
+		}, FixedArgs: 1}
+//line main.mml:313:1
+		_interpretFile = &mml.Function{F: func(a []interface{}) interface{} {
+			var _mainPath = a[0]
+			var _programArgs = a[1]
+			var _modules interface{}
+			var _result interface{}
+//line main.mml:314:2
+			_modules = _load.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 314, Column: 14}, []interface{}{_mainPath})
+//line main.mml:315:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 315, Column: 5}, []interface{}{_modules}).(bool) {
+//line main.mml:316:3
+				return _modules
+			}
+//line main.mml:319:2
+			_result = mml.Ref(mml.Pos{Path: "main.mml", Line: 319, Column: 13}, _interpret, "run").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 319, Column: 13}, []interface{}{_modules, _mainPath, mml.NewList(_mainPath).Concat(_programArgs.(*mml.List))})
+//line main.mml:320:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 320, Column: 5}, []interface{}{_result}).(bool) {
+//line main.mml:321:3
+				_log.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 321, Column: 3}, []interface{}{_result})
+//line main.mml:322:3
+				mml.Ref(mml.Pos{Path: "main.mml", Line: 322, Column: 3}, _os, "exit").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 322, Column: 3}, []interface{}{3})
+			}
+			return nil
+		}, FixedArgs: 2}
+//line main.mml:326:1
+		_exit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _result = a[0]
+//line main.mml:327:2
+			switch {
+			case _isError.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 328, Column: 7}, []interface{}{_result}):
+//line main.mml:329:3
+				_log.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 329, Column: 3}, []interface{}{_result})
+//line main.mml:330:3
+				mml.Ref(mml.Pos{Path: "main.mml", Line: 330, Column: 3}, _os, "exit").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 330, Column: 3}, []interface{}{1})
+			case _isInt.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 331, Column: 7}, []interface{}{_result}):
+//line main.mml:332:3
+				mml.Ref(mml.Pos{Path: "main.mml", Line: 332, Column: 3}, _os, "exit").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 332, Column: 3}, []interface{}{_result})
+			}
+			return nil
+		}, FixedArgs: 1}
+//line main.mml:336:1
+		switch {
+		case (_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 337, Column: 6}, []interface{}{_args}).(int) == 1):
+//line main.mml:338:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 338, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 338, Column: 7}, _repl, "run").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 338, Column: 7}, []interface{}{_args})})
+		case ((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 339, Column: 6}, []interface{}{_args}).(int) >= 2) && _isScript.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 339, Column: 24}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 339, Column: 33}, _args, 1)}).(bool)):
+//line main.mml:340:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 340, Column: 2}, []interface{}{_interpretFile.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 340, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 340, Column: 21}, _args, 1), mml.RefRange(mml.Pos{Path: "main.mml", Line: 340, Column: 30}, _args, 2, nil)})})
+		case (_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 341, Column: 6}, []interface{}{_args}).(int) == 2):
+//line main.mml:342:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 342, Column: 2}, []interface{}{_generate.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 342, Column: 7}, []interface{}{"", mml.Ref(mml.Pos{Path: "main.mml", Line: 342, Column: 20}, _args, 1)})})
+		case ((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 343, Column: 6}, []interface{}{_args}).(int) == 4) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 343, Column: 24}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 343, Column: 24}, _args, 1), "-o").(bool)):
+//line main.mml:344:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 344, Column: 2}, []interface{}{_generate.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 344, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 344, Column: 16}, _args, 2), mml.Ref(mml.Pos{Path: "main.mml", Line: 344, Column: 25}, _args, 3)})})
+		case ((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 345, Column: 6}, []interface{}{_args}).(int) == 3) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 345, Column: 24}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 345, Column: 24}, _args, 1), "check").(bool)):
+//line main.mml:346:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 346, Column: 2}, []interface{}{_check.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 346, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 346, Column: 13}, _args, 2)})})
+		case ((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 347, Column: 6}, []interface{}{_args}).(int) == 3) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 347, Column: 24}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 347, Column: 24}, _args, 1), "build").(bool)):
+//line main.mml:348:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 348, Column: 2}, []interface{}{_build.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 348, Column: 7}, []interface{}{_binaryName.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 348, Column: 13}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 348, Column: 24}, _args, 2)}), mml.Ref(mml.Pos{Path: "main.mml", Line: 348, Column: 34}, _args, 2)})})
+		case (((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 349, Column: 6}, []interface{}{_args}).(int) == 5) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 349, Column: 24}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 349, Column: 24}, _args, 1), "build").(bool)) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 349, Column: 46}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 349, Column: 46}, _args, 2), "-o").(bool)):
+//line main.mml:350:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 350, Column: 2}, []interface{}{_build.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 350, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 350, Column: 13}, _args, 3), mml.Ref(mml.Pos{Path: "main.mml", Line: 350, Column: 22}, _args, 4)})})
+		case ((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 351, Column: 6}, []interface{}{_args}).(int) >= 3) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 351, Column: 24}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 351, Column: 24}, _args, 1), "run").(bool)):
+//line main.mml:352:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 352, Column: 2}, []interface{}{_run.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 352, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 352, Column: 11}, _args, 2), mml.RefRange(mml.Pos{Path: "main.mml", Line: 352, Column: 20}, _args, 3, nil)})})
+		case ((_len.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 353, Column: 6}, []interface{}{_args}).(int) >= 3) && mml.BinaryOp(mml.Pos{Path: "main.mml", Line: 353, Column: 24}, 11, mml.Ref(mml.Pos{Path: "main.mml", Line: 353, Column: 24}, _args, 1), "interpret").(bool)):
+//line main.mml:354:2
+			_exit.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 354, Column: 2}, []interface{}{_interpretFile.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 354, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "main.mml", Line: 354, Column: 21}, _args, 2), mml.RefRange(mml.Pos{Path: "main.mml", Line: 354, Column: 30}, _args, 3, nil)})})
+		default:
+//line main.mml:356:2
+			_log.(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 356, Column: 2}, []interface{}{_usage})
+//line main.mml:357:2
+			mml.Ref(mml.Pos{Path: "main.mml", Line: 357, Column: 2}, _os, "exit").(*mml.Function).CallAt(mml.Pos{Path: "main.mml", Line: 357, Column: 2}, []interface{}{2})
+		}
+//line main.go:675
 		return exports
 	})
-modulePath = "lang.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _fold interface{};
-var _foldr interface{};
-var _map interface{};
-var _filter interface{};
-var _contains interface{};
-var _sort interface{};
-var _flat interface{};
-var _uniq interface{};
-var _join interface{};
-var _joins interface{};
-var _formats interface{};
-var _enum interface{};
-var _log interface{};
-var _onlyErr interface{};
-var _passErr interface{};
-var _logger interface{};
-var _list interface{};
-var _strings interface{};
-var _ints interface{};
-var _errors interface{};
-mml.Nop(_fold, _foldr, _map, _filter, _contains, _sort, _flat, _uniq, _join, _joins, _formats, _enum, _log, _onlyErr, _passErr, _logger, _list, _strings, _ints, _errors);
-_list = mml.Modules.Use("list.mml");
-_strings = mml.Modules.Use("strings.mml");
-_ints = mml.Modules.Use("ints.mml");
-_logger = mml.Modules.Use("log.mml");
-_errors = mml.Modules.Use("errors.mml");
-_fold = mml.Ref(_list, "fold"); exports["fold"] = _fold;
-_foldr = mml.Ref(_list, "foldr"); exports["foldr"] = _foldr;
-_map = mml.Ref(_list, "map"); exports["map"] = _map;
-_filter = mml.Ref(_list, "filter"); exports["filter"] = _filter;
-_contains = mml.Ref(_list, "contains"); exports["contains"] = _contains;
-_sort = mml.Ref(_list, "sort"); exports["sort"] = _sort;
-_flat = mml.Ref(_list, "flat"); exports["flat"] = _flat;
-_uniq = mml.Ref(_list, "uniq"); exports["uniq"] = _uniq;
-_join = mml.Ref(_strings, "join"); exports["join"] = _join;
-_joins = mml.Ref(_strings, "joins"); exports["joins"] = _joins;
-_formats = mml.Ref(_strings, "formats"); exports["formats"] = _formats;
-_enum = mml.Ref(_ints, "enum"); exports["enum"] = _enum;
-_log = mml.Ref(_logger, "log"); exports["log"] = _log;
-_onlyErr = mml.Ref(_errors, "only"); exports["onlyErr"] = _onlyErr;
-_passErr = mml.Ref(_errors, "pass"); exports["passErr"] = _passErr
+}
+func init() {
+	mml.Modules.Set("lang.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _sort interface{}
+		var _flat interface{}
+		var _uniq interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var _log interface{}
+		var _onlyErr interface{}
+		var _passErr interface{}
+		var _logger interface{}
+		var _list interface{}
+		var _strings interface{}
+		var _ints interface{}
+		var _errors interface{}
+		_list = loader.Use("list.mml")
+		_strings = loader.Use("strings.mml")
+		_ints = loader.Use("ints.mml")
+		_logger = loader.Use("log.mml")
+		_errors = loader.Use("errors.mml")
+//line lang.mml:10:1
+		_fold = mml.Ref(mml.Pos{Path: "lang.mml", Line: 11, Column: 11}, _list, "fold")
+		exports.Set("fold", _fold)
+		_foldr = mml.Ref(mml.Pos{Path: "lang.mml", Line: 12, Column: 11}, _list, "foldr")
+		exports.Set("foldr", _foldr)
+		_map = mml.Ref(mml.Pos{Path: "lang.mml", Line: 13, Column: 11}, _list, "map")
+		exports.Set("map", _map)
+		_filter = mml.Ref(mml.Pos{Path: "lang.mml", Line: 14, Column: 11}, _list, "filter")
+		exports.Set("filter", _filter)
+		_contains = mml.Ref(mml.Pos{Path: "lang.mml", Line: 15, Column: 11}, _list, "contains")
+		exports.Set("contains", _contains)
+		_sort = mml.Ref(mml.Pos{Path: "lang.mml", Line: 16, Column: 11}, _list, "sort")
+		exports.Set("sort", _sort)
+		_flat = mml.Ref(mml.Pos{Path: "lang.mml", Line: 17, Column: 11}, _list, "flat")
+		exports.Set("flat", _flat)
+		_uniq = mml.Ref(mml.Pos{Path: "lang.mml", Line: 18, Column: 11}, _list, "uniq")
+		exports.Set("uniq", _uniq)
+//line lang.mml:22:1
+		_join = mml.Ref(mml.Pos{Path: "lang.mml", Line: 23, Column: 10}, _strings, "join")
+		exports.Set("join", _join)
+		_joins = mml.Ref(mml.Pos{Path: "lang.mml", Line: 24, Column: 10}, _strings, "joins")
+		exports.Set("joins", _joins)
+		_formats = mml.Ref(mml.Pos{Path: "lang.mml", Line: 25, Column: 10}, _strings, "formats")
+		exports.Set("formats", _formats)
+//line lang.mml:29:1
+		_enum = mml.Ref(mml.Pos{Path: "lang.mml", Line: 29, Column: 17}, _ints, "enum")
+		exports.Set("enum", _enum)
+//line lang.mml:32:1
+		_log = mml.Ref(mml.Pos{Path: "lang.mml", Line: 32, Column: 16}, _logger, "log")
+		exports.Set("log", _log)
+//line lang.mml:35:1
+		_onlyErr = mml.Ref(mml.Pos{Path: "lang.mml", Line: 36, Column: 10}, _errors, "only")
+		exports.Set("onlyErr", _onlyErr)
+		_passErr = mml.Ref(mml.Pos{Path: "lang.mml", Line: 37, Column: 10}, _errors, "pass")
+		exports.Set("passErr", _passErr)
+//line main.go:742
 		return exports
 	})
-modulePath = "list.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _fold interface{};
-var _foldr interface{};
-var _map interface{};
-var _filter interface{};
-var _contains interface{};
-var _flat interface{};
-var _uniq interface{};
-var _sort interface{};
-mml.Nop(_fold, _foldr, _map, _filter, _contains, _flat, _uniq, _sort);
-_fold = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-var _i = a[1];
-var _l = a[2];
-				;
-				mml.Nop(_f, _i, _l);
-				return func () interface{} { c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0); if c.(bool) { return _i } else { return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0), _i)}).Values), mml.RefRange(_l, 1, nil))}).Values) } }()
-			},
-			FixedArgs: 3,
-		}; exports["fold"] = _fold;
-_foldr = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-var _i = a[1];
-var _l = a[2];
-				;
-				mml.Nop(_f, _i, _l);
-				return func () interface{} { c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0); if c.(bool) { return _i } else { return _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0), _foldr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _i, mml.RefRange(_l, 1, nil))}).Values))}).Values) } }()
-			},
-			FixedArgs: 3,
-		}; exports["foldr"] = _foldr;
-_map = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_m, _l);
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-var _r = a[1];
-				;
-				mml.Nop(_c, _r);
-				return &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _m.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values))}
-			},
-			FixedArgs: 2,
-		}, &mml.List{Values: []interface{}{}}, _l)}).Values)
-			},
-			FixedArgs: 2,
-		}; exports["map"] = _map;
-_filter = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _p = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_p, _l);
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-var _r = a[1];
-				;
-				mml.Nop(_c, _r);
-				return func () interface{} { c = _p.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values); if c.(bool) { return &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _c)} } else { return _r } }()
-			},
-			FixedArgs: 2,
-		}, &mml.List{Values: []interface{}{}}, _l)}).Values)
-			},
-			FixedArgs: 2,
-		}; exports["filter"] = _filter;
-_contains = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _i = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_i, _l);
-				return mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ii = a[0];
-				;
-				mml.Nop(_ii);
-				return mml.BinaryOp(11, _ii, _i)
-			},
-			FixedArgs: 1,
-		}, _l)}).Values))}).Values), 0)
-			},
-			FixedArgs: 2,
-		}; exports["contains"] = _contains;
-_flat = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0];
-				;
-				mml.Nop(_l);
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-var _result = a[1];
-				;
-				mml.Nop(_c, _result);
-				return &mml.List{Values: append(append([]interface{}{}, _result.(*mml.List).Values...), _c.(*mml.List).Values...)}
-			},
-			FixedArgs: 2,
-		}, &mml.List{Values: []interface{}{}}, _l)}).Values)
-			},
-			FixedArgs: 1,
-		}; exports["flat"] = _flat;
-_uniq = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _eq = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_eq, _l);
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-var _u = a[1];
-				;
-				mml.Nop(_c, _u);
-				return func () interface{} { c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _i = a[0];
-				;
-				mml.Nop(_i);
-				return _eq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _i, _c)}).Values)
-			},
-			FixedArgs: 1,
-		}, _u)}).Values))}).Values), 0); if c.(bool) { return &mml.List{Values: append(append([]interface{}{}, _u.(*mml.List).Values...), _c)} } else { return _u } }()
-			},
-			FixedArgs: 2,
-		}, &mml.List{Values: []interface{}{}}, _l)}).Values)
-			},
-			FixedArgs: 2,
-		}; exports["uniq"] = _uniq;
-_sort = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _less = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_less, _l);
-				return func () interface{} { c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0); if c.(bool) { return &mml.List{Values: []interface{}{}} } else { return &mml.List{Values: append(append(append([]interface{}{}, _sort.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _less)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _i = a[0];
-				;
-				mml.Nop(_i);
-				return !_less.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0), _i)}).Values).(bool)
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_l, 1, nil))}).Values))}).Values).(*mml.List).Values...), mml.Ref(_l, 0)), _sort.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _less)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _less.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, 0))}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_l, 1, nil))}).Values))}).Values).(*mml.List).Values...)} } }()
-			},
-			FixedArgs: 2,
-		}; exports["sort"] = _sort
+}
+func init() {
+	mml.Modules.Set("list.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _fold interface{}
+		var _foldr interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _flat interface{}
+		var _uniq interface{}
+		var _sort interface{}
+//line list.mml:1:1
+		_fold = &mml.Function{F: func(a []interface{}) interface{} {
+		__tail:
+			for {
+				var _f = a[0]
+				var _i = a[1]
+				var _l = a[2]
+//line list.mml:2:18
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 2, Column: 18}, []interface{}{_l}).(int) == 0) {
+//line list.mml:2:32
+					return _i
+				} else {
+//line list.mml:2:36
+					a = []interface{}{_f, _f.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 2, Column: 44}, []interface{}{mml.Ref(mml.Pos{Path: "list.mml", Line: 2, Column: 46}, _l, 0), _i}), mml.RefRange(mml.Pos{Path: "list.mml", Line: 2, Column: 56}, _l, 1, nil)}
+					continue __tail
+				}
+			}
+		}, FixedArgs: 3}
+		exports.Set("fold", _fold)
+		_foldr = &mml.Function{F: func(a []interface{}) interface{} {
+		__tail:
+			for {
+				var _f = a[0]
+				var _i = a[1]
+				var _l = a[2]
+//line list.mml:3:18
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 3, Column: 18}, []interface{}{_l}).(int) == 0) {
+//line list.mml:3:32
+					return _i
+				} else {
+//line list.mml:3:36
+					a = []interface{}{_f, _f.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 3, Column: 45}, []interface{}{mml.Ref(mml.Pos{Path: "list.mml", Line: 3, Column: 47}, _l, (_len.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 3, Column: 49}, []interface{}{_l}).(int) - 1)), _i}), mml.RefRange(mml.Pos{Path: "list.mml", Line: 3, Column: 66}, _l, nil, (_len.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 3, Column: 69}, []interface{}{_l}).(int) - 1))}
+					continue __tail
+				}
+			}
+		}, FixedArgs: 3}
+		exports.Set("foldr", _foldr)
+		_map = &mml.Function{F: func(a []interface{}) interface{} {
+			var _m = a[0]
+			var _l = a[1]
+//line list.mml:4:18
+			return _fold.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 4, Column: 18}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+				var _r = a[1]
+//line list.mml:4:33
+				return mml.NewList().Concat(_r.(*mml.List)).Append(_m.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 4, Column: 40}, []interface{}{_c}))
+			}, FixedArgs: 2}, mml.NewList(), _l})
+		}, FixedArgs: 2}
+		exports.Set("map", _map)
+		_filter = &mml.Function{F: func(a []interface{}) interface{} {
+			var _p = a[0]
+			var _l = a[1]
+//line list.mml:5:18
+			return _fold.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 5, Column: 18}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+				var _r = a[1]
+//line list.mml:5:33
+				return func() interface{} {
+					if _p.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 5, Column: 33}, []interface{}{_c}).(bool) {
+						return mml.NewList().Concat(_r.(*mml.List)).Append(_c)
+					}
+					return _r
+				}()
+			}, FixedArgs: 2}, mml.NewList(), _l})
+		}, FixedArgs: 2}
+		exports.Set("filter", _filter)
+		_contains = &mml.Function{F: func(a []interface{}) interface{} {
+			var _i = a[0]
+			var _l = a[1]
+//line list.mml:6:18
+			return (_len.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 6, Column: 18}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 6, Column: 22}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _ii = a[0]
+//line list.mml:6:37
+				return mml.BinaryOp(mml.Pos{Path: "list.mml", Line: 6, Column: 37}, 11, _ii, _i)
+			}, FixedArgs: 1}, _l})}).(int) > 0)
+		}, FixedArgs: 2}
+		exports.Set("contains", _contains)
+		_flat = &mml.Function{F: func(a []interface{}) interface{} {
+			var _l = a[0]
+//line list.mml:7:18
+			return _fold.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 7, Column: 18}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+				var _result = a[1]
+//line list.mml:7:38
+				return mml.NewList().Concat(_result.(*mml.List)).Concat(_c.(*mml.List))
+			}, FixedArgs: 2}, mml.NewList(), _l})
+		}, FixedArgs: 1}
+		exports.Set("flat", _flat)
+		_uniq = &mml.Function{F: func(a []interface{}) interface{} {
+			var _eq = a[0]
+			var _l = a[1]
+//line list.mml:8:18
+			return _fold.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 8, Column: 18}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+				var _u = a[1]
+//line list.mml:8:33
+				return func() interface{} {
+					if (_len.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 8, Column: 33}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 8, Column: 37}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+						var _i = a[0]
+//line list.mml:8:51
+						return _eq.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 8, Column: 51}, []interface{}{_i, _c})
+					}, FixedArgs: 1}, _u})}).(int) == 0) {
+						return mml.NewList().Concat(_u.(*mml.List)).Append(_c)
+					}
+					return _u
+				}()
+			}, FixedArgs: 2}, mml.NewList(), _l})
+		}, FixedArgs: 2}
+		exports.Set("uniq", _uniq)
+//line list.mml:11:1
+		_sort = &mml.Function{F: func(a []interface{}) interface{} {
+			var _less = a[0]
+			var _l = a[1]
+//line list.mml:11:25
+			return func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 11, Column: 25}, []interface{}{_l}).(int) == 0) {
+					return mml.NewList()
+				}
+				return mml.NewList().Concat(_sort.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 12, Column: 45}, []interface{}{_less}).(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 12, Column: 3}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 12, Column: 12}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+					var _i = a[0]
+//line list.mml:12:26
+					return !_less.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 12, Column: 27}, []interface{}{mml.Ref(mml.Pos{Path: "list.mml", Line: 12, Column: 32}, _l, 0), _i}).(bool)
+				}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.RefRange(mml.Pos{Path: "list.mml", Line: 12, Column: 3}, _l, 1, nil)})}).(*mml.List)).Append(mml.Ref(mml.Pos{Path: "list.mml", Line: 13, Column: 2}, _l, 0)).Concat(_sort.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 14, Column: 34}, []interface{}{_less}).(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 14, Column: 3}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 14, Column: 12}, []interface{}{_less.(*mml.Function).CallAt(mml.Pos{Path: "list.mml", Line: 14, Column: 19}, []interface{}{mml.Ref(mml.Pos{Path: "list.mml", Line: 14, Column: 24}, _l, 0)})}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.RefRange(mml.Pos{Path: "list.mml", Line: 14, Column: 3}, _l, 1, nil)})}).(*mml.List))
+			}()
+		}, FixedArgs: 2}
+		exports.Set("sort", _sort)
+//line main.go:883
 		return exports
 	})
-modulePath = "strings.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _firstOr interface{};
-var _join interface{};
-var _joins interface{};
-var _joinTwo interface{};
-var _formats interface{};
-var _formatOne interface{};
-var _escape interface{};
-var _unescape interface{};
-mml.Nop(_firstOr, _join, _joins, _joinTwo, _formats, _formatOne, _escape, _unescape);
-_firstOr = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _v = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_v, _l);
-				return func () interface{} { c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values), 0); if c.(bool) { return mml.Ref(_l, 0) } else { return _v } }()
-			},
-			FixedArgs: 2,
-		};
-_join = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _j = a[0];
-var _s = a[1];
-				;
-				mml.Nop(_j, _s);
-				return func () interface{} { c = mml.BinaryOp(13, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values), 2); if c.(bool) { return _firstOr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "", _s)}).Values) } else { return mml.BinaryOp(9, mml.BinaryOp(9, mml.Ref(_s, 0), _j), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _j, mml.RefRange(_s, 1, nil))}).Values)) } }()
-			},
-			FixedArgs: 2,
-		}; exports["join"] = _join;
-_joins = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _j = a[0];
-var _s = &mml.List{a[1:]};
-				;
-				mml.Nop(_j, _s);
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _j, _s)}).Values)
-			},
-			FixedArgs: 1,
-		}; exports["joins"] = _joins;
-_joinTwo = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _j = a[0];
-var _left = a[1];
-var _right = a[2];
-				;
-				mml.Nop(_j, _left, _right);
-				return _joins.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _j, _left, _right)}).Values)
-			},
-			FixedArgs: 3,
-		}; exports["joinTwo"] = _joinTwo;
-_formats = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-var _a = &mml.List{a[1:]};
-				;
-				mml.Nop(_f, _a);
-				return _format.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _a)}).Values)
-			},
-			FixedArgs: 1,
-		}; exports["formats"] = _formats;
-_formatOne = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-var _a = a[1];
-				;
-				mml.Nop(_f, _a);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _a)}).Values)
-			},
-			FixedArgs: 2,
-		}; exports["formatOne"] = _formatOne;
-_escape = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				var _first interface{};
-mml.Nop(_first);
-c = mml.BinaryOp(11, _s, ""); if c.(bool) { ;
-mml.Nop();
-return "" };
-_first = mml.Ref(_s, 0);
-switch _first {
-case "\b":
-;
-mml.Nop();
-_first = "\\b"
-case "\f":
-;
-mml.Nop();
-_first = "\\f"
-case "\n":
-;
-mml.Nop();
-_first = "\\n"
-case "\r":
-;
-mml.Nop();
-_first = "\\r"
-case "\t":
-;
-mml.Nop();
-_first = "\\t"
-case "\v":
-;
-mml.Nop();
-_first = "\\v"
-case "\"":
-;
-mml.Nop();
-_first = "\\\""
-case "\\":
-;
-mml.Nop();
-_first = "\\\\"
-};
-return mml.BinaryOp(9, _first, _escape.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_s, 1, nil))}).Values));
-				return nil
-			},
-			FixedArgs: 1,
-		}; exports["escape"] = _escape;
-_unescape = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				var _esc interface{};
-var _r interface{};
-mml.Nop(_esc, _r);
-_esc = false;
-_r = &mml.List{Values: []interface{}{}};
-for _i := 0; _i < _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values).(int); _i++ {
-var _c interface{};
-mml.Nop(_c);
-_c = mml.Ref(_s, _i);
-c = _esc; if c.(bool) { ;
-mml.Nop();
-switch _c {
-case "b":
-;
-mml.Nop();
-_c = "\b"
-case "f":
-;
-mml.Nop();
-_c = "\f"
-case "n":
-;
-mml.Nop();
-_c = "\n"
-case "r":
-;
-mml.Nop();
-_c = "\r"
-case "t":
-;
-mml.Nop();
-_c = "\t"
-case "v":
-;
-mml.Nop();
-_c = "\v"
-};
-_r = &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _c)};
-_esc = false;
-continue };
-c = mml.BinaryOp(11, _c, "\\"); if c.(bool) { ;
-mml.Nop();
-_esc = true;
-continue };
-_r = &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _c)}
-};
-return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "", _r)}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		}; exports["unescape"] = _unescape
+}
+func init() {
+	mml.Modules.Set("strings.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _joinHalves interface{}
+		var _join interface{}
+		var _joins interface{}
+		var _joinTwo interface{}
+		var _formats interface{}
+		var _formatOne interface{}
+		var _escape interface{}
+		var _unescape interface{}
+//line strings.mml:3:1
+		_joinHalves = &mml.Function{F: func(a []interface{}) interface{} {
+			var _j = a[0]
+			var _s = a[1]
+//line strings.mml:3:21
+			return func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 3, Column: 21}, []interface{}{_s}).(int) == 1) {
+					return mml.Ref(mml.Pos{Path: "strings.mml", Line: 3, Column: 35}, _s, 0)
+				}
+				return mml.BinaryOp(mml.Pos{Path: "strings.mml", Line: 3, Column: 42}, 9, mml.BinaryOp(mml.Pos{Path: "strings.mml", Line: 3, Column: 42}, 9, _joinHalves.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 3, Column: 42}, []interface{}{_j, mml.RefRange(mml.Pos{Path: "strings.mml", Line: 3, Column: 56}, _s, nil, (_len.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 3, Column: 59}, []interface{}{_s}).(int) / 2))}), _j), _joinHalves.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 3, Column: 78}, []interface{}{_j, mml.RefRange(mml.Pos{Path: "strings.mml", Line: 3, Column: 92}, _s, (_len.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 3, Column: 94}, []interface{}{_s}).(int) / 2), nil)}))
+			}()
+		}, FixedArgs: 2}
+//line strings.mml:5:1
+		_join = &mml.Function{F: func(a []interface{}) interface{} {
+			var _j = a[0]
+			var _s = a[1]
+//line strings.mml:6:26
+			return func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 6, Column: 26}, []interface{}{_s}).(int) == 0) {
+					return ""
+				}
+				return _joinHalves.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 6, Column: 45}, []interface{}{_j, _s})
+			}()
+		}, FixedArgs: 2}
+		exports.Set("join", _join)
+		_joins = &mml.Function{F: func(a []interface{}) interface{} {
+			var _j = a[0]
+			var _s = mml.NewList(a[1:]...)
+//line strings.mml:7:26
+			return _join.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 7, Column: 26}, []interface{}{_j, _s})
+		}, FixedArgs: 1}
+		exports.Set("joins", _joins)
+		_joinTwo = &mml.Function{F: func(a []interface{}) interface{} {
+			var _j = a[0]
+			var _left = a[1]
+			var _right = a[2]
+//line strings.mml:8:26
+			return _joins.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 8, Column: 26}, []interface{}{_j, _left, _right})
+		}, FixedArgs: 3}
+		exports.Set("joinTwo", _joinTwo)
+		_formats = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+			var _a = mml.NewList(a[1:]...)
+//line strings.mml:9:26
+			return _format.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 9, Column: 26}, []interface{}{_f, _a})
+		}, FixedArgs: 1}
+		exports.Set("formats", _formats)
+		_formatOne = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+			var _a = a[1]
+//line strings.mml:10:26
+			return _formats.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 10, Column: 26}, []interface{}{_f, _a})
+		}, FixedArgs: 2}
+		exports.Set("formatOne", _formatOne)
+//line strings.mml:13:1
+		_escape = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+			var _r interface{}
+//line strings.mml:14:2
+			_r = mml.NewList()
+//line strings.mml:15:2
+			for __iter := mml.Iterate(mml.Pos{Path: "strings.mml", Line: 15, Column: 6}, _s); __iter.Next(); {
+				_c := __iter.Value()
+//line strings.mml:16:3
+				switch _c {
+				case "\b":
+//line strings.mml:18:4
+					_c = "\\b"
+				case "\f":
+//line strings.mml:20:4
+					_c = "\\f"
+				case "\n":
+//line strings.mml:22:4
+					_c = "\\n"
+				case "\r":
+//line strings.mml:24:4
+					_c = "\\r"
+				case "\t":
+//line strings.mml:26:4
+					_c = "\\t"
+				case "\v":
+//line strings.mml:28:4
+					_c = "\\v"
+				case "\"":
+//line strings.mml:30:4
+					_c = "\\\""
+				case "\\":
+//line strings.mml:32:4
+					_c = "\\\\"
+				}
+//line strings.mml:35:3
+				_r = mml.NewList().Concat(_r.(*mml.List)).Append(_c)
+			}
+//line strings.mml:38:2
+			return _join.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 38, Column: 9}, []interface{}{"", _r})
+		}, FixedArgs: 1}
+		exports.Set("escape", _escape)
+//line strings.mml:41:1
+		_unescape = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+			var _esc bool
+			var _r interface{}
+//line strings.mml:42:2
+			_esc = false
+			_r = mml.NewList()
+//line strings.mml:47:2
+			for __iter := mml.Iterate(mml.Pos{Path: "strings.mml", Line: 47, Column: 6}, _s); __iter.Next(); {
+				_c := __iter.Value()
+//line strings.mml:48:3
+				if _esc {
+//line strings.mml:49:4
+					switch _c {
+					case "b":
+//line strings.mml:51:5
+						_c = "\b"
+					case "f":
+//line strings.mml:53:5
+						_c = "\f"
+					case "n":
+//line strings.mml:55:5
+						_c = "\n"
+					case "r":
+//line strings.mml:57:5
+						_c = "\r"
+					case "t":
+//line strings.mml:59:5
+						_c = "\t"
+					case "v":
+//line strings.mml:61:5
+						_c = "\v"
+					}
+//line strings.mml:64:4
+					_r = mml.NewList().Concat(_r.(*mml.List)).Append(_c)
+//line strings.mml:65:4
+					_esc = false
+//line strings.mml:66:4
+					continue
+				}
+//line strings.mml:69:3
+				if mml.BinaryOp(mml.Pos{Path: "strings.mml", Line: 69, Column: 6}, 11, _c, "\\").(bool) {
+//line strings.mml:70:4
+					_esc = true
+//line strings.mml:71:4
+					continue
+				}
+//line strings.mml:74:3
+				_r = mml.NewList().Concat(_r.(*mml.List)).Append(_c)
+			}
+//line strings.mml:77:2
+			return _join.(*mml.Function).CallAt(mml.Pos{Path: "strings.mml", Line: 77, Column: 9}, []interface{}{"", _r})
+		}, FixedArgs: 1}
+		exports.Set("unescape", _unescape)
+//line main.go:1050
 		return exports
 	})
-modulePath = "ints.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _counter interface{};
-var _enum interface{};
-mml.Nop(_counter, _enum);
-_counter = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				var _c interface{};
-mml.Nop(_c);
-_c = mml.UnaryOp(2, 1);
-return &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				;
-mml.Nop();
-_c = mml.BinaryOp(9, _c, 1);
-return _c;
-				return nil
-			},
-			FixedArgs: 0,
-		};
-				return nil
-			},
-			FixedArgs: 0,
-		}; exports["counter"] = _counter;
-_enum = _counter; exports["enum"] = _enum
+}
+func init() {
+	mml.Modules.Set("ints.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _counter interface{}
+		var _enum interface{}
+//line ints.mml:1:1
+		_counter = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c int
+//line ints.mml:2:2
+			_c = -1
+//line ints.mml:3:2
+			return &mml.Function{F: func(a []interface{}) interface{} {
+//line ints.mml:4:3
+				_c = (_c + 1)
+//line ints.mml:5:3
+				return _c
+			}, FixedArgs: 0}
+		}, FixedArgs: 0}
+		exports.Set("counter", _counter)
+//line ints.mml:9:1
+		_enum = _counter
+		exports.Set("enum", _enum)
+//line main.go:1076
 		return exports
 	})
-modulePath = "log.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _log interface{};
-var _list interface{};
-var _strings interface{};
-mml.Nop(_log, _list, _strings);
-_list = mml.Modules.Use("list.mml");
-_strings = mml.Modules.Use("strings.mml");
-_log = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _a = &mml.List{a[0:]};
-				;
-				mml.Nop(_a);
-				;
-mml.Nop();
-_stderr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_strings, "join").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, " ")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_list, "map").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _string)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values))}).Values))}).Values);
-_stderr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\n")}).Values);
-return func () interface{} { c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values), 0); if c.(bool) { return "" } else { return mml.Ref(_a, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values), 1)) } }();
-				return nil
-			},
-			FixedArgs: 0,
-		}; exports["log"] = _log
+}
+func init() {
+	mml.Modules.Set("log.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _log interface{}
+		var _list interface{}
+		var _strings interface{}
+		_list = loader.Use("list.mml")
+		_strings = loader.Use("strings.mml")
+//line log.mml:8:1
+		_log = &mml.Function{F: func(a []interface{}) interface{} {
+			var _a = mml.NewList(a[0:]...)
+//line log.mml:9:2
+			_stderr.(*mml.Function).CallAt(mml.Pos{Path: "log.mml", Line: 9, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "log.mml", Line: 9, Column: 27}, _strings, "join").(*mml.Function).CallAt(mml.Pos{Path: "log.mml", Line: 9, Column: 27}, []interface{}{" "}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.Ref(mml.Pos{Path: "log.mml", Line: 9, Column: 7}, _list, "map").(*mml.Function).CallAt(mml.Pos{Path: "log.mml", Line: 9, Column: 7}, []interface{}{_string}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_a})})})
+//line log.mml:10:2
+			_stderr.(*mml.Function).CallAt(mml.Pos{Path: "log.mml", Line: 10, Column: 2}, []interface{}{"\n"})
+//line log.mml:11:2
+			return func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "log.mml", Line: 11, Column: 9}, []interface{}{_a}).(int) == 0) {
+					return ""
+				}
+				return mml.Ref(mml.Pos{Path: "log.mml", Line: 11, Column: 28}, _a, (_len.(*mml.Function).CallAt(mml.Pos{Path: "log.mml", Line: 11, Column: 30}, []interface{}{_a}).(int) - 1))
+			}()
+		}, FixedArgs: 0}
+		exports.Set("log", _log)
+//line main.go:1104
 		return exports
 	})
-modulePath = "errors.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _ifErr interface{};
-var _not interface{};
-var _yes interface{};
-var _pass interface{};
-var _only interface{};
-var _any interface{};
-var _list interface{};
-mml.Nop(_ifErr, _not, _yes, _pass, _only, _any, _list);
-_list = mml.Modules.Use("list.mml");
-_ifErr = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _mod = a[0];
-var _f = a[1];
-				;
-				mml.Nop(_mod, _f);
-				return &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _a = a[0];
-				;
-				mml.Nop(_a);
-				return func () interface{} { c = _mod.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values))}).Values); if c.(bool) { return _f.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _a)}).Values) } else { return _a } }()
-			},
-			FixedArgs: 1,
-		}
-			},
-			FixedArgs: 2,
-		};
-_not = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _x = a[0];
-				;
-				mml.Nop(_x);
-				return !_x.(bool)
-			},
-			FixedArgs: 1,
-		};
-_yes = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _x = a[0];
-				;
-				mml.Nop(_x);
-				return _x
-			},
-			FixedArgs: 1,
-		};
-_pass = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-				;
-				mml.Nop(_f);
-				return _ifErr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _not, _f)}).Values)
-			},
-			FixedArgs: 1,
-		}; exports["pass"] = _pass;
-_only = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-				;
-				mml.Nop(_f);
-				return _ifErr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _yes, _f)}).Values)
-			},
-			FixedArgs: 1,
-		}; exports["only"] = _only;
-_any = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0];
-				;
-				mml.Nop(_l);
-				return mml.Ref(_list, "fold").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-var _r = a[1];
-				;
-				mml.Nop(_c, _r);
-				return func () interface{} { c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _r)}).Values); if c.(bool) { return _r } else { return func () interface{} { c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values); if c.(bool) { return _c } else { return &mml.List{Values: append(append([]interface{}{}, _r.(*mml.List).Values...), _c)} } }() } }()
-			},
-			FixedArgs: 2,
-		}, &mml.List{Values: []interface{}{}}, _l)}).Values)
-			},
-			FixedArgs: 1,
-		}; exports["any"] = _any
+}
+func init() {
+	mml.Modules.Set("errors.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _ifErr interface{}
+		var _not interface{}
+		var _yes interface{}
+		var _pass interface{}
+		var _only interface{}
+		var _any interface{}
+		var _new interface{}
+		var _wrap interface{}
+		var _wrapWith interface{}
+		var _unwrap interface{}
+		var _is interface{}
+		var _data interface{}
+		var _list interface{}
+		_list = loader.Use("list.mml")
+//line errors.mml:3:1
+		_ifErr = &mml.Function{F: func(a []interface{}) interface{} {
+			var _mod = a[0]
+			var _f = a[1]
+//line errors.mml:4:16
+			return &mml.Function{F: func(a []interface{}) interface{} {
+				var _a = a[0]
+//line errors.mml:4:24
+				return func() interface{} {
+					if _mod.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 4, Column: 24}, []interface{}{_isError.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 4, Column: 28}, []interface{}{_a})}).(bool) {
+						return _f.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 4, Column: 42}, []interface{}{_a})
+					}
+					return _a
+				}()
+			}, FixedArgs: 1}
+		}, FixedArgs: 2}
+		_not = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+//line errors.mml:5:16
+			return !_x.(bool)
+		}, FixedArgs: 1}
+		_yes = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+//line errors.mml:6:16
+			return _x
+		}, FixedArgs: 1}
+//line errors.mml:9:1
+		_pass = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+//line errors.mml:10:10
+			return _ifErr.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 10, Column: 10}, []interface{}{_not, _f})
+		}, FixedArgs: 1}
+		exports.Set("pass", _pass)
+		_only = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+//line errors.mml:11:10
+			return _ifErr.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 11, Column: 10}, []interface{}{_yes, _f})
+		}, FixedArgs: 1}
+		exports.Set("only", _only)
+		_any = &mml.Function{F: func(a []interface{}) interface{} {
+			var _l = a[0]
+//line errors.mml:12:10
+			return mml.Ref(mml.Pos{Path: "errors.mml", Line: 12, Column: 10}, _list, "fold").(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 12, Column: 10}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+				var _r = a[1]
+//line errors.mml:12:30
+				return func() interface{} {
+					if _isError.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 12, Column: 30}, []interface{}{_r}).(bool) {
+						return _r
+					}
+					return func() interface{} {
+						if _isError.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 12, Column: 47}, []interface{}{_c}).(bool) {
+							return _c
+						}
+						return mml.NewList().Concat(_r.(*mml.List)).Append(_c)
+					}()
+				}()
+			}, FixedArgs: 2}, mml.NewList(), _l})
+		}, FixedArgs: 1}
+		exports.Set("any", _any)
+//line errors.mml:17:1
+		_new = mml.Interop(mml.Pos{Path: "errors.mml", Line: 18, Column: 11}, "github.com/aryszka/mml/errors.New", __interop_github_46_com_47_aryszka_47_mml_47_errors.New)
+		exports.Set("new", _new)
+		_wrap = mml.Interop(mml.Pos{Path: "errors.mml", Line: 19, Column: 11}, "github.com/aryszka/mml/errors.Wrap", __interop_github_46_com_47_aryszka_47_mml_47_errors.Wrap)
+		exports.Set("wrap", _wrap)
+		_wrapWith = mml.Interop(mml.Pos{Path: "errors.mml", Line: 20, Column: 11}, "github.com/aryszka/mml/errors.WrapWith", __interop_github_46_com_47_aryszka_47_mml_47_errors.WrapWith)
+		exports.Set("wrapWith", _wrapWith)
+		_unwrap = mml.Interop(mml.Pos{Path: "errors.mml", Line: 21, Column: 11}, "github.com/aryszka/mml/errors.Unwrap", __interop_github_46_com_47_aryszka_47_mml_47_errors.Unwrap)
+		exports.Set("unwrap", _unwrap)
+//line errors.mml:24:1
+		_is = &mml.Function{F: func(a []interface{}) interface{} {
+			var _target = a[0]
+			var _e = a[1]
+//line errors.mml:25:16
+			return (_isError.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 25, Column: 16}, []interface{}{_e}).(bool) && (mml.BinaryOp(mml.Pos{Path: "errors.mml", Line: 25, Column: 31}, 11, _e, _target).(bool) || (_has.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 25, Column: 46}, []interface{}{"cause", _e}).(bool) && _is.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 25, Column: 65}, []interface{}{_target, mml.Ref(mml.Pos{Path: "errors.mml", Line: 25, Column: 76}, _e, "cause")}).(bool))))
+		}, FixedArgs: 2}
+		exports.Set("is", _is)
+		_data = &mml.Function{F: func(a []interface{}) interface{} {
+			var _e = a[0]
+//line errors.mml:26:16
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "errors.mml", Line: 26, Column: 16}, []interface{}{"data", _e}).(bool) {
+					return mml.Ref(mml.Pos{Path: "errors.mml", Line: 26, Column: 33}, _e, "data")
+				}
+				return mml.NewStruct(nil)
+			}()
+		}, FixedArgs: 1}
+		exports.Set("data", _data)
+//line main.go:1213
 		return exports
 	})
-modulePath = "code.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _controlStatement interface{};
-var _breakControl interface{};
-var _continueControl interface{};
-var _unaryOp interface{};
-var _binaryNot interface{};
-var _plus interface{};
-var _minus interface{};
-var _logicalNot interface{};
-var _binaryOp interface{};
-var _binaryAnd interface{};
-var _binaryOr interface{};
-var _xor interface{};
-var _andNot interface{};
-var _lshift interface{};
-var _rshift interface{};
-var _mul interface{};
-var _div interface{};
-var _mod interface{};
-var _add interface{};
-var _sub interface{};
-var _eq interface{};
-var _notEq interface{};
-var _less interface{};
-var _lessOrEq interface{};
-var _greater interface{};
-var _greaterOrEq interface{};
-var _logicalAnd interface{};
-var _logicalOr interface{};
-var _builtin interface{};
-var _flattenedStatements interface{};
-var _getModuleName interface{};
-var _fold interface{};
-var _foldr interface{};
-var _map interface{};
-var _filter interface{};
-var _contains interface{};
-var _sort interface{};
-var _flat interface{};
-var _uniq interface{};
-var _join interface{};
-var _joins interface{};
-var _formats interface{};
-var _enum interface{};
-var _log interface{};
-var _onlyErr interface{};
-var _passErr interface{};
-mml.Nop(_controlStatement, _breakControl, _continueControl, _unaryOp, _binaryNot, _plus, _minus, _logicalNot, _binaryOp, _binaryAnd, _binaryOr, _xor, _andNot, _lshift, _rshift, _mul, _div, _mod, _add, _sub, _eq, _notEq, _less, _lessOrEq, _greater, _greaterOrEq, _logicalAnd, _logicalOr, _builtin, _flattenedStatements, _getModuleName, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _uniq, _join, _joins, _formats, _enum, _log, _onlyErr, _passErr);
-var __lang = mml.Modules.Use("lang.mml");;_fold = __lang.Values["fold"];
-_foldr = __lang.Values["foldr"];
-_map = __lang.Values["map"];
-_filter = __lang.Values["filter"];
-_contains = __lang.Values["contains"];
-_sort = __lang.Values["sort"];
-_flat = __lang.Values["flat"];
-_uniq = __lang.Values["uniq"];
-_join = __lang.Values["join"];
-_joins = __lang.Values["joins"];
-_formats = __lang.Values["formats"];
-_enum = __lang.Values["enum"];
-_log = __lang.Values["log"];
-_onlyErr = __lang.Values["onlyErr"];
-_passErr = __lang.Values["passErr"];
-_controlStatement = _enum.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["controlStatement"] = _controlStatement;
-_breakControl = _controlStatement.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["breakControl"] = _breakControl;
-_continueControl = _controlStatement.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["continueControl"] = _continueControl;
-_unaryOp = _enum.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["unaryOp"] = _unaryOp;
-_binaryNot = _unaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["binaryNot"] = _binaryNot;
-_plus = _unaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["plus"] = _plus;
-_minus = _unaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["minus"] = _minus;
-_logicalNot = _unaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["logicalNot"] = _logicalNot;
-_binaryOp = _enum.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["binaryOp"] = _binaryOp;
-_binaryAnd = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["binaryAnd"] = _binaryAnd;
-_binaryOr = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["binaryOr"] = _binaryOr;
-_xor = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["xor"] = _xor;
-_andNot = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["andNot"] = _andNot;
-_lshift = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["lshift"] = _lshift;
-_rshift = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["rshift"] = _rshift;
-_mul = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["mul"] = _mul;
-_div = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["div"] = _div;
-_mod = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["mod"] = _mod;
-_add = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["add"] = _add;
-_sub = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["sub"] = _sub;
-_eq = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["eq"] = _eq;
-_notEq = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["notEq"] = _notEq;
-_less = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["less"] = _less;
-_lessOrEq = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["lessOrEq"] = _lessOrEq;
-_greater = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["greater"] = _greater;
-_greaterOrEq = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["greaterOrEq"] = _greaterOrEq;
-_logicalAnd = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["logicalAnd"] = _logicalAnd;
-_logicalOr = _binaryOp.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values); exports["logicalOr"] = _logicalOr;
-_builtin = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["len"] = "Len";s.Values["isError"] = "IsError";s.Values["keys"] = "Keys";s.Values["format"] = "Format";s.Values["stdin"] = "Stdin";s.Values["stdout"] = "Stdout";s.Values["stderr"] = "Stderr";s.Values["string"] = "String";s.Values["has"] = "Has";s.Values["isBool"] = "IsBool";s.Values["isInt"] = "IsInt";s.Values["isFloat"] = "IsFloat";s.Values["isString"] = "IsString";s.Values["error"] = "Error";s.Values["panic"] = "Panic";s.Values["open"] = "Open";s.Values["close"] = "Close";s.Values["args"] = "Args";s.Values["parseAST"] = "ParseAST";s.Values["parseInt"] = "ParseInt";s.Values["parseFloat"] = "ParseFloat";; return s }(); exports["builtin"] = _builtin;
-_flattenedStatements = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _itemType = a[0];
-var _listType = a[1];
-var _listProp = a[2];
-var _statements = a[3];
-				;
-				mml.Nop(_itemType, _listType, _listProp, _statements);
-				var _type interface{};
-var _toList interface{};
-mml.Nop(_type, _toList);
-_type = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _s)}).Values).(bool) && _contains.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "type"), &mml.List{Values: append([]interface{}{}, _itemType, _listType)})}).Values).(bool))
-			},
-			FixedArgs: 1,
-		};
-_toList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return func () interface{} { c = mml.BinaryOp(11, mml.Ref(_s, "type"), _itemType); if c.(bool) { return &mml.List{Values: append([]interface{}{}, _s)} } else { return mml.Ref(_s, _listProp) } }()
-			},
-			FixedArgs: 1,
-		};
-return _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _toList)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _type)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _statements)}).Values))}).Values))}).Values);
-				return nil
-			},
-			FixedArgs: 4,
-		}; exports["flattenedStatements"] = _flattenedStatements;
-_getModuleName = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _path = a[0];
-				;
-				mml.Nop(_path);
-				return _path
-			},
-			FixedArgs: 1,
-		}; exports["getModuleName"] = _getModuleName
+}
+func init() {
+	mml.Modules.Set("code.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _controlStatement interface{}
+		var _breakControl interface{}
+		var _continueControl interface{}
+		var _unaryOp interface{}
+		var _binaryNot interface{}
+		var _plus interface{}
+		var _minus interface{}
+		var _logicalNot interface{}
+		var _binaryOp interface{}
+		var _binaryAnd interface{}
+		var _binaryOr interface{}
+		var _xor interface{}
+		var _andNot interface{}
+		var _lshift interface{}
+		var _rshift interface{}
+		var _mul interface{}
+		var _div interface{}
+		var _mod interface{}
+		var _add interface{}
+		var _sub interface{}
+		var _eq interface{}
+		var _notEq interface{}
+		var _less interface{}
+		var _lessOrEq interface{}
+		var _greater interface{}
+		var _greaterOrEq interface{}
+		var _logicalAnd interface{}
+		var _logicalOr interface{}
+		var _builtin interface{}
+		var _flattenedStatements interface{}
+		var _getScope interface{}
+		var _findCode interface{}
+		var _interopAlias interface{}
+		var _goFileName interface{}
+		var _dirName interface{}
+		var _goFileNames interface{}
+		var _getModuleName interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _flat interface{}
+		var _join interface{}
+		var _formats interface{}
+		var _enum interface{}
+		var __lang = loader.Use("lang.mml")
+		_map = mml.Ref(mml.Pos{Path: "code.mml", Line: 1, Column: 5}, __lang, "map")
+		_filter = mml.Ref(mml.Pos{Path: "code.mml", Line: 1, Column: 5}, __lang, "filter")
+		_contains = mml.Ref(mml.Pos{Path: "code.mml", Line: 1, Column: 5}, __lang, "contains")
+		_flat = mml.Ref(mml.Pos{Path: "code.mml", Line: 1, Column: 5}, __lang, "flat")
+		_join = mml.Ref(mml.Pos{Path: "code.mml", Line: 1, Column: 5}, __lang, "join")
+		_formats = mml.Ref(mml.Pos{Path: "code.mml", Line: 1, Column: 5}, __lang, "formats")
+		_enum = mml.Ref(mml.Pos{Path: "code.mml", Line: 1, Column: 5}, __lang, "enum")
+//line code.mml:3:1
+		_controlStatement = _enum.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 4, Column: 19}, []interface{}{})
+		exports.Set("controlStatement", _controlStatement)
+		_breakControl = _controlStatement.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 5, Column: 19}, []interface{}{})
+		exports.Set("breakControl", _breakControl)
+		_continueControl = _controlStatement.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 6, Column: 19}, []interface{}{})
+		exports.Set("continueControl", _continueControl)
+//line code.mml:9:1
+		_unaryOp = _enum.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 10, Column: 13}, []interface{}{})
+		exports.Set("unaryOp", _unaryOp)
+		_binaryNot = _unaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 11, Column: 13}, []interface{}{})
+		exports.Set("binaryNot", _binaryNot)
+		_plus = _unaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 12, Column: 13}, []interface{}{})
+		exports.Set("plus", _plus)
+		_minus = _unaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 13, Column: 13}, []interface{}{})
+		exports.Set("minus", _minus)
+		_logicalNot = _unaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 14, Column: 13}, []interface{}{})
+		exports.Set("logicalNot", _logicalNot)
+//line code.mml:17:1
+		_binaryOp = _enum.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 18, Column: 14}, []interface{}{})
+		exports.Set("binaryOp", _binaryOp)
+		_binaryAnd = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 19, Column: 14}, []interface{}{})
+		exports.Set("binaryAnd", _binaryAnd)
+		_binaryOr = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 20, Column: 14}, []interface{}{})
+		exports.Set("binaryOr", _binaryOr)
+		_xor = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 21, Column: 14}, []interface{}{})
+		exports.Set("xor", _xor)
+		_andNot = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 22, Column: 14}, []interface{}{})
+		exports.Set("andNot", _andNot)
+		_lshift = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 23, Column: 14}, []interface{}{})
+		exports.Set("lshift", _lshift)
+		_rshift = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 24, Column: 14}, []interface{}{})
+		exports.Set("rshift", _rshift)
+		_mul = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 25, Column: 14}, []interface{}{})
+		exports.Set("mul", _mul)
+		_div = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 26, Column: 14}, []interface{}{})
+		exports.Set("div", _div)
+		_mod = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 27, Column: 14}, []interface{}{})
+		exports.Set("mod", _mod)
+		_add = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 28, Column: 14}, []interface{}{})
+		exports.Set("add", _add)
+		_sub = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 29, Column: 14}, []interface{}{})
+		exports.Set("sub", _sub)
+		_eq = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 30, Column: 14}, []interface{}{})
+		exports.Set("eq", _eq)
+		_notEq = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 31, Column: 14}, []interface{}{})
+		exports.Set("notEq", _notEq)
+		_less = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 32, Column: 14}, []interface{}{})
+		exports.Set("less", _less)
+		_lessOrEq = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 33, Column: 14}, []interface{}{})
+		exports.Set("lessOrEq", _lessOrEq)
+		_greater = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 34, Column: 14}, []interface{}{})
+		exports.Set("greater", _greater)
+		_greaterOrEq = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 35, Column: 14}, []interface{}{})
+		exports.Set("greaterOrEq", _greaterOrEq)
+		_logicalAnd = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 36, Column: 14}, []interface{}{})
+		exports.Set("logicalAnd", _logicalAnd)
+		_logicalOr = _binaryOp.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 37, Column: 14}, []interface{}{})
+		exports.Set("logicalOr", _logicalOr)
+//line code.mml:40:1
+		_builtin = mml.NewStruct(nil).With("len", "Len").With("runeLen", "RuneLen").With("runes", "Runes").With("runeAt", "RuneAt").With("runeSlice", "RuneSlice").With("codePoints", "CodePoints").With("fromCodePoints", "FromCodePoints").With("bytes", "Bytes").With("fromBytes", "FromBytes").With("isError", "IsError").With("keys", "Keys").With("format", "Format").With("stdin", "Stdin").With("stdout", "Stdout").With("stderr", "Stderr").With("string", "String").With("has", "Has").With("chan", "Chan").With("bufchan", "BufChan").With("isBool", "IsBool").With("isInt", "IsInt").With("isFloat", "IsFloat").With("isString", "IsString").With("error", "Error").With("panic", "Panic").With("open", "Open").With("create", "Create").With("openAppend", "OpenAppend").With("readLine", "ReadLine").With("readAll", "ReadAll").With("read", "Read").With("write", "Write").With("close", "Close").With("eof", "EOF").With("exec", "Exec").With("args", "Args").With("parseAST", "ParseAST").With("parseInt", "ParseInt").With("parseFloat", "ParseFloat")
+		exports.Set("builtin", _builtin)
+//line code.mml:82:1
+		_flattenedStatements = &mml.Function{F: func(a []interface{}) interface{} {
+			var _itemType = a[0]
+			var _listType = a[1]
+			var _listProp = a[2]
+			var _statements = a[3]
+			var _type interface{}
+			var _toList interface{}
+//line code.mml:83:2
+			_type = &mml.Function{F: func(a []interface{}) interface{} {
+				var _s = a[0]
+//line code.mml:84:13
+				return (_has.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 84, Column: 13}, []interface{}{"type", _s}).(bool) && _contains.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 84, Column: 31}, []interface{}{mml.Ref(mml.Pos{Path: "code.mml", Line: 84, Column: 40}, _s, "type"), mml.NewList(_itemType, _listType)}).(bool))
+			}, FixedArgs: 1}
+			_toList = &mml.Function{F: func(a []interface{}) interface{} {
+				var _s = a[0]
+//line code.mml:85:13
+				return func() interface{} {
+					if mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 85, Column: 13}, 11, mml.Ref(mml.Pos{Path: "code.mml", Line: 85, Column: 13}, _s, "type"), _itemType).(bool) {
+						return mml.NewList(_s)
+					}
+					return mml.Ref(mml.Pos{Path: "code.mml", Line: 85, Column: 40}, _s, _listProp)
+				}()
+			}, FixedArgs: 1}
+//line code.mml:88:2
+			return _flat.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 88, Column: 9}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 88, Column: 39}, []interface{}{_toList}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 88, Column: 23}, []interface{}{_type}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_statements})})})
+		}, FixedArgs: 4}
+		exports.Set("flattenedStatements", _flattenedStatements)
+//line code.mml:92:1
+		_getScope = &mml.Function{F: func(a []interface{}) interface{} {
+			var _statements = mml.NewList(a[0:]...)
+			var _defs interface{}
+			var _uses interface{}
+			var _inlineUses interface{}
+			var _namedUses interface{}
+			var _unnamedUses interface{}
+//line code.mml:93:2
+			_defs = _flattenedStatements.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 94, Column: 8}, []interface{}{"definition", "definition-list", "definitions", _statements})
+			_uses = _flattenedStatements.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 95, Column: 8}, []interface{}{"use", "use-list", "uses", _statements})
+//line code.mml:98:2
+			_inlineUses = _flat.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 98, Column: 17}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 101, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line code.mml:101:16
+				return mml.Ref(mml.Pos{Path: "code.mml", Line: 101, Column: 16}, _u, "exportNames")
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 100, Column: 5}, []interface{}{_has.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 100, Column: 12}, []interface{}{"exportNames"})}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 99, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line code.mml:99:19
+				return mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 99, Column: 19}, 11, mml.Ref(mml.Pos{Path: "code.mml", Line: 99, Column: 19}, _u, "capture"), ".")
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_uses})})})})
+//line code.mml:104:2
+			_namedUses = _filter.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 105, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line code.mml:105:19
+				return (mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 105, Column: 19}, 12, mml.Ref(mml.Pos{Path: "code.mml", Line: 105, Column: 19}, _u, "capture"), ".").(bool) && mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 105, Column: 39}, 12, mml.Ref(mml.Pos{Path: "code.mml", Line: 105, Column: 39}, _u, "capture"), "").(bool))
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 104, Column: 16}, []interface{}{_uses})
+//line code.mml:107:2
+			_unnamedUses = _filter.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 108, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line code.mml:108:19
+				return mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 108, Column: 19}, 11, mml.Ref(mml.Pos{Path: "code.mml", Line: 108, Column: 19}, _u, "capture"), "")
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 107, Column: 18}, []interface{}{_uses})
+//line code.mml:110:2
+			return _flat.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 110, Column: 9}, []interface{}{mml.NewList(_map.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 111, Column: 3}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _d = a[0]
+//line code.mml:111:14
+				return mml.Ref(mml.Pos{Path: "code.mml", Line: 111, Column: 14}, _d, "symbol")
+			}, FixedArgs: 1}, _defs}), _map.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 112, Column: 3}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line code.mml:112:14
+				return mml.Ref(mml.Pos{Path: "code.mml", Line: 112, Column: 14}, _u, "capture")
+			}, FixedArgs: 1}, _namedUses}), _map.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 113, Column: 3}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line code.mml:113:14
+				return mml.Ref(mml.Pos{Path: "code.mml", Line: 113, Column: 14}, _u, "path")
+			}, FixedArgs: 1}, _unnamedUses}), _inlineUses)})
+		}, FixedArgs: 0}
+		exports.Set("getScope", _getScope)
+//line code.mml:118:1
+		_findCode = &mml.Function{F: func(a []interface{}) interface{} {
+			var _type = a[0]
+			var _code = a[1]
+			var _found interface{}
+//line code.mml:119:2
+			if (((_isBool.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 119, Column: 5}, []interface{}{_code}).(bool) || _isInt.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 119, Column: 21}, []interface{}{_code}).(bool)) || _isFloat.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 119, Column: 36}, []interface{}{_code}).(bool)) || _isString.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 119, Column: 53}, []interface{}{_code}).(bool)) {
+//line code.mml:120:3
+				return mml.NewList()
+			}
+//line code.mml:123:2
+			if (_has.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 123, Column: 5}, []interface{}{"type", _code}).(bool) && mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 123, Column: 26}, 11, mml.Ref(mml.Pos{Path: "code.mml", Line: 123, Column: 26}, _code, "type"), _type).(bool)) {
+//line code.mml:124:3
+				return mml.NewList(_code)
+			}
+//line code.mml:127:2
+			_found = mml.NewList()
+//line code.mml:128:2
+			for __iter := mml.Iterate(mml.Pos{Path: "code.mml", Line: 128, Column: 6}, _code); __iter.Next(); {
+				_c := __iter.Value()
+//line code.mml:129:3
+				_found = mml.NewList().Concat(_found.(*mml.List)).Concat(_findCode.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 129, Column: 22}, []interface{}{_type, _c}).(*mml.List))
+			}
+//line code.mml:132:2
+			return _found
+		}, FixedArgs: 2}
+		exports.Set("findCode", _findCode)
+//line code.mml:138:1
+		_interopAlias = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _name interface{}
+//line code.mml:139:2
+			_name = mml.NewList()
+//line code.mml:140:2
+			for __iter := mml.Iterate(mml.Pos{Path: "code.mml", Line: 140, Column: 6}, _bytes.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 140, Column: 11}, []interface{}{_path})); __iter.Next(); {
+				_c := __iter.Value()
+				var _alnum bool
+//line code.mml:141:3
+				_alnum = (((mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 141, Column: 13}, 16, _c, 97).(bool) && mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 141, Column: 24}, 14, _c, 122).(bool)) || (mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 141, Column: 36}, 16, _c, 65).(bool) && mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 141, Column: 47}, 14, _c, 90).(bool))) || (mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 141, Column: 58}, 16, _c, 48).(bool) && mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 141, Column: 69}, 14, _c, 57).(bool)))
+//line code.mml:142:3
+				_name = mml.NewList().Concat(_name.(*mml.List)).Append(func() interface{} {
+					if _alnum {
+						return _fromBytes.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 142, Column: 28}, []interface{}{mml.NewList(_c)})
+					}
+					return _format.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 142, Column: 45}, []interface{}{"_%d_", mml.NewList(_c)})
+				}())
+			}
+//line code.mml:145:2
+			return mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 145, Column: 9}, 9, "__interop_", _join.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 145, Column: 24}, []interface{}{"", _name}))
+		}, FixedArgs: 1}
+		exports.Set("interopAlias", _interopAlias)
+//line code.mml:151:1
+		_goFileName = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _name interface{}
+//line code.mml:152:2
+			_name = mml.NewList()
+//line code.mml:153:2
+			for _i := 0; _i < _len.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 153, Column: 12}, []interface{}{_path}).(int); _i++ {
+				var _c interface{}
+				var _alnum bool
+				var _trimmed bool
+//line code.mml:154:3
+				_c = mml.Ref(mml.Pos{Path: "code.mml", Line: 154, Column: 9}, _path, _i)
+//line code.mml:155:3
+				_alnum = (((mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 155, Column: 13}, 16, _c, "a").(bool) && mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 155, Column: 25}, 14, _c, "z").(bool)) || (mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 155, Column: 37}, 16, _c, "A").(bool) && mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 155, Column: 49}, 14, _c, "Z").(bool))) || (mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 155, Column: 61}, 16, _c, "0").(bool) && mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 155, Column: 73}, 14, _c, "9").(bool)))
+//line code.mml:156:3
+				_trimmed = ((_len.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 156, Column: 15}, []interface{}{_name}).(int) == 0) && !_alnum)
+//line code.mml:157:3
+				_name = func() interface{} {
+					if _trimmed {
+						return _name
+					}
+					return mml.NewList().Concat(_name.(*mml.List)).Append(func() interface{} {
+						if (_alnum || mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 157, Column: 46}, 11, _c, ".").(bool)) {
+							return _c
+						}
+						return "_"
+					}())
+				}()
+			}
+//line code.mml:160:2
+			return mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 160, Column: 9}, 9, _join.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 160, Column: 9}, []interface{}{"", _name}), ".go")
+		}, FixedArgs: 1}
+		exports.Set("goFileName", _goFileName)
+//line code.mml:164:1
+		_dirName = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+//line code.mml:165:2
+			for _i := 0; _i < _len.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 165, Column: 12}, []interface{}{_path}).(int); _i++ {
+				var _at int
+//line code.mml:166:3
+				_at = ((_len.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 166, Column: 10}, []interface{}{_path}).(int) - 1) - _i)
+//line code.mml:167:3
+				if mml.BinaryOp(mml.Pos{Path: "code.mml", Line: 167, Column: 6}, 11, mml.Ref(mml.Pos{Path: "code.mml", Line: 167, Column: 6}, _path, _at), "/").(bool) {
+//line code.mml:168:4
+					return func() interface{} {
+						if _at == 0 {
+							return "/"
+						}
+						return mml.RefRange(mml.Pos{Path: "code.mml", Line: 168, Column: 27}, _path, nil, _at)
+					}()
+				}
+			}
+//line code.mml:172:2
+			return ""
+		}, FixedArgs: 1}
+		exports.Set("dirName", _dirName)
+//line code.mml:177:1
+		_goFileNames = &mml.Function{F: func(a []interface{}) interface{} {
+			var _paths = a[0]
+			var _names interface{}
+//line code.mml:178:2
+			_names = mml.NewList()
+//line code.mml:179:2
+			for __iter := mml.Iterate(mml.Pos{Path: "code.mml", Line: 179, Column: 6}, _map.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 179, Column: 14}, []interface{}{_goFileName, _paths})); __iter.Next(); {
+				_name := __iter.Value()
+				var _unique interface{}
+				var _n int
+//line code.mml:180:3
+				_unique = _name
+				_n = 1
+//line code.mml:185:3
+				for _contains.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 185, Column: 7}, []interface{}{_unique, _names}).(bool) {
+//line code.mml:186:4
+					_n = (_n + 1)
+//line code.mml:187:4
+					_unique = _formats.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 187, Column: 13}, []interface{}{"%s_%d.go", mml.RefRange(mml.Pos{Path: "code.mml", Line: 187, Column: 33}, _name, nil, (_len.(*mml.Function).CallAt(mml.Pos{Path: "code.mml", Line: 187, Column: 39}, []interface{}{_name}).(int) - 3)), _n})
+				}
+//line code.mml:190:3
+				_names = mml.NewList().Concat(_names.(*mml.List)).Append(_unique)
+			}
+//line code.mml:193:2
+			return _names
+		}, FixedArgs: 1}
+		exports.Set("goFileNames", _goFileNames)
+//line code.mml:197:1
+		_getModuleName = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+//line code.mml:197:31
+			return _path
+		}, FixedArgs: 1}
+		exports.Set("getModuleName", _getModuleName)
+//line main.go:1554
 		return exports
 	})
-modulePath = "parse.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _parseString interface{};
-var _spread interface{};
-var _expressionList interface{};
-var _list interface{};
-var _mutableList interface{};
-var _expressionKey interface{};
-var _entry interface{};
-var _struct interface{};
-var _mutableStruct interface{};
-var _statementList interface{};
-var _function interface{};
-var _effect interface{};
-var _symbolIndex interface{};
-var _expressionIndex interface{};
-var _indexer interface{};
-var _mutableCapture interface{};
-var _valueDefinition interface{};
-var _functionDefinition interface{};
-var _assign interface{};
-var _parseSend interface{};
-var _parseReceive interface{};
-var _parseGo interface{};
-var _parseDefer interface{};
-var _receiveDefinition interface{};
-var _symbol interface{};
-var _ret interface{};
-var _functionFact interface{};
-var _range interface{};
-var _rangeIndex interface{};
-var _indexerNodes interface{};
-var _application interface{};
-var _unary interface{};
-var _binary interface{};
-var _chaining interface{};
-var _ternary interface{};
-var _parseIf interface{};
-var _parseSwitch interface{};
-var _rangeOver interface{};
-var _loop interface{};
-var _valueCapture interface{};
-var _definitions interface{};
-var _mutableDefinitions interface{};
-var _functionCapture interface{};
-var _effectCapture interface{};
-var _effectDefinitions interface{};
-var _assignCaptures interface{};
-var _parseSelect interface{};
-var _parseExport interface{};
-var _useFact interface{};
-var _parseUse interface{};
-var _parse interface{};
-var _parseFile interface{};
-var _findExportNames interface{};
-var _parseModule interface{};
-var _modules interface{};
-var _code interface{};
-var _strings interface{};
-var _errors interface{};
-var _fold interface{};
-var _foldr interface{};
-var _map interface{};
-var _filter interface{};
-var _contains interface{};
-var _sort interface{};
-var _flat interface{};
-var _uniq interface{};
-var _join interface{};
-var _joins interface{};
-var _formats interface{};
-var _enum interface{};
-var _log interface{};
-var _onlyErr interface{};
-var _passErr interface{};
-mml.Nop(_parseString, _spread, _expressionList, _list, _mutableList, _expressionKey, _entry, _struct, _mutableStruct, _statementList, _function, _effect, _symbolIndex, _expressionIndex, _indexer, _mutableCapture, _valueDefinition, _functionDefinition, _assign, _parseSend, _parseReceive, _parseGo, _parseDefer, _receiveDefinition, _symbol, _ret, _functionFact, _range, _rangeIndex, _indexerNodes, _application, _unary, _binary, _chaining, _ternary, _parseIf, _parseSwitch, _rangeOver, _loop, _valueCapture, _definitions, _mutableDefinitions, _functionCapture, _effectCapture, _effectDefinitions, _assignCaptures, _parseSelect, _parseExport, _useFact, _parseUse, _parse, _parseFile, _findExportNames, _parseModule, _modules, _code, _strings, _errors, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _uniq, _join, _joins, _formats, _enum, _log, _onlyErr, _passErr);
-var __lang = mml.Modules.Use("lang.mml");;_fold = __lang.Values["fold"];
-_foldr = __lang.Values["foldr"];
-_map = __lang.Values["map"];
-_filter = __lang.Values["filter"];
-_contains = __lang.Values["contains"];
-_sort = __lang.Values["sort"];
-_flat = __lang.Values["flat"];
-_uniq = __lang.Values["uniq"];
-_join = __lang.Values["join"];
-_joins = __lang.Values["joins"];
-_formats = __lang.Values["formats"];
-_enum = __lang.Values["enum"];
-_log = __lang.Values["log"];
-_onlyErr = __lang.Values["onlyErr"];
-_passErr = __lang.Values["passErr"];
-_code = mml.Modules.Use("code.mml");
-_strings = mml.Modules.Use("strings.mml");
-_errors = mml.Modules.Use("errors.mml");
-_parseString = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return mml.Ref(_strings, "unescape").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(mml.Ref(_ast, "text"), 1, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "text"))}).Values), 1)))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_spread = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "spread";s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_expressionList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _nodes = a[0];
-				;
-				mml.Nop(_nodes);
-				return _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, _nodes)}).Values)
-			},
-			FixedArgs: 1,
-		};
-_list = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "list";s.Values["values"] = _expressionList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values);s.Values["mutable"] = false;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_mutableList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["mutable"] = true;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_expressionKey = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "expression-key";s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_entry = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "entry";s.Values["key"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_struct = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "struct";s.Values["entries"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values);s.Values["mutable"] = false;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_mutableStruct = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _struct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["mutable"] = true;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_statementList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "statement-list";s.Values["statements"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_function = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return _functionFact.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_effect = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _function.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["effect"] = true;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_symbolIndex = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name")
-			},
-			FixedArgs: 1,
-		};
-_expressionIndex = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_indexer = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return _indexerNodes.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_mutableCapture = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _valueCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values).(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["mutable"] = true;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_valueDefinition = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_functionDefinition = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_assign = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "assign-list";s.Values["assignments"] = _assignCaptures.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_parseSend = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "send";s.Values["channel"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_parseReceive = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "receive";s.Values["channel"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_parseGo = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "go";s.Values["application"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_parseDefer = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "defer";s.Values["application"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_receiveDefinition = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return _valueCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-			},
-			FixedArgs: 1,
-		};
-_symbol = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				;
-mml.Nop();
-switch mml.Ref(_ast, "text") {
-case "break":
-;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "control-statement";s.Values["control"] = mml.Ref(_code, "breakControl");; return s }()
-case "continue":
-;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "control-statement";s.Values["control"] = mml.Ref(_code, "continueControl");; return s }()
-default:
-;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "symbol";s.Values["name"] = mml.Ref(_ast, "text");; return s }()
-};
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_ret = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func () interface{} { c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 0); if c.(bool) { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "ret";; return s }() } else { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "ret";s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);; return s }() } }()
-			},
-			FixedArgs: 1,
-		};
-_functionFact = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _nodes = a[0];
-				;
-				mml.Nop(_nodes);
-				var _last interface{};
-var _params interface{};
-var _lastParam interface{};
-var _hasCollectParam interface{};
-var _fixedParams interface{};
-mml.Nop(_last, _params, _lastParam, _hasCollectParam, _fixedParams);
-_last = mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _nodes)}).Values), 1);
-_params = mml.RefRange(_nodes, nil, _last);
-_lastParam = mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _params)}).Values), 1);
-_hasCollectParam = (mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _params)}).Values), 0).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(_params, _lastParam), "name"), "collect-parameter").(bool));
-_fixedParams = func () interface{} { c = _hasCollectParam; if c.(bool) { return mml.RefRange(_params, nil, _lastParam) } else { return _params } }();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "function";s.Values["params"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _p = a[0];
-				;
-				mml.Nop(_p);
-				return mml.Ref(_p, "name")
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _fixedParams)}).Values))}).Values);s.Values["collectParam"] = func () interface{} { c = _hasCollectParam; if c.(bool) { return mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(mml.Ref(_params, _lastParam), "nodes"), 0))}).Values), "name") } else { return "" } }();s.Values["statement"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_nodes, _last))}).Values);s.Values["effect"] = false;; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_range = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _v interface{};
-mml.Nop(_v);
-_v = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);
-return func () interface{} { c = mml.BinaryOp(11, mml.Ref(_ast, "name"), "range-from"); if c.(bool) { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "range-expression";s.Values["from"] = _v;; return s }() } else { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "range-expression";s.Values["to"] = _v;; return s }() } }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_rangeIndex = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _r interface{};
-mml.Nop(_r);
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 0); if c.(bool) { ;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "range-expression";; return s }() };
-_r = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1); if c.(bool) { ;
-mml.Nop();
-return _r };
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _r.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["to"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values), "to");; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_indexerNodes = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _n = a[0];
-				;
-				mml.Nop(_n);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "indexer";s.Values["expression"] = func () interface{} { c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _n)}).Values), 2); if c.(bool) { return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, 0))}).Values) } else { return _indexerNodes.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_n, nil, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _n)}).Values), 1)))}).Values) } }();s.Values["index"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _n)}).Values), 1)))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_application = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "function-application";s.Values["function"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);s.Values["args"] = _expressionList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_unary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _op interface{};
-mml.Nop(_op);
-_op = mml.Ref(_code, "binaryNot");
-switch mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name") {
-case "plus":
-;
-mml.Nop();
-_op = mml.Ref(_code, "plus")
-case "minus":
-;
-mml.Nop();
-_op = mml.Ref(_code, "minus")
-case "logical-not":
-;
-mml.Nop();
-_op = mml.Ref(_code, "logicalNot")
-};
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "unary";s.Values["op"] = _op;s.Values["arg"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values);; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_binary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _op interface{};
-mml.Nop(_op);
-_op = mml.Ref(_code, "binaryAnd");
-switch mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 2)), "name") {
-case "xor":
-;
-mml.Nop();
-_op = mml.Ref(_code, "xor")
-case "and-not":
-;
-mml.Nop();
-_op = mml.Ref(_code, "andNot")
-case "lshift":
-;
-mml.Nop();
-_op = mml.Ref(_code, "lshift")
-case "rshift":
-;
-mml.Nop();
-_op = mml.Ref(_code, "rshift")
-case "mul":
-;
-mml.Nop();
-_op = mml.Ref(_code, "mul")
-case "div":
-;
-mml.Nop();
-_op = mml.Ref(_code, "div")
-case "mod":
-;
-mml.Nop();
-_op = mml.Ref(_code, "mod")
-case "add":
-;
-mml.Nop();
-_op = mml.Ref(_code, "add")
-case "sub":
-;
-mml.Nop();
-_op = mml.Ref(_code, "sub")
-case "eq":
-;
-mml.Nop();
-_op = mml.Ref(_code, "eq")
-case "not-eq":
-;
-mml.Nop();
-_op = mml.Ref(_code, "notEq")
-case "less":
-;
-mml.Nop();
-_op = mml.Ref(_code, "less")
-case "less-or-eq":
-;
-mml.Nop();
-_op = mml.Ref(_code, "lessOrEq")
-case "greater":
-;
-mml.Nop();
-_op = mml.Ref(_code, "greater")
-case "greater-or-eq":
-;
-mml.Nop();
-_op = mml.Ref(_code, "greaterOrEq")
-case "logical-and":
-;
-mml.Nop();
-_op = mml.Ref(_code, "logicalAnd")
-case "logical-or":
-;
-mml.Nop();
-_op = mml.Ref(_code, "logicalOr")
-};
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "binary";s.Values["op"] = _op;s.Values["left"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 3); if c.(bool) { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["name"] = mml.Ref(_ast, "name");s.Values["nodes"] = mml.RefRange(mml.Ref(_ast, "nodes"), nil, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 2));; return s }() } else { return mml.Ref(mml.Ref(_ast, "nodes"), 0) } }())}).Values);s.Values["right"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1)))}).Values);; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_chaining = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _a interface{};
-var _n interface{};
-mml.Nop(_a, _n);
-_a = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);
-_n = mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil);
-for  {
-;
-mml.Nop();
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _n)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-return _a };
-_a = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "function-application";s.Values["function"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_n, 0))}).Values);s.Values["args"] = &mml.List{Values: append([]interface{}{}, _a)};; return s }();
-_n = mml.RefRange(_n, 1, nil)
-};
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_ternary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "cond";s.Values["condition"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);s.Values["consequent"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values);s.Values["alternative"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 2))}).Values);s.Values["ternary"] = true;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_parseIf = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _cond interface{};
-var _alternative interface{};
-mml.Nop(_cond, _alternative);
-_cond = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "cond";s.Values["ternary"] = false;s.Values["condition"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);s.Values["consequent"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values);; return s }();
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 2); if c.(bool) { ;
-mml.Nop();
-return _cond };
-_alternative = func () interface{} { c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 3); if c.(bool) { return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 2))}).Values) } else { return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _ast.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["nodes"] = mml.RefRange(mml.Ref(_ast, "nodes"), 2, nil);; return s }())}).Values) } }();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _cond.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["alternative"] = _alternative;; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_parseSwitch = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _hasExpression interface{};
-var _expression interface{};
-var _nodes interface{};
-var _groupLines interface{};
-var _cases interface{};
-var _lines interface{};
-var _s interface{};
-mml.Nop(_hasExpression, _expression, _nodes, _groupLines, _cases, _lines, _s);
-_hasExpression = (mml.BinaryOp(12, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"), "case").(bool) && mml.BinaryOp(12, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"), "default").(bool));
-_expression = func () interface{} { c = _hasExpression; if c.(bool) { return mml.Ref(mml.Ref(_ast, "nodes"), 0) } else { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }() } }();
-_nodes = func () interface{} { c = _hasExpression; if c.(bool) { return mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil) } else { return mml.Ref(_ast, "nodes") } }();
-_groupLines = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				var _isDefault interface{};
-var _current interface{};
-var _cases interface{};
-var _defaults interface{};
-mml.Nop(_isDefault, _current, _cases, _defaults);
-_isDefault = false;
-_current = &mml.List{Values: []interface{}{}};
-_cases = &mml.List{Values: []interface{}{}};
-_defaults = &mml.List{Values: []interface{}{}};
-for _, _n := range _nodes.(*mml.List).Values {
-;
-mml.Nop();
-switch mml.Ref(_n, "name") {
-case "case":
-;
-mml.Nop();
-c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _current)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-c = _isDefault; if c.(bool) { ;
-mml.Nop();
-_defaults = _current } else { ;
-mml.Nop();
-_cases = &mml.List{Values: append(append([]interface{}{}, _cases.(*mml.List).Values...), _current)} } };
-_current = &mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_n, "nodes"), 0))};
-_isDefault = false
-case "default":
-;
-mml.Nop();
-c = (mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _current)}).Values), 0).(bool) && !_isDefault.(bool)); if c.(bool) { ;
-mml.Nop();
-_cases = &mml.List{Values: append(append([]interface{}{}, _cases.(*mml.List).Values...), _current)} };
-_current = &mml.List{Values: []interface{}{}};
-_isDefault = true
-default:
-;
-mml.Nop();
-_current = &mml.List{Values: append(append([]interface{}{}, _current.(*mml.List).Values...), _n)}
 }
-};
-c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _current)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-c = _isDefault; if c.(bool) { ;
-mml.Nop();
-_defaults = _current } else { ;
-mml.Nop();
-_cases = &mml.List{Values: append(append([]interface{}{}, _cases.(*mml.List).Values...), _current)} } };
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["cases"] = _cases;s.Values["defaults"] = _defaults;; return s }();
-				return nil
-			},
-			FixedArgs: 0,
-		};
-_cases = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				;
-mml.Nop();
-return _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "switch-case";s.Values["expression"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, 0))}).Values);s.Values["body"] = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "statement-list";s.Values["statements"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.RefRange(_c, 1, nil))}).Values);; return s }();; return s }()
-			},
-			FixedArgs: 1,
-		}, _c)}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_lines = _groupLines.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values);
-_s = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "switch-statement";s.Values["cases"] = _cases.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lines, "cases"))}).Values);s.Values["defaultStatements"] = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "statement-list";s.Values["statements"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_lines, "defaults"))}).Values);; return s }();; return s }();
-return func () interface{} { c = _hasExpression; if c.(bool) { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _s.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["expression"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _expression)}).Values);; return s }() } else { return _s } }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_rangeOver = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _expression interface{};
-mml.Nop(_expression);
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 0); if c.(bool) { ;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "range-over";; return s }() };
-c = (mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"), "symbol").(bool)); if c.(bool) { ;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "range-over";s.Values["symbol"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name");; return s }() };
-_expression = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _nodes = a[0];
-				;
-				mml.Nop(_nodes);
-				var _exp interface{};
-mml.Nop(_exp);
-_exp = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_nodes, 0))}).Values);
-c = ((!_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _exp)}).Values).(bool) || mml.BinaryOp(12, mml.Ref(_exp, "type"), "range-expression").(bool)) || mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _nodes)}).Values), 1).(bool)); if c.(bool) { ;
-mml.Nop();
-return _exp };
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _exp.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["to"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_nodes, 1))}).Values), "to");; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-c = mml.BinaryOp(12, mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name"), "symbol"); if c.(bool) { ;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "range-over";s.Values["expression"] = _expression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values);; return s }() };
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "range-over";s.Values["symbol"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name");s.Values["expression"] = _expression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil))}).Values);; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_loop = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _loop interface{};
-var _expression interface{};
-var _emptyRange interface{};
-mml.Nop(_loop, _expression, _emptyRange);
-_loop = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "loop";; return s }();
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values), 1); if c.(bool) { ;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _loop.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["body"] = _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);; return s }() };
-_expression = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);
-_emptyRange = (((_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _expression)}).Values).(bool) && mml.BinaryOp(11, mml.Ref(_expression, "type"), "range-over").(bool)) && !_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "symbol", _expression)}).Values).(bool)) && !_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", _expression)}).Values).(bool));
-return func () interface{} { c = _emptyRange; if c.(bool) { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _loop.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["body"] = _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values);; return s }() } else { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _loop.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["expression"] = _expression;s.Values["body"] = _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values);; return s }() } }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_valueCapture = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "definition";s.Values["symbol"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name");s.Values["expression"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values);s.Values["mutable"] = false;s.Values["exported"] = false;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_definitions = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "definition-list";s.Values["definitions"] = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return (!_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _c)}).Values).(bool) || mml.BinaryOp(12, mml.Ref(_c, "type"), "comment").(bool))
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "nodes"))}).Values))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_mutableDefinitions = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _dl interface{};
-mml.Nop(_dl);
-_dl = _definitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values);
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _dl.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["definitions"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0];
-				;
-				mml.Nop(_d);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _d.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["mutable"] = true;; return s }()
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_dl, "definitions"))}).Values);; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_functionCapture = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "definition";s.Values["symbol"] = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name");s.Values["expression"] = _functionFact.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(mml.Ref(_ast, "nodes"), 1, nil))}).Values);s.Values["mutable"] = false;s.Values["exported"] = false;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_effectCapture = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _f interface{};
-mml.Nop(_f);
-_f = _functionCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values);
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _f.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["expression"] = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := mml.Ref(_f, "expression").(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["effect"] = true;; return s }();; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_effectDefinitions = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _dl interface{};
-mml.Nop(_dl);
-_dl = _definitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values);
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _dl.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["definitions"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0];
-				;
-				mml.Nop(_d);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _d.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["effect"] = true;; return s }()
-			},
-			FixedArgs: 1,
-		}, mml.Ref(_dl, "definitions"))}).Values);; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_assignCaptures = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _nodes = a[0];
-				;
-				mml.Nop(_nodes);
-				;
-mml.Nop();
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _nodes)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-return &mml.List{Values: []interface{}{}} };
-return &mml.List{Values: append(append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "assign";s.Values["capture"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_nodes, 0))}).Values);s.Values["value"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_nodes, 1))}).Values);; return s }()), _assignCaptures.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.RefRange(_nodes, 2, nil))}).Values).(*mml.List).Values...)};
+func init() {
+	mml.Modules.Set("parse.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _parseString interface{}
+		var _spread interface{}
+		var _expressionList interface{}
+		var _list interface{}
+		var _mutableList interface{}
+		var _expressionKey interface{}
+		var _entry interface{}
+		var _struct interface{}
+		var _mutableStruct interface{}
+		var _statementList interface{}
+		var _function interface{}
+		var _effect interface{}
+		var _symbolIndex interface{}
+		var _expressionIndex interface{}
+		var _indexer interface{}
+		var _mutableCapture interface{}
+		var _valueDefinition interface{}
+		var _functionDefinition interface{}
+		var _assign interface{}
+		var _parseSend interface{}
+		var _parseReceive interface{}
+		var _parseGo interface{}
+		var _parseDefer interface{}
+		var _receiveDefinition interface{}
+		var _symbol interface{}
+		var _ret interface{}
+		var _functionFact interface{}
+		var _range interface{}
+		var _rangeIndex interface{}
+		var _indexerNodes interface{}
+		var _isInteropUse interface{}
+		var _application interface{}
+		var _unary interface{}
+		var _binary interface{}
+		var _chaining interface{}
+		var _ternary interface{}
+		var _parseIf interface{}
+		var _parseSwitch interface{}
+		var _rangeOver interface{}
+		var _loop interface{}
+		var _valueCapture interface{}
+		var _definitions interface{}
+		var _mutableDefinitions interface{}
+		var _functionCapture interface{}
+		var _effectCapture interface{}
+		var _effectDefinitions interface{}
+		var _assignCaptures interface{}
+		var _parseSelect interface{}
+		var _parseExport interface{}
+		var _useFact interface{}
+		var _parseUse interface{}
+		var _parseNode interface{}
+		var _position interface{}
+		var _parse interface{}
+		var _withPath interface{}
+		var _parseFile interface{}
+		var _findExportNames interface{}
+		var _parseModule interface{}
+		var _exists interface{}
+		var _modulePath interface{}
+		var _resolveUses interface{}
+		var _newContext interface{}
+		var _modules interface{}
+		var _input interface{}
+		var _code interface{}
+		var _strings interface{}
+		var _errors interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _flat interface{}
+		var _uniq interface{}
+		var _formats interface{}
+		var _passErr interface{}
+		var __lang = loader.Use("lang.mml")
+		_map = mml.Ref(mml.Pos{Path: "parse.mml", Line: 2, Column: 2}, __lang, "map")
+		_filter = mml.Ref(mml.Pos{Path: "parse.mml", Line: 2, Column: 2}, __lang, "filter")
+		_contains = mml.Ref(mml.Pos{Path: "parse.mml", Line: 2, Column: 2}, __lang, "contains")
+		_flat = mml.Ref(mml.Pos{Path: "parse.mml", Line: 2, Column: 2}, __lang, "flat")
+		_uniq = mml.Ref(mml.Pos{Path: "parse.mml", Line: 2, Column: 2}, __lang, "uniq")
+		_formats = mml.Ref(mml.Pos{Path: "parse.mml", Line: 2, Column: 2}, __lang, "formats")
+		_passErr = mml.Ref(mml.Pos{Path: "parse.mml", Line: 2, Column: 2}, __lang, "passErr")
+		_code = loader.Use("code.mml")
+		_strings = loader.Use("strings.mml")
+		_errors = loader.Use("errors.mml")
+//line parse.mml:8:1
+		_parseString = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:9:29
+			return mml.Ref(mml.Pos{Path: "parse.mml", Line: 9, Column: 29}, _strings, "unescape").(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 9, Column: 29}, []interface{}{mml.RefRange(mml.Pos{Path: "parse.mml", Line: 9, Column: 46}, mml.Ref(mml.Pos{}, _ast, "text"), 1, (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 9, Column: 57}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 9, Column: 61}, _ast, "text")}).(int) - 1))})
+		}, FixedArgs: 1}
+		_spread = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:10:29
+			return mml.NewStruct(nil).With("type", "spread").With("value", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 10, Column: 53}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 10, Column: 59}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}))
+		}, FixedArgs: 1}
+		_expressionList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _nodes = a[0]
+//line parse.mml:11:24
+			return _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 11, Column: 24}, []interface{}{_parse, _nodes})
+		}, FixedArgs: 1}
+		_list = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:12:29
+			return mml.NewStruct(nil).With("type", "list").With("values", _expressionList.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 12, Column: 52}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 12, Column: 67}, _ast, "nodes")})).With("mutable", false)
+		}, FixedArgs: 1}
+		_mutableList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:13:29
+			return mml.NewStruct(nil).Merge(_list.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 13, Column: 30}, []interface{}{_ast}).(*mml.Struct)).With("mutable", true)
+		}, FixedArgs: 1}
+		_expressionKey = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:14:29
+			return mml.NewStruct(nil).With("type", "expression-key").With("value", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 14, Column: 61}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 14, Column: 67}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}))
+		}, FixedArgs: 1}
+		_entry = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:15:29
+			return mml.NewStruct(nil).With("type", "entry").With("key", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 15, Column: 50}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 15, Column: 56}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})).With("value", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 15, Column: 78}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 15, Column: 84}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)}))
+		}, FixedArgs: 1}
+		_struct = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:16:29
+			return mml.NewStruct(nil).With("type", "struct").With("entries", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 16, Column: 55}, []interface{}{_parse, mml.Ref(mml.Pos{Path: "parse.mml", Line: 16, Column: 66}, _ast, "nodes")})).With("mutable", false)
+		}, FixedArgs: 1}
+		_mutableStruct = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:17:29
+			return mml.NewStruct(nil).Merge(_struct.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 17, Column: 30}, []interface{}{_ast}).(*mml.Struct)).With("mutable", true)
+		}, FixedArgs: 1}
+		_statementList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:18:29
+			return mml.NewStruct(nil).With("type", "statement-list").With("statements", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 18, Column: 66}, []interface{}{_parse, mml.Ref(mml.Pos{Path: "parse.mml", Line: 18, Column: 77}, _ast, "nodes")}))
+		}, FixedArgs: 1}
+		_function = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:19:29
+			return _functionFact.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 19, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 19, Column: 42}, _ast, "nodes")})
+		}, FixedArgs: 1}
+		_effect = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:20:29
+			return mml.NewStruct(nil).Merge(_function.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 20, Column: 30}, []interface{}{_ast}).(*mml.Struct)).With("effect", true)
+		}, FixedArgs: 1}
+		_symbolIndex = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:21:29
+			return mml.Ref(mml.Pos{Path: "parse.mml", Line: 21, Column: 29}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 21, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 21, Column: 35}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}), "name")
+		}, FixedArgs: 1}
+		_expressionIndex = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:22:29
+			return _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 22, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 22, Column: 35}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+		}, FixedArgs: 1}
+		_indexer = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:23:29
+			return _indexerNodes.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 23, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 23, Column: 42}, _ast, "nodes")})
+		}, FixedArgs: 1}
+		_mutableCapture = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:24:29
+			return mml.NewStruct(nil).Merge(_valueCapture.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 24, Column: 30}, []interface{}{_ast}).(*mml.Struct)).With("mutable", true)
+		}, FixedArgs: 1}
+		_valueDefinition = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:25:29
+			return _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 25, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 25, Column: 35}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+		}, FixedArgs: 1}
+		_functionDefinition = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:26:29
+			return _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 26, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 26, Column: 35}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+		}, FixedArgs: 1}
+		_assign = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:27:29
+			return mml.NewStruct(nil).With("type", "assign-list").With("assignments", _assignCaptures.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 27, Column: 64}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 27, Column: 79}, _ast, "nodes")}))
+		}, FixedArgs: 1}
+		_parseSend = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:28:29
+			return mml.NewStruct(nil).With("type", "send").With("channel", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 28, Column: 53}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 28, Column: 59}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})).With("value", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 28, Column: 81}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 28, Column: 87}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)}))
+		}, FixedArgs: 1}
+		_parseReceive = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:29:29
+			return mml.NewStruct(nil).With("type", "receive").With("channel", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 29, Column: 56}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 29, Column: 62}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}))
+		}, FixedArgs: 1}
+		_parseGo = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:30:29
+			return mml.NewStruct(nil).With("type", "go").With("application", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 30, Column: 55}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 30, Column: 61}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}))
+		}, FixedArgs: 1}
+		_parseDefer = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:31:29
+			return mml.NewStruct(nil).With("type", "defer").With("application", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 31, Column: 58}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 31, Column: 64}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}))
+		}, FixedArgs: 1}
+		_receiveDefinition = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:32:29
+			return _valueCapture.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 32, Column: 29}, []interface{}{_ast})
+		}, FixedArgs: 1}
+//line parse.mml:35:1
+		_symbol = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:36:2
+			switch mml.Ref(mml.Pos{Path: "parse.mml", Line: 36, Column: 9}, _ast, "text") {
+			case "break":
+//line parse.mml:38:3
+				return mml.NewStruct(nil).With("type", "control-statement").With("control", mml.Ref(mml.Pos{Path: "parse.mml", Line: 38, Column: 47}, _code, "breakControl"))
+			case "continue":
+//line parse.mml:40:3
+				return mml.NewStruct(nil).With("type", "control-statement").With("control", mml.Ref(mml.Pos{Path: "parse.mml", Line: 40, Column: 47}, _code, "continueControl"))
+			default:
+//line parse.mml:43:3
+				return mml.NewStruct(nil).With("type", "symbol").With("name", mml.Ref(mml.Pos{Path: "parse.mml", Line: 43, Column: 33}, _ast, "text"))
+			}
+			return nil
+		}, FixedArgs: 1}
+//line parse.mml:47:1
+		_ret = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:47:13
+			return func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 47, Column: 13}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 47, Column: 17}, _ast, "nodes")}).(int) == 0) {
+					return mml.NewStruct(nil).With("type", "ret")
+				}
+				return mml.NewStruct(nil).With("type", "ret").With("value", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 47, Column: 72}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 47, Column: 78}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}))
+			}()
+		}, FixedArgs: 1}
+//line parse.mml:49:1
+		_functionFact = &mml.Function{F: func(a []interface{}) interface{} {
+			var _nodes = a[0]
+			var _last int
+			var _params interface{}
+			var _lastParam int
+			var _hasCollectParam bool
+			var _fixedParams interface{}
+//line parse.mml:50:2
+			_last = (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 51, Column: 8}, []interface{}{_nodes}).(int) - 1)
+			_params = mml.RefRange(mml.Pos{Path: "parse.mml", Line: 52, Column: 10}, _nodes, nil, _last)
+			_lastParam = (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 53, Column: 13}, []interface{}{_params}).(int) - 1)
+			_hasCollectParam = ((_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 54, Column: 19}, []interface{}{_params}).(int) > 0) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 54, Column: 38}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 54, Column: 38}, mml.Ref(mml.Pos{}, _params, _lastParam), "name"), "collect-parameter").(bool))
+			_fixedParams = func() interface{} {
+				if _hasCollectParam {
+					return mml.RefRange(mml.Pos{Path: "parse.mml", Line: 55, Column: 33}, _params, nil, _lastParam)
+				}
+				return _params
+			}()
+//line parse.mml:58:2
+			return mml.NewStruct(nil).With("type", "function").With("params", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 60, Column: 46}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _p = a[0]
+//line parse.mml:60:57
+				return mml.Ref(mml.Pos{Path: "parse.mml", Line: 60, Column: 57}, _p, "name")
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 60, Column: 17}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 60, Column: 32}, []interface{}{_parse}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_fixedParams})})).With("collectParam", func() interface{} {
+				if _hasCollectParam {
+					return mml.Ref(mml.Pos{Path: "parse.mml", Line: 61, Column: 35}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 61, Column: 35}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 61, Column: 41}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 61, Column: 41}, mml.Ref(mml.Pos{}, _params, _lastParam), "nodes"), 0)}), "name")
+				}
+				return ""
+			}()).With("statement", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 62, Column: 17}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 62, Column: 23}, _nodes, _last)})).With("effect", false)
+		}, FixedArgs: 1}
+//line parse.mml:67:1
+		_range = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _v interface{}
+//line parse.mml:68:2
+			_v = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 68, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 68, Column: 16}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+//line parse.mml:69:2
+			return func() interface{} {
+				if mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 69, Column: 9}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 69, Column: 9}, _ast, "name"), "range-from").(bool) {
+					return mml.NewStruct(nil).With("type", "range-expression").With("from", _v)
+				}
+				return mml.NewStruct(nil).With("type", "range-expression").With("to", _v)
+			}()
+		}, FixedArgs: 1}
+//line parse.mml:78:1
+		_rangeIndex = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _r interface{}
+//line parse.mml:79:2
+			if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 79, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 79, Column: 9}, _ast, "nodes")}).(int) == 0) {
+//line parse.mml:80:3
+				return mml.NewStruct(nil).With("type", "range-expression")
+			}
+//line parse.mml:83:2
+			_r = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 83, Column: 8}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 83, Column: 14}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+//line parse.mml:84:2
+			if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 84, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 84, Column: 9}, _ast, "nodes")}).(int) == 1) {
+//line parse.mml:85:3
+				return _r
+			}
+//line parse.mml:88:2
+			return mml.NewStruct(nil).Merge(_r.(*mml.Struct)).With("to", mml.Ref(mml.Pos{Path: "parse.mml", Line: 88, Column: 20}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 88, Column: 20}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 88, Column: 26}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)}), "to"))
+		}, FixedArgs: 1}
+//line parse.mml:91:1
+		_indexerNodes = &mml.Function{F: func(a []interface{}) interface{} {
+			var _n = a[0]
+//line parse.mml:91:20
+			return mml.NewStruct(nil).With("type", "indexer").With("expression", func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 93, Column: 14}, []interface{}{_n}).(int) == 2) {
+					return _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 93, Column: 28}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 93, Column: 34}, _n, 0)})
+				}
+				return _indexerNodes.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 93, Column: 42}, []interface{}{mml.RefRange(mml.Pos{Path: "parse.mml", Line: 93, Column: 55}, _n, nil, (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 93, Column: 58}, []interface{}{_n}).(int) - 1))})
+			}()).With("index", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 94, Column: 14}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 94, Column: 20}, _n, (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 94, Column: 22}, []interface{}{_n}).(int) - 1))}))
+		}, FixedArgs: 1}
+//line parse.mml:98:1
+		_isInteropUse = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+//line parse.mml:99:2
+			return (((((_has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 99, Column: 2}, []interface{}{"type", _f}).(bool) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 100, Column: 2}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 100, Column: 2}, _f, "type"), "indexer").(bool)) && _has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 101, Column: 2}, []interface{}{"type", mml.Ref(mml.Pos{Path: "parse.mml", Line: 101, Column: 14}, _f, "expression")}).(bool)) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 102, Column: 2}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 102, Column: 2}, mml.Ref(mml.Pos{}, _f, "expression"), "type"), "symbol").(bool)) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 103, Column: 2}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 103, Column: 2}, mml.Ref(mml.Pos{}, _f, "expression"), "name"), "interop").(bool)) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 104, Column: 2}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 104, Column: 2}, _f, "index"), "use").(bool))
+		}, FixedArgs: 1}
+//line parse.mml:106:1
+		_application = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _function interface{}
+			var _args interface{}
+//line parse.mml:107:2
+			_function = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 108, Column: 12}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 108, Column: 18}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+			_args = _expressionList.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 109, Column: 12}, []interface{}{mml.RefRange(mml.Pos{Path: "parse.mml", Line: 109, Column: 27}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1, nil)})
+//line parse.mml:112:2
+			return func() interface{} {
+				if _isInteropUse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 112, Column: 9}, []interface{}{_function}).(bool) {
+					return mml.NewStruct(nil).With("type", "interop").With("args", _args)
+				}
+				return mml.NewStruct(nil).With("type", "function-application").With("function", _function).With("args", _args)
+			}()
+		}, FixedArgs: 1}
+//line parse.mml:122:1
+		_unary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _op interface{}
+//line parse.mml:123:2
+			_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 123, Column: 11}, _code, "binaryNot")
+//line parse.mml:124:2
+			switch mml.Ref(mml.Pos{Path: "parse.mml", Line: 124, Column: 9}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 124, Column: 9}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0), "name") {
+			case "plus":
+//line parse.mml:126:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 126, Column: 8}, _code, "plus")
+			case "minus":
+//line parse.mml:128:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 128, Column: 8}, _code, "minus")
+			case "logical-not":
+//line parse.mml:130:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 130, Column: 8}, _code, "logicalNot")
+			}
+//line parse.mml:133:2
+			return mml.NewStruct(nil).With("type", "unary").With("op", _op).With("arg", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 136, Column: 9}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 136, Column: 15}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)}))
+		}, FixedArgs: 1}
+//line parse.mml:140:1
+		_binary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _op interface{}
+//line parse.mml:141:2
+			_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 141, Column: 11}, _code, "binaryAnd")
+//line parse.mml:142:2
+			switch mml.Ref(mml.Pos{Path: "parse.mml", Line: 142, Column: 9}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 142, Column: 9}, mml.Ref(mml.Pos{}, _ast, "nodes"), (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 142, Column: 19}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 142, Column: 23}, _ast, "nodes")}).(int)-2)), "name") {
+			case "xor":
+//line parse.mml:144:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 144, Column: 8}, _code, "xor")
+			case "and-not":
+//line parse.mml:146:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 146, Column: 8}, _code, "andNot")
+			case "lshift":
+//line parse.mml:148:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 148, Column: 8}, _code, "lshift")
+			case "rshift":
+//line parse.mml:150:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 150, Column: 8}, _code, "rshift")
+			case "mul":
+//line parse.mml:152:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 152, Column: 8}, _code, "mul")
+			case "div":
+//line parse.mml:154:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 154, Column: 8}, _code, "div")
+			case "mod":
+//line parse.mml:156:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 156, Column: 8}, _code, "mod")
+			case "add":
+//line parse.mml:158:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 158, Column: 8}, _code, "add")
+			case "sub":
+//line parse.mml:160:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 160, Column: 8}, _code, "sub")
+			case "eq":
+//line parse.mml:162:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 162, Column: 8}, _code, "eq")
+			case "not-eq":
+//line parse.mml:164:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 164, Column: 8}, _code, "notEq")
+			case "less":
+//line parse.mml:166:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 166, Column: 8}, _code, "less")
+			case "less-or-eq":
+//line parse.mml:168:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 168, Column: 8}, _code, "lessOrEq")
+			case "greater":
+//line parse.mml:170:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 170, Column: 8}, _code, "greater")
+			case "greater-or-eq":
+//line parse.mml:172:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 172, Column: 8}, _code, "greaterOrEq")
+			case "logical-and":
+//line parse.mml:174:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 174, Column: 8}, _code, "logicalAnd")
+			case "logical-or":
+//line parse.mml:176:3
+				_op = mml.Ref(mml.Pos{Path: "parse.mml", Line: 176, Column: 8}, _code, "logicalOr")
+			}
+//line parse.mml:179:2
+			return mml.NewStruct(nil).With("type", "binary").With("op", _op).With("left", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 182, Column: 10}, []interface{}{func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 182, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 182, Column: 20}, _ast, "nodes")}).(int) > 3) {
+					return mml.NewStruct(nil).Merge(_ast.(*mml.Struct)).With("nodes", mml.RefRange(mml.Pos{Path: "parse.mml", Line: 185, Column: 12}, mml.Ref(mml.Pos{}, _ast, "nodes"), nil, (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 185, Column: 23}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 185, Column: 27}, _ast, "nodes")}).(int)-2)))
+				}
+				return mml.Ref(mml.Pos{Path: "parse.mml", Line: 187, Column: 4}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)
+			}()})).With("right", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 188, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 188, Column: 16}, mml.Ref(mml.Pos{}, _ast, "nodes"), (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 188, Column: 26}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 188, Column: 30}, _ast, "nodes")}).(int) - 1))}))
+		}, FixedArgs: 1}
+//line parse.mml:192:1
+		_chaining = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _a interface{}
+			var _n interface{}
+//line parse.mml:193:2
+			_a = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 194, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 194, Column: 13}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+			_n = mml.RefRange(mml.Pos{Path: "parse.mml", Line: 195, Column: 7}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1, nil)
+//line parse.mml:198:2
+			for {
+//line parse.mml:199:3
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 199, Column: 6}, []interface{}{_n}).(int) == 0) {
+//line parse.mml:200:4
+					return _a
+				}
+//line parse.mml:203:3
+				_a = mml.NewStruct(nil).With("type", "function-application").With("function", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 205, Column: 14}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 205, Column: 20}, _n, 0)})).With("args", mml.NewList(_a))
+//line parse.mml:209:3
+				_n = mml.RefRange(mml.Pos{Path: "parse.mml", Line: 209, Column: 7}, _n, 1, nil)
+			}
+			return nil
+		}, FixedArgs: 1}
+//line parse.mml:213:1
+		_ternary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:213:17
+			return mml.NewStruct(nil).With("type", "cond").With("condition", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 215, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 215, Column: 21}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})).With("consequent", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 216, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 216, Column: 21}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)})).With("alternative", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 217, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 217, Column: 21}, mml.Ref(mml.Pos{}, _ast, "nodes"), 2)})).With("ternary", true)
+		}, FixedArgs: 1}
+//line parse.mml:221:1
+		_parseIf = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _cond interface{}
+			var _alternative interface{}
+//line parse.mml:222:2
+			_cond = mml.NewStruct(nil).With("type", "cond").With("ternary", false).With("condition", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 225, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 225, Column: 21}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})).With("consequent", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 226, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 226, Column: 21}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)}))
+//line parse.mml:229:2
+			if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 229, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 229, Column: 9}, _ast, "nodes")}).(int) == 2) {
+//line parse.mml:230:3
+				return _cond
+			}
+//line parse.mml:233:2
+			_alternative = func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 233, Column: 18}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 233, Column: 22}, _ast, "nodes")}).(int) == 3) {
+					return _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 234, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 234, Column: 9}, mml.Ref(mml.Pos{}, _ast, "nodes"), 2)})
+				}
+				return _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 235, Column: 3}, []interface{}{mml.NewStruct(nil).Merge(_ast.(*mml.Struct)).With("nodes", mml.RefRange(mml.Pos{Path: "parse.mml", Line: 235, Column: 25}, mml.Ref(mml.Pos{}, _ast, "nodes"), 2, nil))})
+			}()
+//line parse.mml:237:2
+			return mml.NewStruct(nil).Merge(_cond.(*mml.Struct)).With("alternative", _alternative)
+		}, FixedArgs: 1}
+//line parse.mml:243:1
+		_parseSwitch = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _hasExpression bool
+			var _expression interface{}
+			var _nodes interface{}
+			var _groupLines interface{}
+			var _cases interface{}
+			var _lines interface{}
+			var _s interface{}
+//line parse.mml:244:2
+			_hasExpression = (mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 245, Column: 17}, 12, mml.Ref(mml.Pos{Path: "parse.mml", Line: 245, Column: 17}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 245, Column: 17}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0), "name"), "case").(bool) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 245, Column: 48}, 12, mml.Ref(mml.Pos{Path: "parse.mml", Line: 245, Column: 48}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 245, Column: 48}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0), "name"), "default").(bool))
+			_expression = func() interface{} {
+				if _hasExpression {
+					return mml.Ref(mml.Pos{Path: "parse.mml", Line: 246, Column: 33}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)
+				}
+				return mml.NewStruct(nil)
+			}()
+			_nodes = func() interface{} {
+				if _hasExpression {
+					return mml.RefRange(mml.Pos{Path: "parse.mml", Line: 247, Column: 33}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1, nil)
+				}
+				return mml.Ref(mml.Pos{Path: "parse.mml", Line: 247, Column: 49}, _ast, "nodes")
+			}()
+//line parse.mml:250:2
+			_groupLines = &mml.Function{F: func(a []interface{}) interface{} {
+				var _isDefault bool
+				var _current interface{}
+				var _cases interface{}
+				var _defaults interface{}
+//line parse.mml:251:3
+				_isDefault = false
+				_current = mml.NewList()
+				_cases = mml.NewList()
+				_defaults = mml.NewList()
+//line parse.mml:258:3
+				for __iter := mml.Iterate(mml.Pos{Path: "parse.mml", Line: 258, Column: 7}, _nodes); __iter.Next(); {
+					_n := __iter.Value()
+//line parse.mml:259:4
+					switch mml.Ref(mml.Pos{Path: "parse.mml", Line: 259, Column: 11}, _n, "name") {
+					case "case":
+//line parse.mml:261:5
+						if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 261, Column: 8}, []interface{}{_current}).(int) > 0) {
+//line parse.mml:262:6
+							if _isDefault {
+//line parse.mml:263:7
+								_defaults = _current
+							} else {
+//line parse.mml:265:7
+								_cases = mml.NewList().Concat(_cases.(*mml.List)).Append(_current)
+							}
+						}
+//line parse.mml:269:5
+						_current = mml.NewList(mml.Ref(mml.Pos{Path: "parse.mml", Line: 269, Column: 16}, mml.Ref(mml.Pos{}, _n, "nodes"), 0))
+//line parse.mml:270:5
+						_isDefault = false
+					case "default":
+//line parse.mml:272:5
+						if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 272, Column: 8}, []interface{}{_current}).(int) > 0) && !_isDefault {
+//line parse.mml:273:6
+							_cases = mml.NewList().Concat(_cases.(*mml.List)).Append(_current)
+						}
+//line parse.mml:276:5
+						_current = mml.NewList()
+//line parse.mml:277:5
+						_isDefault = true
+					default:
+//line parse.mml:279:5
+						_current = mml.NewList().Concat(_current.(*mml.List)).Append(_n)
+					}
+				}
+//line parse.mml:283:3
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 283, Column: 6}, []interface{}{_current}).(int) > 0) {
+//line parse.mml:284:4
+					if _isDefault {
+//line parse.mml:285:5
+						_defaults = _current
+					} else {
+//line parse.mml:287:5
+						_cases = mml.NewList().Concat(_cases.(*mml.List)).Append(_current)
+					}
+				}
+//line parse.mml:291:3
+				return mml.NewStruct(nil).With("cases", _cases).With("defaults", _defaults)
+			}, FixedArgs: 0}
+//line parse.mml:294:2
+			_cases = &mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+//line parse.mml:295:3
+				return _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 295, Column: 10}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+					var _c = a[0]
+//line parse.mml:295:21
+					return mml.NewStruct(nil).With("type", "switch-case").With("expression", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 297, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 297, Column: 22}, _c, 0)})).With("body", mml.NewStruct(nil).With("type", "statement-list").With("statements", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 300, Column: 17}, []interface{}{_parse, mml.RefRange(mml.Pos{Path: "parse.mml", Line: 300, Column: 28}, _c, 1, nil)})))
+				}, FixedArgs: 1}, _c})
+			}, FixedArgs: 1}
+//line parse.mml:305:2
+			_lines = _groupLines.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 305, Column: 12}, []interface{}{})
+//line parse.mml:306:2
+			_s = mml.NewStruct(nil).With("type", "switch-statement").With("cases", _cases.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 308, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 308, Column: 28}, _lines, "cases")})).With("defaultStatements", mml.NewStruct(nil).With("type", "statement-list").With("statements", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 309, Column: 59}, []interface{}{_parse, mml.Ref(mml.Pos{Path: "parse.mml", Line: 309, Column: 70}, _lines, "defaults")})))
+//line parse.mml:312:2
+			return func() interface{} {
+				if _hasExpression {
+					return mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("expression", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 312, Column: 44}, []interface{}{_expression}))
+				}
+				return _s
+			}()
+		}, FixedArgs: 1}
+//line parse.mml:315:1
+		_rangeOver = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _expression interface{}
+//line parse.mml:316:2
+			if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 316, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 316, Column: 9}, _ast, "nodes")}).(int) == 0) {
+//line parse.mml:317:3
+				return mml.NewStruct(nil).With("type", "range-over")
+			}
+//line parse.mml:320:2
+			if ((_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 320, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 320, Column: 9}, _ast, "nodes")}).(int) == 1) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 320, Column: 28}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 320, Column: 28}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 320, Column: 28}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0), "name"), "symbol").(bool)) {
+//line parse.mml:321:3
+				return mml.NewStruct(nil).With("type", "range-over").With("symbol", mml.Ref(mml.Pos{Path: "parse.mml", Line: 323, Column: 12}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 323, Column: 12}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 323, Column: 18}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}), "name"))
+			}
+//line parse.mml:327:2
+			_expression = &mml.Function{F: func(a []interface{}) interface{} {
+				var _nodes = a[0]
+				var _exp interface{}
+//line parse.mml:328:3
+				_exp = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 328, Column: 11}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 328, Column: 17}, _nodes, 0)})
+//line parse.mml:329:3
+				if (!_has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 329, Column: 7}, []interface{}{"type", _exp}).(bool) || mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 329, Column: 27}, 12, mml.Ref(mml.Pos{Path: "parse.mml", Line: 329, Column: 27}, _exp, "type"), "range-expression").(bool)) || (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 329, Column: 61}, []interface{}{_nodes}).(int) == 1) {
+//line parse.mml:330:4
+					return _exp
+				}
+//line parse.mml:333:3
+				return mml.NewStruct(nil).Merge(_exp.(*mml.Struct)).With("to", mml.Ref(mml.Pos{Path: "parse.mml", Line: 335, Column: 8}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 335, Column: 8}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 335, Column: 14}, _nodes, 1)}), "to"))
+			}, FixedArgs: 1}
+//line parse.mml:339:2
+			if mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 339, Column: 5}, 12, mml.Ref(mml.Pos{Path: "parse.mml", Line: 339, Column: 5}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 339, Column: 5}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0), "name"), "symbol").(bool) {
+//line parse.mml:340:3
+				return mml.NewStruct(nil).With("type", "range-over").With("expression", _expression.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 342, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 342, Column: 27}, _ast, "nodes")}))
+			}
+//line parse.mml:346:2
+			if ((_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 346, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 346, Column: 9}, _ast, "nodes")}).(int) > 2) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 346, Column: 27}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 346, Column: 27}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 346, Column: 27}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1), "name"), "symbol").(bool)) {
+//line parse.mml:347:3
+				return mml.NewStruct(nil).With("type", "range-over").With("key", mml.Ref(mml.Pos{Path: "parse.mml", Line: 349, Column: 16}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 349, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 349, Column: 22}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}), "name")).With("symbol", mml.Ref(mml.Pos{Path: "parse.mml", Line: 350, Column: 16}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 350, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 350, Column: 22}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)}), "name")).With("expression", _expression.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 351, Column: 16}, []interface{}{mml.RefRange(mml.Pos{Path: "parse.mml", Line: 351, Column: 27}, mml.Ref(mml.Pos{}, _ast, "nodes"), 2, nil)}))
+			}
+//line parse.mml:355:2
+			return mml.NewStruct(nil).With("type", "range-over").With("symbol", mml.Ref(mml.Pos{Path: "parse.mml", Line: 357, Column: 15}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 357, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 357, Column: 21}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}), "name")).With("expression", _expression.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 358, Column: 15}, []interface{}{mml.RefRange(mml.Pos{Path: "parse.mml", Line: 358, Column: 26}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1, nil)}))
+		}, FixedArgs: 1}
+//line parse.mml:362:1
+		_loop = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _loop interface{}
+			var _expression interface{}
+			var _emptyRange bool
+//line parse.mml:363:2
+			_loop = mml.NewStruct(nil).With("type", "loop")
+//line parse.mml:364:2
+			if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 364, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 364, Column: 9}, _ast, "nodes")}).(int) == 1) {
+//line parse.mml:365:3
+				return mml.NewStruct(nil).Merge(_loop.(*mml.Struct)).With("body", _statementList.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 365, Column: 26}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 365, Column: 40}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}))
+			}
+//line parse.mml:368:2
+			_expression = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 368, Column: 17}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 368, Column: 23}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+//line parse.mml:369:2
+			_emptyRange = (((_has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 370, Column: 3}, []interface{}{"type", _expression}).(bool) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 371, Column: 3}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 371, Column: 3}, _expression, "type"), "range-over").(bool)) && !_has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 372, Column: 4}, []interface{}{"symbol", _expression}).(bool)) && !_has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 373, Column: 4}, []interface{}{"expression", _expression}).(bool))
+//line parse.mml:375:2
+			return func() interface{} {
+				if _emptyRange {
+					return mml.NewStruct(nil).Merge(_loop.(*mml.Struct)).With("body", _statementList.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 376, Column: 19}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 376, Column: 33}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)}))
+				}
+				return mml.NewStruct(nil).Merge(_loop.(*mml.Struct)).With("expression", _expression).With("body", _statementList.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 377, Column: 43}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 377, Column: 57}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)}))
+			}()
+		}, FixedArgs: 1}
+//line parse.mml:380:1
+		_valueCapture = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:380:22
+			return mml.NewStruct(nil).With("type", "definition").With("symbol", mml.Ref(mml.Pos{Path: "parse.mml", Line: 382, Column: 14}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 382, Column: 14}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 382, Column: 20}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}), "name")).With("expression", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 383, Column: 14}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 383, Column: 20}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)})).With("mutable", false).With("exported", false)
+		}, FixedArgs: 1}
+//line parse.mml:388:1
+		_definitions = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:388:21
+			return mml.NewStruct(nil).With("type", "definition-list").With("definitions", _filter.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 392, Column: 6}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+//line parse.mml:392:20
+				return (!_has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 392, Column: 21}, []interface{}{"type", _c}).(bool) || mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 392, Column: 39}, 12, mml.Ref(mml.Pos{Path: "parse.mml", Line: 392, Column: 39}, _c, "type"), "comment").(bool))
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 390, Column: 15}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 391, Column: 6}, []interface{}{_parse}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 390, Column: 15}, _ast, "nodes")})}))
+		}, FixedArgs: 1}
+//line parse.mml:395:1
+		_mutableDefinitions = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _dl interface{}
+//line parse.mml:396:2
+			_dl = _definitions.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 396, Column: 9}, []interface{}{_ast})
+//line parse.mml:397:2
+			return mml.NewStruct(nil).Merge(_dl.(*mml.Struct)).With("definitions", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 399, Column: 34}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _d = a[0]
+//line parse.mml:399:45
+				return mml.NewStruct(nil).Merge(_d.(*mml.Struct)).With("mutable", true)
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 399, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 399, Column: 16}, _dl, "definitions")}))
+		}, FixedArgs: 1}
+//line parse.mml:403:1
+		_functionCapture = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:403:25
+			return mml.NewStruct(nil).With("type", "definition").With("symbol", mml.Ref(mml.Pos{Path: "parse.mml", Line: 405, Column: 14}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 405, Column: 14}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 405, Column: 20}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}), "name")).With("expression", _functionFact.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 406, Column: 14}, []interface{}{mml.RefRange(mml.Pos{Path: "parse.mml", Line: 406, Column: 27}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1, nil)})).With("mutable", false).With("exported", false)
+		}, FixedArgs: 1}
+//line parse.mml:411:1
+		_effectCapture = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _f interface{}
+//line parse.mml:412:2
+			_f = _functionCapture.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 412, Column: 8}, []interface{}{_ast})
+//line parse.mml:413:2
+			return mml.NewStruct(nil).Merge(_f.(*mml.Struct)).With("expression", mml.NewStruct(nil).Merge(mml.Ref(mml.Pos{Path: "parse.mml", Line: 415, Column: 16}, _f, "expression").(*mml.Struct)).With("effect", true))
+		}, FixedArgs: 1}
+//line parse.mml:419:1
+		_effectDefinitions = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _dl interface{}
+//line parse.mml:420:2
+			_dl = _definitions.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 420, Column: 9}, []interface{}{_ast})
+//line parse.mml:421:2
+			return mml.NewStruct(nil).Merge(_dl.(*mml.Struct)).With("definitions", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 423, Column: 16}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _d = a[0]
+//line parse.mml:423:27
+				return mml.NewStruct(nil).Merge(_d.(*mml.Struct)).With("effect", true)
+			}, FixedArgs: 1}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 423, Column: 49}, _dl, "definitions")}))
+		}, FixedArgs: 1}
+//line parse.mml:427:1
+		_assignCaptures = &mml.Function{F: func(a []interface{}) interface{} {
+			var _nodes = a[0]
+//line parse.mml:428:2
+			if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 428, Column: 5}, []interface{}{_nodes}).(int) == 0) {
+//line parse.mml:429:3
+				return mml.NewList()
+			}
+//line parse.mml:432:2
+			return mml.NewList(mml.NewStruct(nil).With("type", "assign").With("capture", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 435, Column: 13}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 435, Column: 19}, _nodes, 0)})).With("value", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 436, Column: 13}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 436, Column: 19}, _nodes, 1)}))).Concat(_assignCaptures.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 438, Column: 3}, []interface{}{mml.RefRange(mml.Pos{Path: "parse.mml", Line: 438, Column: 18}, _nodes, 2, nil)}).(*mml.List))
+		}, FixedArgs: 1}
+//line parse.mml:442:1
+		_parseSelect = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _nodes interface{}
+			var _groupLines interface{}
+			var _cases interface{}
+			var _lines interface{}
+//line parse.mml:443:2
+			_nodes = mml.Ref(mml.Pos{Path: "parse.mml", Line: 443, Column: 12}, _ast, "nodes")
+//line parse.mml:445:2
+			_groupLines = &mml.Function{F: func(a []interface{}) interface{} {
+				var _isDefault bool
+				var _hasDefault bool
+				var _current interface{}
+				var _cases interface{}
+				var _defaults interface{}
+//line parse.mml:446:3
+				_isDefault = false
+				_hasDefault = false
+				_current = mml.NewList()
+				_cases = mml.NewList()
+				_defaults = mml.NewList()
+//line parse.mml:454:3
+				for __iter := mml.Iterate(mml.Pos{Path: "parse.mml", Line: 454, Column: 7}, _nodes); __iter.Next(); {
+					_n := __iter.Value()
+//line parse.mml:455:4
+					switch mml.Ref(mml.Pos{Path: "parse.mml", Line: 455, Column: 11}, _n, "name") {
+					case "select-case":
+//line parse.mml:457:5
+						if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 457, Column: 8}, []interface{}{_current}).(int) > 0) {
+//line parse.mml:458:6
+							if _isDefault {
+//line parse.mml:459:7
+								_defaults = _current
+							} else {
+//line parse.mml:461:7
+								_cases = mml.NewList().Concat(_cases.(*mml.List)).Append(_current)
+							}
+						}
+//line parse.mml:465:5
+						_current = mml.NewList(mml.Ref(mml.Pos{Path: "parse.mml", Line: 465, Column: 16}, mml.Ref(mml.Pos{}, _n, "nodes"), 0))
+//line parse.mml:466:5
+						_isDefault = false
+					case "default":
+//line parse.mml:468:5
+						if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 468, Column: 8}, []interface{}{_current}).(int) > 0) && !_isDefault {
+//line parse.mml:469:6
+							_cases = mml.NewList().Concat(_cases.(*mml.List)).Append(_current)
+						}
+//line parse.mml:472:5
+						_current = mml.NewList()
+//line parse.mml:473:5
+						_isDefault = true
+//line parse.mml:474:5
+						_hasDefault = true
+					default:
+//line parse.mml:476:5
+						_current = mml.NewList().Concat(_current.(*mml.List)).Append(_n)
+					}
+				}
+//line parse.mml:480:3
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 480, Column: 6}, []interface{}{_current}).(int) > 0) {
+//line parse.mml:481:4
+					if _isDefault {
+//line parse.mml:482:5
+						_defaults = _current
+					} else {
+//line parse.mml:484:5
+						_cases = mml.NewList().Concat(_cases.(*mml.List)).Append(_current)
+					}
+				}
+//line parse.mml:488:3
+				return mml.NewStruct(nil).With("cases", _cases).With("defaults", _defaults).With("hasDefault", _hasDefault)
+			}, FixedArgs: 0}
+//line parse.mml:491:2
+			_cases = &mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+//line parse.mml:492:3
+				return _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 492, Column: 10}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+					var _c = a[0]
+//line parse.mml:492:21
+					return mml.NewStruct(nil).With("type", "select-case").With("expression", _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 494, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 494, Column: 22}, _c, 0)})).With("body", mml.NewStruct(nil).With("type", "statement-list").With("statements", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 497, Column: 17}, []interface{}{_parse, mml.RefRange(mml.Pos{Path: "parse.mml", Line: 497, Column: 28}, _c, 1, nil)})))
+				}, FixedArgs: 1}, _c})
+			}, FixedArgs: 1}
+//line parse.mml:502:2
+			_lines = _groupLines.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 502, Column: 12}, []interface{}{})
+//line parse.mml:503:2
+			return mml.NewStruct(nil).With("type", "select").With("cases", _cases.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 505, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 505, Column: 28}, _lines, "cases")})).With("defaultStatements", mml.NewStruct(nil).With("type", "statement-list").With("statements", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 506, Column: 59}, []interface{}{_parse, mml.Ref(mml.Pos{Path: "parse.mml", Line: 506, Column: 70}, _lines, "defaults")}))).With("hasDefault", mml.Ref(mml.Pos{Path: "parse.mml", Line: 507, Column: 22}, _lines, "hasDefault"))
+		}, FixedArgs: 1}
+//line parse.mml:511:1
+		_parseExport = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _d interface{}
+//line parse.mml:512:2
+			_d = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 512, Column: 8}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 512, Column: 14}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+//line parse.mml:513:2
+			return mml.NewStruct(nil).With("type", "definition-list").With("definitions", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 517, Column: 7}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _d = a[0]
+//line parse.mml:517:18
+				return mml.NewStruct(nil).Merge(_d.(*mml.Struct)).With("exported", true)
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 516, Column: 4}, []interface{}{func() interface{} {
+				if mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 516, Column: 5}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 516, Column: 5}, _d, "type"), "definition").(bool) {
+					return mml.NewList(_d)
+				}
+				return mml.Ref(mml.Pos{Path: "parse.mml", Line: 516, Column: 36}, _d, "definitions")
+			}()}))
+		}, FixedArgs: 1}
+//line parse.mml:521:1
+		_useFact = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _capture interface{}
+			var _path interface{}
+//line parse.mml:522:2
+			_capture = ""
+			_path = ""
+//line parse.mml:527:2
+			switch mml.Ref(mml.Pos{Path: "parse.mml", Line: 527, Column: 9}, mml.Ref(mml.Pos{Path: "parse.mml", Line: 527, Column: 9}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0), "name") {
+			case "use-inline":
+//line parse.mml:529:3
+				_capture = "."
+//line parse.mml:530:3
+				_path = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 530, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 530, Column: 16}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)})
+			case "symbol":
+//line parse.mml:532:3
+				_capture = mml.Ref(mml.Pos{Path: "parse.mml", Line: 532, Column: 13}, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 532, Column: 13}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 532, Column: 19}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)}), "name")
+//line parse.mml:533:3
+				_path = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 533, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 533, Column: 16}, mml.Ref(mml.Pos{}, _ast, "nodes"), 1)})
+			default:
+//line parse.mml:535:3
+				_path = _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 535, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 535, Column: 16}, mml.Ref(mml.Pos{}, _ast, "nodes"), 0)})
+			}
+//line parse.mml:538:2
+			return mml.NewStruct(nil).With("type", "use").With("capture", _capture).With("path", _path)
+		}, FixedArgs: 1}
+//line parse.mml:545:1
+		_parseUse = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:545:18
+			return mml.NewStruct(nil).With("type", "use-list").With("uses", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 547, Column: 8}, []interface{}{_parse, mml.Ref(mml.Pos{Path: "parse.mml", Line: 547, Column: 19}, _ast, "nodes")}))
+		}, FixedArgs: 1}
+//line parse.mml:550:1
+		_parseNode = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:551:2
+			switch mml.Ref(mml.Pos{Path: "parse.mml", Line: 551, Column: 9}, _ast, "name") {
+			case "line-comment-content":
+//line parse.mml:553:3
+				return mml.NewStruct(nil).With("type", "comment")
+			case "int":
+//line parse.mml:555:3
+				return _parseInt.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 555, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 555, Column: 19}, _ast, "text")})
+			case "float":
+//line parse.mml:557:3
+				return _parseFloat.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 557, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 557, Column: 21}, _ast, "text")})
+			case "string":
+//line parse.mml:559:3
+				return _parseString.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 559, Column: 10}, []interface{}{_ast})
+			case "true":
+//line parse.mml:561:3
+				return true
+			case "false":
+//line parse.mml:563:3
+				return false
+			case "symbol":
+//line parse.mml:565:3
+				return _symbol.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 565, Column: 10}, []interface{}{_ast})
+			case "spread-expression":
+//line parse.mml:567:3
+				return _spread.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 567, Column: 10}, []interface{}{_ast})
+			case "list":
+//line parse.mml:569:3
+				return _list.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 569, Column: 10}, []interface{}{_ast})
+			case "mutable-list":
+//line parse.mml:571:3
+				return _mutableList.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 571, Column: 10}, []interface{}{_ast})
+			case "expression-key":
+//line parse.mml:573:3
+				return _expressionKey.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 573, Column: 10}, []interface{}{_ast})
+			case "entry":
+//line parse.mml:575:3
+				return _entry.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 575, Column: 10}, []interface{}{_ast})
+			case "struct":
+//line parse.mml:577:3
+				return _struct.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 577, Column: 10}, []interface{}{_ast})
+			case "mutable-struct":
+//line parse.mml:579:3
+				return _mutableStruct.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 579, Column: 10}, []interface{}{_ast})
+			case "return":
+//line parse.mml:581:3
+				return _ret.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 581, Column: 10}, []interface{}{_ast})
+			case "block":
+//line parse.mml:583:3
+				return _statementList.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 583, Column: 10}, []interface{}{_ast})
+			case "function":
+//line parse.mml:585:3
+				return _function.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 585, Column: 10}, []interface{}{_ast})
+			case "effect":
+//line parse.mml:587:3
+				return _effect.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 587, Column: 10}, []interface{}{_ast})
+			case "range-from":
+//line parse.mml:589:3
+				return _range.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 589, Column: 10}, []interface{}{_ast})
+			case "range-to":
+//line parse.mml:591:3
+				return _range.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 591, Column: 10}, []interface{}{_ast})
+			case "symbol-index":
+//line parse.mml:593:3
+				return _symbolIndex.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 593, Column: 10}, []interface{}{_ast})
+			case "expression-index":
+//line parse.mml:595:3
+				return _expressionIndex.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 595, Column: 10}, []interface{}{_ast})
+			case "range-index":
+//line parse.mml:597:3
+				return _rangeIndex.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 597, Column: 10}, []interface{}{_ast})
+			case "indexer":
+//line parse.mml:599:3
+				return _indexer.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 599, Column: 10}, []interface{}{_ast})
+			case "function-application":
+//line parse.mml:601:3
+				return _application.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 601, Column: 10}, []interface{}{_ast})
+			case "unary-expression":
+//line parse.mml:603:3
+				return _unary.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 603, Column: 10}, []interface{}{_ast})
+			case "binary0":
+//line parse.mml:605:3
+				return _binary.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 605, Column: 10}, []interface{}{_ast})
+			case "binary1":
+//line parse.mml:607:3
+				return _binary.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 607, Column: 10}, []interface{}{_ast})
+			case "binary2":
+//line parse.mml:609:3
+				return _binary.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 609, Column: 10}, []interface{}{_ast})
+			case "binary3":
+//line parse.mml:611:3
+				return _binary.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 611, Column: 10}, []interface{}{_ast})
+			case "binary4":
+//line parse.mml:613:3
+				return _binary.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 613, Column: 10}, []interface{}{_ast})
+			case "chaining":
+//line parse.mml:615:3
+				return _chaining.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 615, Column: 10}, []interface{}{_ast})
+			case "ternary-expression":
+//line parse.mml:617:3
+				return _ternary.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 617, Column: 10}, []interface{}{_ast})
+			case "if":
+//line parse.mml:619:3
+				return _parseIf.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 619, Column: 10}, []interface{}{_ast})
+			case "switch":
+//line parse.mml:621:3
+				return _parseSwitch.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 621, Column: 10}, []interface{}{_ast})
+			case "range-over-expression":
+//line parse.mml:623:3
+				return _rangeOver.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 623, Column: 10}, []interface{}{_ast})
+			case "loop":
+//line parse.mml:625:3
+				return _loop.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 625, Column: 10}, []interface{}{_ast})
+			case "value-capture":
+//line parse.mml:627:3
+				return _valueCapture.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 627, Column: 10}, []interface{}{_ast})
+			case "mutable-capture":
+//line parse.mml:629:3
+				return _mutableCapture.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 629, Column: 10}, []interface{}{_ast})
+			case "value-definition":
+//line parse.mml:631:3
+				return _valueDefinition.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 631, Column: 10}, []interface{}{_ast})
+			case "value-definition-group":
+//line parse.mml:633:3
+				return _definitions.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 633, Column: 10}, []interface{}{_ast})
+			case "mutable-definition-group":
+//line parse.mml:635:3
+				return _mutableDefinitions.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 635, Column: 10}, []interface{}{_ast})
+			case "function-capture":
+//line parse.mml:637:3
+				return _functionCapture.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 637, Column: 10}, []interface{}{_ast})
+			case "effect-capture":
+//line parse.mml:639:3
+				return _effectCapture.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 639, Column: 10}, []interface{}{_ast})
+			case "function-definition":
+//line parse.mml:641:3
+				return _functionDefinition.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 641, Column: 10}, []interface{}{_ast})
+			case "function-definition-group":
+//line parse.mml:643:3
+				return _definitions.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 643, Column: 10}, []interface{}{_ast})
+			case "effect-definition-group":
+//line parse.mml:645:3
+				return _effectDefinitions.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 645, Column: 10}, []interface{}{_ast})
+			case "assignment":
+//line parse.mml:647:3
+				return _assign.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 647, Column: 10}, []interface{}{_ast})
+			case "send":
+//line parse.mml:649:3
+				return _parseSend.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 649, Column: 10}, []interface{}{_ast})
+			case "receive":
+//line parse.mml:651:3
+				return _parseReceive.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 651, Column: 10}, []interface{}{_ast})
+			case "go":
+//line parse.mml:653:3
+				return _parseGo.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 653, Column: 10}, []interface{}{_ast})
+			case "defer":
+//line parse.mml:655:3
+				return _parseDefer.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 655, Column: 10}, []interface{}{_ast})
+			case "receive-definition":
+//line parse.mml:657:3
+				return _receiveDefinition.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 657, Column: 10}, []interface{}{_ast})
+			case "select":
+//line parse.mml:659:3
+				return _parseSelect.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 659, Column: 10}, []interface{}{_ast})
+			case "export":
+//line parse.mml:661:3
+				return _parseExport.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 661, Column: 10}, []interface{}{_ast})
+			case "use-fact":
+//line parse.mml:663:3
+				return _useFact.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 663, Column: 10}, []interface{}{_ast})
+			case "use":
+//line parse.mml:665:3
+				return _parseUse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 665, Column: 10}, []interface{}{_ast})
+			default:
+//line parse.mml:667:3
+				return _statementList.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 667, Column: 10}, []interface{}{_ast})
+			}
+			return nil
+		}, FixedArgs: 1}
+//line parse.mml:671:1
+		_position = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+//line parse.mml:671:18
+			return mml.NewStruct(nil).With("path", mml.Ref(mml.Pos{Path: "parse.mml", Line: 672, Column: 10}, _ast, "path")).With("line", mml.Ref(mml.Pos{Path: "parse.mml", Line: 673, Column: 10}, _ast, "line")).With("column", mml.Ref(mml.Pos{Path: "parse.mml", Line: 674, Column: 10}, _ast, "column"))
+		}, FixedArgs: 1}
+//line parse.mml:677:1
+		_parse = &mml.Function{F: func(a []interface{}) interface{} {
+			var _ast = a[0]
+			var _code interface{}
+//line parse.mml:678:2
+			_code = _parseNode.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 678, Column: 11}, []interface{}{_ast})
+//line parse.mml:679:2
+			return func() interface{} {
+				if (_has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 679, Column: 9}, []interface{}{"type", _code}).(bool) && _has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 679, Column: 30}, []interface{}{"line", _ast}).(bool)) {
+					return mml.NewStruct(nil).Merge(_code.(*mml.Struct)).With("pos", _position.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 679, Column: 64}, []interface{}{_ast}))
+				}
+				return _code
+			}()
+		}, FixedArgs: 1}
+//line parse.mml:682:1
+		_withPath = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _ast = a[1]
+//line parse.mml:682:24
+			return mml.NewStruct(nil).Merge(_ast.(*mml.Struct)).With("path", _path).With("nodes", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 685, Column: 9}, []interface{}{_withPath.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 685, Column: 13}, []interface{}{_path}), mml.Ref(mml.Pos{Path: "parse.mml", Line: 685, Column: 29}, _ast, "nodes")}))
+		}, FixedArgs: 2}
+//line parse.mml:688:1
+		_parseFile = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _in interface{}
+			var _ast interface{}
+//line parse.mml:689:2
+			_in = _open.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 689, Column: 9}, []interface{}{_path})
+//line parse.mml:690:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 690, Column: 5}, []interface{}{_in}).(bool) {
+//line parse.mml:691:3
+				return _in
+			}
+//line parse.mml:694:2
+			defer _close.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 694, Column: 8}, []interface{}{_in})
+//line parse.mml:696:2
+			_ast = _passErr.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 696, Column: 20}, []interface{}{_parseAST}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 696, Column: 10}, []interface{}{_in.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 696, Column: 10}, []interface{}{-1})})
+//line parse.mml:697:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 697, Column: 5}, []interface{}{_ast}).(bool) {
+//line parse.mml:698:3
+				return _ast
+			}
+//line parse.mml:701:2
+			return _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 701, Column: 9}, []interface{}{_withPath.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 701, Column: 16}, []interface{}{_path}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_ast})})
+		}, FixedArgs: 1}
+//line parse.mml:707:1
+		_findExportNames = &mml.Function{F: func(a []interface{}) interface{} {
+			var _statements = a[0]
+//line parse.mml:708:2
+			return _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 711, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _d = a[0]
+//line parse.mml:711:16
+				return mml.Ref(mml.Pos{Path: "parse.mml", Line: 711, Column: 16}, _d, "symbol")
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 708, Column: 2}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 710, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _d = a[0]
+//line parse.mml:710:19
+				return mml.Ref(mml.Pos{Path: "parse.mml", Line: 710, Column: 19}, _d, "exported")
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 709, Column: 5}, _code, "flattenedStatements").(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 709, Column: 5}, []interface{}{"definition", "definition-list", "definitions"}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_statements})})})
+		}, FixedArgs: 1}
+//line parse.mml:713:1
+		_parseModule = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _entryPath = a[1]
+			var _module interface{}
+			var _modules interface{}
+//line parse.mml:718:2
+			if _contains.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 718, Column: 5}, []interface{}{_entryPath, mml.Ref(mml.Pos{Path: "parse.mml", Line: 718, Column: 25}, _context, "stack")}).(bool) {
+//line parse.mml:719:3
+				return _error.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 719, Column: 10}, []interface{}{_formats.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 719, Column: 16}, []interface{}{"circular module dependency: %s", _entryPath})})
+			}
+//line parse.mml:722:2
+			if _has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 722, Column: 5}, []interface{}{_entryPath, mml.Ref(mml.Pos{Path: "parse.mml", Line: 722, Column: 20}, _context, "parsed")}).(bool) {
+//line parse.mml:723:3
+				return mml.Ref(mml.Pos{Path: "parse.mml", Line: 723, Column: 10}, mml.Ref(mml.Pos{}, _context, "parsed"), _entryPath)
+			}
+//line parse.mml:726:2
+			_module = _parseFile.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 726, Column: 13}, []interface{}{_entryPath})
+//line parse.mml:727:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 727, Column: 5}, []interface{}{_module}).(bool) {
+//line parse.mml:728:3
+				return _module
+			}
+//line parse.mml:731:2
+			_modules = _resolveUses.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 731, Column: 14}, []interface{}{_context, _entryPath, _module})
+//line parse.mml:732:2
+			if !_isError.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 732, Column: 6}, []interface{}{_modules}).(bool) {
+//line parse.mml:733:3
+				mml.SetRef(mml.Pos{Path: "parse.mml", Line: 733, Column: 3}, mml.Ref(mml.Pos{}, _context, "parsed"), _entryPath, _modules)
+			}
+//line parse.mml:736:2
+			return _modules
+		}, FixedArgs: 2}
+//line parse.mml:739:1
+		_exists = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _f interface{}
+//line parse.mml:740:2
+			_f = _open.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 740, Column: 8}, []interface{}{_path})
+//line parse.mml:741:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 741, Column: 5}, []interface{}{_f}).(bool) {
+//line parse.mml:742:3
+				return false
+			}
+//line parse.mml:745:2
+			_close.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 745, Column: 2}, []interface{}{_f})
+//line parse.mml:746:2
+			return true
+		}, FixedArgs: 1}
+//line parse.mml:753:1
+		_modulePath = &mml.Function{F: func(a []interface{}) interface{} {
+			var _root = a[0]
+			var _path = a[1]
+			var _resolved interface{}
+//line parse.mml:754:2
+			if (mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 754, Column: 5}, 11, _root, "").(bool) || ((_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 754, Column: 19}, []interface{}{_path}).(int) > 0) && mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 754, Column: 36}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 754, Column: 36}, _path, 0), "/").(bool))) {
+//line parse.mml:755:3
+				return mml.NewStruct(nil).With("path", mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 755, Column: 17}, 9, _path, ".mml")).With("root", _root)
+			}
+//line parse.mml:758:2
+			_resolved = mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 758, Column: 15}, 9, mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 758, Column: 15}, 9, mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 758, Column: 15}, 9, _root, "/"), _path), ".mml")
+//line parse.mml:759:2
+			return func() interface{} {
+				if _exists.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 759, Column: 9}, []interface{}{_resolved}).(bool) {
+					return mml.NewStruct(nil).With("path", _resolved).With("root", _root)
+				}
+				return mml.NewStruct(nil).With("path", mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 759, Column: 66}, 9, _path, ".mml")).With("root", "")
+			}()
+		}, FixedArgs: 2}
+//line parse.mml:764:1
+		_resolveUses = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _entryPath = a[1]
+			var _module = a[2]
+			var _root interface{}
+			var _resolve interface{}
+			var _uses interface{}
+			var _usesModules interface{}
+			var _withExports interface{}
+			var _statements interface{}
+			var _currentCode interface{}
+//line parse.mml:765:2
+			_root = func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 765, Column: 11}, []interface{}{_entryPath, mml.Ref(mml.Pos{Path: "parse.mml", Line: 765, Column: 26}, _context, "roots")}).(bool) {
+					return mml.Ref(mml.Pos{Path: "parse.mml", Line: 765, Column: 43}, mml.Ref(mml.Pos{}, _context, "roots"), _entryPath)
+				}
+				return ""
+			}()
+//line parse.mml:766:2
+			_resolve = &mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+				var _m interface{}
+//line parse.mml:767:3
+				_m = _modulePath.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 767, Column: 9}, []interface{}{_root, mml.Ref(mml.Pos{Path: "parse.mml", Line: 767, Column: 26}, _u, "path")})
+//line parse.mml:768:3
+				mml.SetRef(mml.Pos{Path: "parse.mml", Line: 768, Column: 3}, mml.Ref(mml.Pos{}, _context, "roots"), mml.Ref(mml.Pos{Path: "parse.mml", Line: 768, Column: 16}, _m, "path"), mml.Ref(mml.Pos{Path: "parse.mml", Line: 768, Column: 27}, _m, "root"))
+//line parse.mml:769:3
+				return mml.NewStruct(nil).Merge(_u.(*mml.Struct)).With("modulePath", mml.Ref(mml.Pos{Path: "parse.mml", Line: 769, Column: 29}, _m, "path"))
+			}, FixedArgs: 1}
+//line parse.mml:772:2
+			_uses = _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 772, Column: 85}, []interface{}{_resolve}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 772, Column: 11}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 772, Column: 11}, _code, "flattenedStatements").(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 772, Column: 11}, []interface{}{"use", "use-list", "uses", mml.Ref(mml.Pos{Path: "parse.mml", Line: 772, Column: 63}, _module, "statements")})})
+//line parse.mml:774:2
+			mml.SetRef(mml.Pos{Path: "parse.mml", Line: 774, Column: 2}, _context, "stack", mml.NewList().Concat(mml.Ref(mml.Pos{Path: "parse.mml", Line: 774, Column: 19}, _context, "stack").(*mml.List)).Append(_entryPath))
+//line parse.mml:775:2
+			_usesModules = _passErr.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 786, Column: 5}, []interface{}{_uniq.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 786, Column: 13}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _left = a[0]
+				var _right = a[1]
+//line parse.mml:786:35
+				return mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 786, Column: 35}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 786, Column: 35}, _left, "path"), mml.Ref(mml.Pos{Path: "parse.mml", Line: 786, Column: 48}, _right, "path"))
+			}, FixedArgs: 2}})}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 775, Column: 18}, []interface{}{_passErr.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 780, Column: 5}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 780, Column: 13}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _m = a[0]
+//line parse.mml:780:24
+				return mml.NewStruct(nil).With("type", mml.Ref(mml.Pos{Path: "parse.mml", Line: 781, Column: 9}, _m, "type")).With("path", mml.Ref(mml.Pos{Path: "parse.mml", Line: 782, Column: 9}, _m, "path")).With("statements", mml.Ref(mml.Pos{Path: "parse.mml", Line: 783, Column: 15}, _m, "statements")).With("exportNames", _findExportNames.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 784, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 784, Column: 32}, _m, "statements")}))
+			}, FixedArgs: 1}})}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_passErr.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 779, Column: 5}, []interface{}{_flat}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 778, Column: 5}, _errors, "any").(*mml.Function).CallAt(mml.Pos{}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 777, Column: 5}, []interface{}{_parseModule.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 777, Column: 9}, []interface{}{_context})}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 776, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line parse.mml:776:16
+				return mml.Ref(mml.Pos{Path: "parse.mml", Line: 776, Column: 16}, _u, "modulePath")
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_uses})})})})})})
+//line parse.mml:787:2
+			mml.SetRef(mml.Pos{Path: "parse.mml", Line: 787, Column: 2}, _context, "stack", mml.RefRange(mml.Pos{Path: "parse.mml", Line: 787, Column: 18}, mml.Ref(mml.Pos{}, _context, "stack"), nil, (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 787, Column: 33}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 787, Column: 37}, _context, "stack")}).(int)-1)))
+//line parse.mml:789:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 789, Column: 5}, []interface{}{_usesModules}).(bool) {
+//line parse.mml:790:3
+				return _usesModules
+			}
+//line parse.mml:793:2
+			_withExports = &mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+				var _resolved interface{}
+				var _m interface{}
+//line parse.mml:794:3
+				_resolved = _resolve.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 794, Column: 16}, []interface{}{_u})
+//line parse.mml:795:3
+				_m = _filter.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 795, Column: 9}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+					var _m = a[0]
+//line parse.mml:795:23
+					return mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 795, Column: 23}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 795, Column: 23}, _m, "path"), mml.Ref(mml.Pos{Path: "parse.mml", Line: 795, Column: 33}, _resolved, "modulePath"))
+				}, FixedArgs: 1}, _usesModules})
+//line parse.mml:796:3
+				return func() interface{} {
+					if (_len.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 796, Column: 10}, []interface{}{_m}).(int) == 0) {
+						return _resolved
+					}
+					return mml.NewStruct(nil).Merge(_resolved.(*mml.Struct)).With("exportNames", mml.Ref(mml.Pos{Path: "parse.mml", Line: 796, Column: 62}, mml.Ref(mml.Pos{}, _m, 0), "exportNames"))
+				}()
+			}, FixedArgs: 1}
+//line parse.mml:799:2
+			_statements = _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 800, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _s = a[0]
+//line parse.mml:801:3
+				switch {
+				case !_has.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 802, Column: 9}, []interface{}{"type", _s}).(bool):
+//line parse.mml:803:4
+					return _s
+				case mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 804, Column: 8}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 804, Column: 8}, _s, "type"), "use"):
+//line parse.mml:805:4
+					return _withExports.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 805, Column: 11}, []interface{}{_s})
+				case mml.BinaryOp(mml.Pos{Path: "parse.mml", Line: 806, Column: 8}, 11, mml.Ref(mml.Pos{Path: "parse.mml", Line: 806, Column: 8}, _s, "type"), "use-list"):
+//line parse.mml:807:4
+					return mml.NewStruct(nil).With("type", mml.Ref(mml.Pos{Path: "parse.mml", Line: 807, Column: 18}, _s, "type")).With("uses", _map.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 807, Column: 32}, []interface{}{_withExports, mml.Ref(mml.Pos{Path: "parse.mml", Line: 807, Column: 49}, _s, "uses")}))
+				default:
+//line parse.mml:809:4
+					return _s
+				}
 				return nil
-			},
-			FixedArgs: 1,
-		};
-_parseSelect = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _nodes interface{};
-var _groupLines interface{};
-var _cases interface{};
-var _lines interface{};
-mml.Nop(_nodes, _groupLines, _cases, _lines);
-_nodes = mml.Ref(_ast, "nodes");
-_groupLines = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				var _isDefault interface{};
-var _hasDefault interface{};
-var _current interface{};
-var _cases interface{};
-var _defaults interface{};
-mml.Nop(_isDefault, _hasDefault, _current, _cases, _defaults);
-_isDefault = false;
-_hasDefault = false;
-_current = &mml.List{Values: []interface{}{}};
-_cases = &mml.List{Values: []interface{}{}};
-_defaults = &mml.List{Values: []interface{}{}};
-for _, _n := range _nodes.(*mml.List).Values {
-;
-mml.Nop();
-switch mml.Ref(_n, "name") {
-case "case":
-;
-mml.Nop();
-c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _current)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-c = _isDefault; if c.(bool) { ;
-mml.Nop();
-_defaults = _current } else { ;
-mml.Nop();
-_cases = &mml.List{Values: append(append([]interface{}{}, _cases.(*mml.List).Values...), _current)} } };
-_current = &mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_n, "nodes"), 0))};
-_isDefault = false
-case "default":
-;
-mml.Nop();
-c = (mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _current)}).Values), 0).(bool) && !_isDefault.(bool)); if c.(bool) { ;
-mml.Nop();
-_cases = &mml.List{Values: append(append([]interface{}{}, _cases.(*mml.List).Values...), _current)} };
-_current = &mml.List{Values: []interface{}{}};
-_isDefault = true;
-_hasDefault = true
-default:
-;
-mml.Nop();
-_current = &mml.List{Values: append(append([]interface{}{}, _current.(*mml.List).Values...), _n)}
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 799, Column: 17}, []interface{}{mml.Ref(mml.Pos{Path: "parse.mml", Line: 799, Column: 17}, _module, "statements")})
+//line parse.mml:813:2
+			_currentCode = mml.NewStruct(nil).Merge(_module.(*mml.Struct)).With("path", _entryPath).With("statements", _statements)
+//line parse.mml:819:2
+			return mml.NewList(_currentCode).Concat(_usesModules.(*mml.List))
+		}, FixedArgs: 3}
+//line parse.mml:823:1
+		_newContext = &mml.Function{F: func(a []interface{}) interface{} {
+//line parse.mml:823:25
+			return mml.NewStruct(nil).With("stack", mml.NewList()).With("parsed", mml.NewStruct(nil)).With("roots", mml.NewStruct(nil))
+		}, FixedArgs: 0}
+		exports.Set("newContext", _newContext)
+//line parse.mml:826:1
+		_modules = &mml.Function{F: func(a []interface{}) interface{} {
+			var _entryPath = a[0]
+//line parse.mml:826:30
+			return _parseModule.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 826, Column: 30}, []interface{}{mml.NewStruct(nil).Merge(_newContext.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 827, Column: 4}, []interface{}{}).(*mml.Struct)).With("roots", mml.NewStruct(nil).With(_entryPath.(string), mml.Ref(mml.Pos{Path: "parse.mml", Line: 827, Column: 43}, _code, "dirName").(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 827, Column: 43}, []interface{}{_entryPath}))), _entryPath})
+		}, FixedArgs: 1}
+		exports.Set("modules", _modules)
+//line parse.mml:833:1
+		_input = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _path = a[1]
+			var _ast = a[2]
+//line parse.mml:833:38
+			return _resolveUses.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 833, Column: 38}, []interface{}{_context, _path, _parse.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 833, Column: 65}, []interface{}{_withPath.(*mml.Function).CallAt(mml.Pos{Path: "parse.mml", Line: 833, Column: 72}, []interface{}{_path}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_ast})})})
+		}, FixedArgs: 3}
+		exports.Set("input", _input)
+//line main.go:2852
+		return exports
+	})
 }
-};
-c = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _current)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-c = _isDefault; if c.(bool) { ;
-mml.Nop();
-_defaults = _current } else { ;
-mml.Nop();
-_cases = &mml.List{Values: append(append([]interface{}{}, _cases.(*mml.List).Values...), _current)} } };
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["cases"] = _cases;s.Values["defaults"] = _defaults;s.Values["hasDefault"] = _hasDefault;; return s }();
-				return nil
-			},
-			FixedArgs: 0,
-		};
-_cases = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				;
-mml.Nop();
-return _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "select-case";s.Values["expression"] = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, 0))}).Values);s.Values["body"] = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "statement-list";s.Values["statements"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.RefRange(_c, 1, nil))}).Values);; return s }();; return s }()
-			},
-			FixedArgs: 1,
-		}, _c)}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_lines = _groupLines.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values);
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["cases"] = _cases.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_lines, "cases"))}).Values);s.Values["defaultStatements"] = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "statement-list";s.Values["statements"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_lines, "defaults"))}).Values);; return s }();s.Values["hasDefault"] = mml.Ref(_lines, "hasDefault");; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_parseExport = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _d interface{};
-mml.Nop(_d);
-_d = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values);
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "definition-list";s.Values["definitions"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0];
-				;
-				mml.Nop(_d);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _d.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["exported"] = true;; return s }()
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = mml.BinaryOp(11, mml.Ref(_d, "type"), "definition"); if c.(bool) { return &mml.List{Values: append([]interface{}{}, _d)} } else { return mml.Ref(_d, "definitions") } }())}).Values);; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_useFact = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				var _capture interface{};
-var _path interface{};
-mml.Nop(_capture, _path);
-_capture = "";
-_path = "";
-switch mml.Ref(mml.Ref(mml.Ref(_ast, "nodes"), 0), "name") {
-case "use-inline":
-;
-mml.Nop();
-_capture = ".";
-_path = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-case "symbol":
-;
-mml.Nop();
-_capture = mml.Ref(_parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values), "name");
-_path = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 1))}).Values)
-default:
-;
-mml.Nop();
-_path = _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_ast, "nodes"), 0))}).Values)
-};
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "use";s.Values["capture"] = _capture;s.Values["path"] = _path;; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_parseUse = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "use-list";s.Values["uses"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parse, mml.Ref(_ast, "nodes"))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		};
-_parse = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _ast = a[0];
-				;
-				mml.Nop(_ast);
-				;
-mml.Nop();
-switch mml.Ref(_ast, "name") {
-case "line-comment-content":
-;
-mml.Nop();
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "comment";; return s }()
-case "int":
-;
-mml.Nop();
-return _parseInt.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "text"))}).Values)
-case "float":
-;
-mml.Nop();
-return _parseFloat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ast, "text"))}).Values)
-case "string":
-;
-mml.Nop();
-return _parseString.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "true":
-;
-mml.Nop();
-return true
-case "false":
-;
-mml.Nop();
-return false
-case "symbol":
-;
-mml.Nop();
-return _symbol.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "spread-expression":
-;
-mml.Nop();
-return _spread.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "list":
-;
-mml.Nop();
-return _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "mutable-list":
-;
-mml.Nop();
-return _mutableList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "expression-key":
-;
-mml.Nop();
-return _expressionKey.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "entry":
-;
-mml.Nop();
-return _entry.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "struct":
-;
-mml.Nop();
-return _struct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "mutable-struct":
-;
-mml.Nop();
-return _mutableStruct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "return":
-;
-mml.Nop();
-return _ret.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "block":
-;
-mml.Nop();
-return _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "function":
-;
-mml.Nop();
-return _function.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "effect":
-;
-mml.Nop();
-return _effect.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "range-from":
-;
-mml.Nop();
-return _range.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "range-to":
-;
-mml.Nop();
-return _range.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "symbol-index":
-;
-mml.Nop();
-return _symbolIndex.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "expression-index":
-;
-mml.Nop();
-return _expressionIndex.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "range-index":
-;
-mml.Nop();
-return _rangeIndex.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "indexer":
-;
-mml.Nop();
-return _indexer.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "function-application":
-;
-mml.Nop();
-return _application.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "unary-expression":
-;
-mml.Nop();
-return _unary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "binary0":
-;
-mml.Nop();
-return _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "binary1":
-;
-mml.Nop();
-return _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "binary2":
-;
-mml.Nop();
-return _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "binary3":
-;
-mml.Nop();
-return _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "binary4":
-;
-mml.Nop();
-return _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "chaining":
-;
-mml.Nop();
-return _chaining.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "ternary-expression":
-;
-mml.Nop();
-return _ternary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "if":
-;
-mml.Nop();
-return _parseIf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "switch":
-;
-mml.Nop();
-return _parseSwitch.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "range-over-expression":
-;
-mml.Nop();
-return _rangeOver.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "loop":
-;
-mml.Nop();
-return _loop.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "value-capture":
-;
-mml.Nop();
-return _valueCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "mutable-capture":
-;
-mml.Nop();
-return _mutableCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "value-definition":
-;
-mml.Nop();
-return _valueDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "value-definition-group":
-;
-mml.Nop();
-return _definitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "mutable-definition-group":
-;
-mml.Nop();
-return _mutableDefinitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "function-capture":
-;
-mml.Nop();
-return _functionCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "effect-capture":
-;
-mml.Nop();
-return _effectCapture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "function-definition":
-;
-mml.Nop();
-return _functionDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "function-definition-group":
-;
-mml.Nop();
-return _definitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "effect-definition-group":
-;
-mml.Nop();
-return _effectDefinitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "assignment":
-;
-mml.Nop();
-return _assign.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "send":
-;
-mml.Nop();
-return _parseSend.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "receive":
-;
-mml.Nop();
-return _parseReceive.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "go":
-;
-mml.Nop();
-return _parseGo.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "defer":
-;
-mml.Nop();
-return _parseDefer.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "receive-definition":
-;
-mml.Nop();
-return _receiveDefinition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "select":
-;
-mml.Nop();
-return _parseSelect.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "export":
-;
-mml.Nop();
-return _parseExport.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "use-fact":
-;
-mml.Nop();
-return _useFact.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-case "use":
-;
-mml.Nop();
-return _parseUse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-default:
-;
-mml.Nop();
-return _statementList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values)
-};
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_parseFile = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _path = a[0];
-				;
-				mml.Nop(_path);
-				var _in interface{};
-var _ast interface{};
-mml.Nop(_in, _ast);
-_in = _open.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _path)}).Values);
-c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _in)}).Values); if c.(bool) { ;
-mml.Nop();
-return _in };
-defer _close.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _in)}).Values);
-_ast = _passErr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parseAST)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _in.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.UnaryOp(2, 1))}).Values))}).Values);
-c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values); if c.(bool) { ;
-mml.Nop();
-return _ast };
-return _parse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ast)}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_findExportNames = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _statements = a[0];
-				;
-				mml.Nop(_statements);
-				return _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0];
-				;
-				mml.Nop(_d);
-				return mml.Ref(_d, "symbol")
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0];
-				;
-				mml.Nop(_d);
-				return mml.Ref(_d, "exported")
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_code, "flattenedStatements").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "definition", "definition-list", "definitions")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _statements)}).Values))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_parseModule = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _entryPath = a[1];
-				;
-				mml.Nop(_context, _entryPath);
-				var _module interface{};
-var _uses interface{};
-var _usesModules interface{};
-var _statements interface{};
-var _currentCode interface{};
-var _modules interface{};
-mml.Nop(_module, _uses, _usesModules, _statements, _currentCode, _modules);
-c = _contains.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _entryPath, mml.Ref(_context, "stack"))}).Values); if c.(bool) { ;
-mml.Nop();
-return _error.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "circular module dependency: %s", _entryPath)}).Values))}).Values) };
-c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _entryPath, mml.Ref(_context, "parsed"))}).Values); if c.(bool) { ;
-mml.Nop();
-return mml.Ref(mml.Ref(_context, "parsed"), _entryPath) };
-_module = _parseFile.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _entryPath)}).Values);
-_uses = mml.Ref(_code, "flattenedStatements").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "use", "use-list", "uses", mml.Ref(_module, "statements"))}).Values);
-c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _module)}).Values); if c.(bool) { ;
-mml.Nop();
-return _module };
-mml.SetRef(_context, "stack", &mml.List{Values: append(append([]interface{}{}, mml.Ref(_context, "stack").(*mml.List).Values...), _entryPath)});
-_usesModules = _passErr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _uniq.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _left = a[0];
-var _right = a[1];
-				;
-				mml.Nop(_left, _right);
-				return mml.BinaryOp(11, mml.Ref(_left, "path"), mml.Ref(_right, "path"))
-			},
-			FixedArgs: 2,
-		})}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _passErr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0];
-				;
-				mml.Nop(_m);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = mml.Ref(_m, "type");s.Values["path"] = mml.Ref(_m, "path");s.Values["statements"] = mml.Ref(_m, "statements");s.Values["exportNames"] = _findExportNames.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_m, "statements"))}).Values);; return s }()
-			},
-			FixedArgs: 1,
-		})}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _passErr.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _flat)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_errors, "any").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _parseModule.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context)}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return mml.BinaryOp(9, mml.Ref(_u, "path"), ".mml")
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _uses)}).Values))}).Values))}).Values))}).Values))}).Values))}).Values);
-mml.SetRef(_context, "stack", mml.RefRange(mml.Ref(_context, "stack"), nil, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_context, "stack"))}).Values), 1)));
-c = _isError.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _usesModules)}).Values); if c.(bool) { ;
-mml.Nop();
-return _usesModules };
-_statements = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				;
-mml.Nop();
-c = (!_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _s)}).Values).(bool) || (mml.BinaryOp(12, mml.Ref(_s, "type"), "use").(bool) && mml.BinaryOp(12, mml.Ref(_s, "type"), "use-list").(bool))); if c.(bool) { ;
-mml.Nop();
-return _s };
-c = mml.BinaryOp(11, mml.Ref(_s, "type"), "use"); if c.(bool) { var _m interface{};
-mml.Nop(_m);
-_m = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0];
-				;
-				mml.Nop(_m);
-				return mml.BinaryOp(11, mml.Ref(_m, "path"), mml.Ref(_s, "path"))
-			},
-			FixedArgs: 1,
-		}, _usesModules)}).Values);
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _m)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-return _s };
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _s.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["exportNames"] = mml.Ref(mml.Ref(_m, 0), "exportNames");; return s }() };
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = mml.Ref(_s, "type");s.Values["uses"] = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				var _m interface{};
-mml.Nop(_m);
-_m = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _m = a[0];
-				;
-				mml.Nop(_m);
-				return mml.BinaryOp(11, mml.Ref(_m, "path"), mml.BinaryOp(9, mml.Ref(_u, "path"), ".mml"))
-			},
-			FixedArgs: 1,
-		}, _usesModules)}).Values);
-c = mml.BinaryOp(11, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _m)}).Values), 0); if c.(bool) { ;
-mml.Nop();
-return _u };
-return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _u.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["exportNames"] = mml.Ref(mml.Ref(_m, 0), "exportNames");; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		}, mml.Ref(_s, "uses"))}).Values);; return s }();
-				return nil
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_module, "statements"))}).Values);
-_currentCode = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _module.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["path"] = _entryPath;s.Values["statements"] = _statements;; return s }();
-_modules = &mml.List{Values: append(append([]interface{}{}, _currentCode), _usesModules.(*mml.List).Values...)};
-mml.SetRef(mml.Ref(_context, "parsed"), _entryPath, _modules);
-return _modules;
+func init() {
+	mml.Modules.Set("definitions.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _newContext interface{}
+		var _extend interface{}
+		var _definedCurrent interface{}
+		var _define interface{}
+		var _defined interface{}
+		var _capture interface{}
+		var _values interface{}
+		var _results interface{}
+		var _resultValues interface{}
+		var _resultErrors interface{}
+		var _emptyResults interface{}
+		var _mergeResults interface{}
+		var _wrapWithReturn interface{}
+		var _all interface{}
+		var _scoped interface{}
+		var _allScoped interface{}
+		var _fields interface{}
+		var _fieldsIfHas interface{}
+		var _list interface{}
+		var _struct interface{}
+		var _rangeExpression interface{}
+		var _indexer interface{}
+		var _spread interface{}
+		var _unary interface{}
+		var _binary interface{}
+		var _validateSend interface{}
+		var _validateGo interface{}
+		var _validateDefer interface{}
+		var _definitions interface{}
+		var _assignments interface{}
+		var _ret interface{}
+		var _useList interface{}
+		var _located interface{}
+		var _undefined interface{}
+		var _duplicate interface{}
+		var _expandFunction interface{}
+		var _symbol interface{}
+		var _entry interface{}
+		var _function interface{}
+		var _application interface{}
+		var _validateInterop interface{}
+		var _cond interface{}
+		var _validateCase interface{}
+		var _validateSwitch interface{}
+		var _validateReceive interface{}
+		var _validateSelect interface{}
+		var _rangeOver interface{}
+		var _loop interface{}
+		var _definition interface{}
+		var _assignment interface{}
+		var _validateUse interface{}
+		var _statements interface{}
+		var _do interface{}
+		var _topLevel interface{}
+		var _validate interface{}
+		var _validateLine interface{}
+		var _isDefined interface{}
+		var _undefine interface{}
+		var _mmlcode interface{}
+		var _fold interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _formats interface{}
+		var __lang = loader.Use("lang.mml")
+		_fold = mml.Ref(mml.Pos{Path: "definitions.mml", Line: 8, Column: 2}, __lang, "fold")
+		_map = mml.Ref(mml.Pos{Path: "definitions.mml", Line: 8, Column: 2}, __lang, "map")
+		_filter = mml.Ref(mml.Pos{Path: "definitions.mml", Line: 8, Column: 2}, __lang, "filter")
+		_formats = mml.Ref(mml.Pos{Path: "definitions.mml", Line: 8, Column: 2}, __lang, "formats")
+		_mmlcode = loader.Use("code.mml")
+//line definitions.mml:12:1
+		_newContext = &mml.Function{F: func(a []interface{}) interface{} {
+//line definitions.mml:12:17
+			return mml.NewStruct(nil).With("definitions", mml.NewStruct(nil)).With("unexpanded", mml.NewList()).With("capturing", false)
+		}, FixedArgs: 0}
+//line definitions.mml:14:1
+		_extend = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+//line definitions.mml:15:29
+			return mml.NewStruct(nil).Merge(_newContext.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 15, Column: 31}, []interface{}{}).(*mml.Struct)).With("parent", _context)
+		}, FixedArgs: 1}
+		_definedCurrent = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _n = a[1]
+//line definitions.mml:16:29
+			return _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 16, Column: 29}, []interface{}{_n, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 16, Column: 36}, _context, "definitions")})
+		}, FixedArgs: 2}
+		_define = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _n = a[1]
+			var _v = a[2]
+//line definitions.mml:17:29
+			return _capture.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 17, Column: 29}, []interface{}{_context, _n, _v})
+		}, FixedArgs: 3}
+//line definitions.mml:21:1
+		_defined = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _n = a[1]
+//line definitions.mml:22:2
+			return (_has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 22, Column: 2}, []interface{}{_n, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 22, Column: 9}, _context, "definitions")}).(bool) || (_has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 23, Column: 2}, []interface{}{"parent", _context}).(bool) && _defined.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 23, Column: 28}, []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 23, Column: 36}, _context, "parent"), _n}).(bool)))
+		}, FixedArgs: 2}
+//line definitions.mml:25:1
+		_capture = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _n = a[1]
+			var _v = a[2]
+//line definitions.mml:26:2
+			return mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 26, Column: 2}, mml.Ref(mml.Pos{}, _context, "definitions"), _n, func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 26, Column: 27}, []interface{}{_n, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 26, Column: 34}, _context, "definitions")}).(bool) {
+					return mml.NewList().Concat(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 27, Column: 4}, mml.Ref(mml.Pos{}, _context, "definitions"), _n).(*mml.List)).Concat(_v.(*mml.List))
+				}
+				return _v
+			}())
+		}, FixedArgs: 3}
+//line definitions.mml:30:1
+		_values = &mml.Function{F: func(a []interface{}) interface{} {
+		__tail:
+			for {
+				var _context = a[0]
+				var _n = a[1]
+//line definitions.mml:30:24
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 30, Column: 24}, []interface{}{_n, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 30, Column: 31}, _context, "definitions")}).(bool) {
+//line definitions.mml:31:2
+					return mml.Ref(mml.Pos{Path: "definitions.mml", Line: 31, Column: 2}, mml.Ref(mml.Pos{}, _context, "definitions"), _n)
+				} else {
+//line definitions.mml:32:2
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 32, Column: 2}, []interface{}{"parent", _context}).(bool) {
+//line definitions.mml:33:3
+						a = []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 33, Column: 10}, _context, "parent"), _n}
+						continue __tail
+					} else {
+//line definitions.mml:34:3
+						return mml.NewList()
+					}
+				}
+			}
+		}, FixedArgs: 2}
+//line definitions.mml:36:1
+		_results = &mml.Function{F: func(a []interface{}) interface{} {
+			var _v = a[0]
+			var _e = a[1]
+//line definitions.mml:37:21
+			return mml.NewStruct(nil).With("values", _v).With("errors", _e)
+		}, FixedArgs: 2}
+		_resultValues = &mml.Function{F: func(a []interface{}) interface{} {
+			var _v = mml.NewList(a[0:]...)
+//line definitions.mml:38:21
+			return _results.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 38, Column: 21}, []interface{}{_v, mml.NewList()})
+		}, FixedArgs: 0}
+		_resultErrors = &mml.Function{F: func(a []interface{}) interface{} {
+			var _e = mml.NewList(a[0:]...)
+//line definitions.mml:39:21
+			return _results.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 39, Column: 21}, []interface{}{mml.NewList(), _e})
+		}, FixedArgs: 0}
+//line definitions.mml:42:1
+		_emptyResults = _results.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 42, Column: 18}, []interface{}{mml.NewList(), mml.NewList()})
+//line definitions.mml:44:1
+		_mergeResults = &mml.Function{F: func(a []interface{}) interface{} {
+			var _r = mml.NewList(a[0:]...)
+			var _mergeTwo interface{}
+//line definitions.mml:45:2
+			_mergeTwo = &mml.Function{F: func(a []interface{}) interface{} {
+				var _left = a[0]
+				var _right = a[1]
+//line definitions.mml:45:27
+				return _results.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 45, Column: 27}, []interface{}{mml.NewList().Concat(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 46, Column: 4}, _left, "values").(*mml.List)).Concat(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 46, Column: 20}, _right, "values").(*mml.List)), mml.NewList().Concat(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 47, Column: 4}, _left, "errors").(*mml.List)).Concat(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 47, Column: 20}, _right, "errors").(*mml.List))})
+			}, FixedArgs: 2}
+//line definitions.mml:50:2
+			return _fold.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 50, Column: 9}, []interface{}{_mergeTwo, _emptyResults, _r})
+		}, FixedArgs: 0}
+//line definitions.mml:53:1
+		_wrapWithReturn = &mml.Function{F: func(a []interface{}) interface{} {
+			var _r = a[0]
+//line definitions.mml:53:22
+			return _results.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 53, Column: 22}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 54, Column: 14}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _v = a[0]
+//line definitions.mml:54:25
+				return mml.NewStruct(nil).With("type", "ret").With("value", _v)
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 54, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 54, Column: 2}, _r, "values")}), mml.Ref(mml.Pos{Path: "definitions.mml", Line: 55, Column: 2}, _r, "errors")})
+		}, FixedArgs: 1}
+//line definitions.mml:58:1
+		_all = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _l = a[1]
+//line definitions.mml:59:30
+			return (&mml.Function{F: func(a []interface{}) interface{} {
+				var _r = a[0]
+//line definitions.mml:59:62
+				return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 59, Column: 62}, mml.NewList().Concat(_r.(*mml.List)).Items())
+			}, FixedArgs: 1}).CallAt(mml.Pos{Path: "definitions.mml", Line: 59, Column: 30}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 59, Column: 35}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 59, Column: 39}, []interface{}{_context})}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_l})})
+		}, FixedArgs: 2}
+		_scoped = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _code = a[1]
+//line definitions.mml:60:30
+			return _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 60, Column: 30}, []interface{}{_extend.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 60, Column: 33}, []interface{}{_context}), _code})
+		}, FixedArgs: 2}
+		_allScoped = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _l = a[1]
+//line definitions.mml:61:30
+			return (&mml.Function{F: func(a []interface{}) interface{} {
+				var _r = a[0]
+//line definitions.mml:61:66
+				return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 61, Column: 66}, mml.NewList().Concat(_r.(*mml.List)).Items())
+			}, FixedArgs: 1}).CallAt(mml.Pos{Path: "definitions.mml", Line: 61, Column: 30}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 61, Column: 35}, []interface{}{_scoped.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 61, Column: 39}, []interface{}{_context})}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_l})})
+		}, FixedArgs: 2}
+		_fields = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _s = a[1]
+			var _f = a[2]
+//line definitions.mml:62:30
+			return _all.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 62, Column: 55}, []interface{}{_context}).(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 62, Column: 30}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 62, Column: 35}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _f = a[0]
+//line definitions.mml:62:46
+				return mml.Ref(mml.Pos{Path: "definitions.mml", Line: 62, Column: 46}, _s, _f)
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_f})})
+		}, FixedArgs: 3}
+		_fieldsIfHas = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _f = a[1]
+			var _s = a[2]
+//line definitions.mml:63:30
+			return _fields.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 63, Column: 63}, []interface{}{_context, _s}).(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 63, Column: 30}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 63, Column: 35}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _f = a[0]
+//line definitions.mml:63:49
+				return _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 63, Column: 49}, []interface{}{_f, _s})
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_f})})
+		}, FixedArgs: 3}
+		_list = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _l = a[1]
+//line definitions.mml:64:30
+			return _all.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 64, Column: 30}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 64, Column: 43}, _l, "values")})
+		}, FixedArgs: 2}
+		_struct = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _s = a[1]
+//line definitions.mml:65:30
+			return _all.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 65, Column: 30}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 65, Column: 43}, _s, "entries")})
+		}, FixedArgs: 2}
+		_rangeExpression = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _r = a[1]
+//line definitions.mml:66:30
+			return _fieldsIfHas.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 66, Column: 30}, []interface{}{_context, mml.NewList("from", "to"), _r})
+		}, FixedArgs: 2}
+		_indexer = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _i = a[1]
+//line definitions.mml:67:30
+			return _all.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 67, Column: 30}, []interface{}{_context, mml.NewList(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 67, Column: 44}, _i, "index"), mml.Ref(mml.Pos{Path: "definitions.mml", Line: 67, Column: 53}, _i, "expression"))})
+		}, FixedArgs: 2}
+		_spread = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _s = a[1]
+//line definitions.mml:68:30
+			return _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 68, Column: 30}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 68, Column: 42}, _s, "value")})
+		}, FixedArgs: 2}
+		_unary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _u = a[1]
+//line definitions.mml:69:30
+			return _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 69, Column: 30}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 69, Column: 42}, _u, "arg")})
+		}, FixedArgs: 2}
+		_binary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _b = a[1]
+//line definitions.mml:70:30
+			return _fields.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 70, Column: 30}, []interface{}{_context, _b, mml.NewList("left", "right")})
+		}, FixedArgs: 2}
+		_validateSend = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _s = a[1]
+//line definitions.mml:71:30
+			return _fields.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 71, Column: 30}, []interface{}{_context, _s, mml.NewList("channel", "value")})
+		}, FixedArgs: 2}
+		_validateGo = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _g = a[1]
+//line definitions.mml:72:30
+			return _fields.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 72, Column: 30}, []interface{}{_context, _g, mml.NewList("application")})
+		}, FixedArgs: 2}
+		_validateDefer = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _d = a[1]
+//line definitions.mml:73:30
+			return _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 73, Column: 30}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 73, Column: 42}, _d, "application")})
+		}, FixedArgs: 2}
+		_definitions = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _d = a[1]
+//line definitions.mml:74:30
+			return _all.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 74, Column: 30}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 74, Column: 43}, _d, "definitions")})
+		}, FixedArgs: 2}
+		_assignments = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _a = a[1]
+//line definitions.mml:75:30
+			return _all.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 75, Column: 30}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 75, Column: 43}, _a, "assignments")})
+		}, FixedArgs: 2}
+		_ret = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _r = a[1]
+//line definitions.mml:76:30
+			return _fieldsIfHas.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 76, Column: 30}, []interface{}{_context, mml.NewList("value"), _r})
+		}, FixedArgs: 2}
+		_useList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _u = a[1]
+//line definitions.mml:77:30
+			return _all.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 77, Column: 30}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 77, Column: 43}, _u, "uses")})
+		}, FixedArgs: 2}
+//line definitions.mml:80:1
+		_located = &mml.Function{F: func(a []interface{}) interface{} {
+			var _code = a[0]
+			var _message = a[1]
+//line definitions.mml:80:27
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 80, Column: 27}, []interface{}{"pos", _code}).(bool) {
+					return _formats.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 81, Column: 2}, []interface{}{"%s:%d:%d: %s", mml.Ref(mml.Pos{Path: "definitions.mml", Line: 81, Column: 26}, mml.Ref(mml.Pos{}, _code, "pos"), "path"), mml.Ref(mml.Pos{Path: "definitions.mml", Line: 81, Column: 41}, mml.Ref(mml.Pos{}, _code, "pos"), "line"), mml.Ref(mml.Pos{Path: "definitions.mml", Line: 81, Column: 56}, mml.Ref(mml.Pos{}, _code, "pos"), "column"), _message})
+				}
+				return _message
+			}()
+		}, FixedArgs: 2}
+//line definitions.mml:84:1
+		_undefined = &mml.Function{F: func(a []interface{}) interface{} {
+			var _code = a[0]
+			var _name = a[1]
+//line definitions.mml:85:24
+			return _error.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 85, Column: 24}, []interface{}{_located.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 85, Column: 30}, []interface{}{_code, _formats.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 85, Column: 44}, []interface{}{"undefined: %s", _name})})})
+		}, FixedArgs: 2}
+		_duplicate = &mml.Function{F: func(a []interface{}) interface{} {
+			var _code = a[0]
+			var _name = a[1]
+//line definitions.mml:86:24
+			return _error.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 86, Column: 24}, []interface{}{_located.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 86, Column: 30}, []interface{}{_code, _formats.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 86, Column: 44}, []interface{}{"duplicate definition: %s", _name})})})
+		}, FixedArgs: 2}
+//line definitions.mml:89:1
+		_expandFunction = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+			var _c interface{}
+//line definitions.mml:90:2
+			if mml.Ref(mml.Pos{Path: "definitions.mml", Line: 90, Column: 5}, _f, "expanded").(bool) {
+//line definitions.mml:91:3
+				return _emptyResults
+			}
+//line definitions.mml:94:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 94, Column: 2}, _f, "expanded", true)
+//line definitions.mml:96:2
+			_c = _extend.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 96, Column: 8}, []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 96, Column: 15}, _f, "context")})
+//line definitions.mml:97:2
+			for __iter := mml.Iterate(mml.Pos{Path: "definitions.mml", Line: 97, Column: 6}, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 97, Column: 11}, _f, "params")); __iter.Next(); {
+				_p := __iter.Value()
+//line definitions.mml:98:3
+				_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 98, Column: 3}, []interface{}{_c, _p, mml.NewList()})
+			}
+//line definitions.mml:101:2
+			if mml.BinaryOp(mml.Pos{Path: "definitions.mml", Line: 101, Column: 5}, 12, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 101, Column: 5}, _f, "collectParam"), "").(bool) {
+//line definitions.mml:102:3
+				_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 102, Column: 3}, []interface{}{_c, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 102, Column: 13}, _f, "collectParam"), mml.NewList()})
+			}
+//line definitions.mml:105:2
+			return _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 105, Column: 9}, []interface{}{_c, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 105, Column: 15}, _f, "statement")})
+		}, FixedArgs: 1}
+//line definitions.mml:108:1
+		_symbol = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _s = a[1]
+			var _r interface{}
+//line definitions.mml:109:2
+			_r = func() interface{} {
+				if _defined.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 109, Column: 10}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 109, Column: 27}, _s, "name")}).(bool) {
+					return _resultValues.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 110, Column: 3}, mml.NewList().Concat(_values.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 110, Column: 16}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 110, Column: 32}, _s, "name")}).(*mml.List)).Items())
+				}
+				return _resultErrors.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 111, Column: 3}, []interface{}{_undefined.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 111, Column: 16}, []interface{}{_s, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 111, Column: 29}, _s, "name")})})
+			}()
+//line definitions.mml:112:2
+			if mml.Ref(mml.Pos{Path: "definitions.mml", Line: 112, Column: 5}, _context, "capturing").(bool) {
+//line definitions.mml:113:3
+				return _r
+			}
+//line definitions.mml:116:2
+			for __iter := mml.Iterate(mml.Pos{Path: "definitions.mml", Line: 116, Column: 6}, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 116, Column: 11}, _r, "values")); __iter.Next(); {
+				_v := __iter.Value()
+//line definitions.mml:117:3
+				if (!_has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 117, Column: 7}, []interface{}{"type", _v}).(bool) || mml.BinaryOp(mml.Pos{Path: "definitions.mml", Line: 117, Column: 25}, 12, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 117, Column: 25}, _v, "type"), "function").(bool)) {
+//line definitions.mml:118:4
+					continue
+				}
+//line definitions.mml:121:3
+				_r = _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 121, Column: 7}, []interface{}{_r, _expandFunction.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 121, Column: 23}, []interface{}{_v})})
+			}
+//line definitions.mml:124:2
+			return _r
+		}, FixedArgs: 2}
+//line definitions.mml:127:1
+		_entry = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _e = a[1]
+			var _kr interface{}
+//line definitions.mml:128:2
+			_kr = _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 128, Column: 9}, []interface{}{_context, func() interface{} {
+				if (_has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 128, Column: 21}, []interface{}{"type", mml.Ref(mml.Pos{Path: "definitions.mml", Line: 128, Column: 33}, _e, "key")}).(bool) && mml.BinaryOp(mml.Pos{Path: "definitions.mml", Line: 128, Column: 43}, 11, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 128, Column: 43}, mml.Ref(mml.Pos{}, _e, "key"), "type"), "symbol").(bool)) {
+					return mml.Ref(mml.Pos{Path: "definitions.mml", Line: 128, Column: 68}, mml.Ref(mml.Pos{}, _e, "key"), "name")
+				}
+				return mml.Ref(mml.Pos{Path: "definitions.mml", Line: 128, Column: 81}, _e, "key")
+			}()})
+//line definitions.mml:129:2
+			return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 129, Column: 9}, []interface{}{_kr, _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 131, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 131, Column: 15}, _e, "value")})})
+		}, FixedArgs: 2}
+//line definitions.mml:135:1
+		_function = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _f = a[1]
+			var _ff interface{}
+//line definitions.mml:136:2
+			_ff = mml.NewStruct(nil).Merge(_f.(*mml.Struct)).With("context", _context).With("expanded", false)
+//line definitions.mml:137:2
+			if mml.Ref(mml.Pos{Path: "definitions.mml", Line: 137, Column: 5}, _context, "capturing").(bool) {
+//line definitions.mml:138:3
+				mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 138, Column: 3}, _context, "unexpanded", mml.NewList().Concat(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 138, Column: 25}, _context, "unexpanded").(*mml.List)).Append(_ff))
+//line definitions.mml:139:3
+				return _resultValues.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 139, Column: 10}, []interface{}{_ff})
+			}
+//line definitions.mml:142:2
+			return _expandFunction.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 142, Column: 9}, []interface{}{_ff})
+		}, FixedArgs: 2}
+//line definitions.mml:145:1
+		_application = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _a = a[1]
+			var _capturing interface{}
+			var _r interface{}
+//line definitions.mml:146:2
+			_capturing = mml.Ref(mml.Pos{Path: "definitions.mml", Line: 146, Column: 16}, _context, "capturing")
+//line definitions.mml:147:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 147, Column: 2}, _context, "capturing", false)
+//line definitions.mml:148:2
+			_r = _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 148, Column: 8}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 149, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 149, Column: 15}, _a, "function")}), _all.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 150, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 150, Column: 16}, _a, "args")})})
+//line definitions.mml:153:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 153, Column: 2}, _context, "capturing", _capturing)
+//line definitions.mml:154:2
+			return _r
+		}, FixedArgs: 2}
+//line definitions.mml:157:1
+		_validateInterop = &mml.Function{F: func(a []interface{}) interface{} {
+			var _i = a[0]
+//line definitions.mml:158:2
+			return func() interface{} {
+				if (((_len.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 158, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 158, Column: 6}, _i, "args")}).(int) == 2) && _isString.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 158, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 158, Column: 31}, mml.Ref(mml.Pos{}, _i, "args"), 0)}).(bool)) && _isString.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 158, Column: 45}, []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 158, Column: 54}, mml.Ref(mml.Pos{}, _i, "args"), 1)}).(bool)) {
+					return _emptyResults
+				}
+				return _resultErrors.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 160, Column: 2}, []interface{}{_error.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 160, Column: 15}, []interface{}{_located.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 160, Column: 21}, []interface{}{_i, "interop.use expects a Go package path and a function name as strings"})})})
+			}()
+		}, FixedArgs: 1}
+//line definitions.mml:162:1
+		_cond = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _c = a[1]
+//line definitions.mml:162:22
+			return func() interface{} {
+				if mml.Ref(mml.Pos{Path: "definitions.mml", Line: 162, Column: 22}, _c, "ternary").(bool) {
+					return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 163, Column: 2}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 164, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 164, Column: 15}, _c, "condition")}), _scoped.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 165, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 165, Column: 19}, _c, "consequent")}), _fieldsIfHas.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 166, Column: 3}, []interface{}{_extend.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 166, Column: 15}, []interface{}{_context}), mml.NewList("alternative"), _c})})
+				}
+				return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 168, Column: 2}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 169, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 169, Column: 15}, _c, "condition")}), _wrapWithReturn.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 170, Column: 3}, []interface{}{_scoped.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 170, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 170, Column: 19}, _c, "consequent")})}), _wrapWithReturn.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 171, Column: 3}, []interface{}{_fieldsIfHas.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 171, Column: 3}, []interface{}{_extend.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 171, Column: 15}, []interface{}{_context}), mml.NewList("alternative"), _c})})})
+			}()
+		}, FixedArgs: 2}
+//line definitions.mml:174:1
+		_validateCase = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _c = a[1]
+//line definitions.mml:174:30
+			return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 174, Column: 30}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 175, Column: 2}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 175, Column: 14}, _c, "expression")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 176, Column: 2}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 176, Column: 14}, _c, "body")})})
+		}, FixedArgs: 2}
+//line definitions.mml:179:1
+		_validateSwitch = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _s = a[1]
+//line definitions.mml:179:32
+			return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 179, Column: 32}, []interface{}{_fieldsIfHas.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 180, Column: 2}, []interface{}{_context, mml.NewList("expression"), _s}), _wrapWithReturn.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 181, Column: 2}, []interface{}{_allScoped.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 181, Column: 2}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 181, Column: 21}, _s, "cases")})}), _wrapWithReturn.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 182, Column: 2}, []interface{}{_scoped.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 182, Column: 2}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 182, Column: 18}, _s, "defaultStatements")})})})
+		}, FixedArgs: 2}
+//line definitions.mml:185:1
+		_validateReceive = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _r = a[1]
+//line definitions.mml:185:33
+			return _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 185, Column: 33}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 185, Column: 45}, _r, "channel")})
+		}, FixedArgs: 2}
+//line definitions.mml:187:1
+		_validateSelect = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _s = a[1]
+//line definitions.mml:187:32
+			return _wrapWithReturn.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 187, Column: 32}, []interface{}{_mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 187, Column: 32}, []interface{}{_allScoped.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 188, Column: 2}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 188, Column: 21}, _s, "cases")}), func() interface{} {
+				if mml.Ref(mml.Pos{Path: "definitions.mml", Line: 189, Column: 2}, _s, "hasDefault").(bool) {
+					return _scoped.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 189, Column: 17}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 189, Column: 33}, _s, "defaultStatements")})
+				}
+				return _emptyResults
+			}()})})
+		}, FixedArgs: 2}
+//line definitions.mml:192:1
+		_rangeOver = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _r = a[1]
+			var _result interface{}
+//line definitions.mml:193:2
+			if (_has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 193, Column: 5}, []interface{}{"key", _r}).(bool) && mml.BinaryOp(mml.Pos{Path: "definitions.mml", Line: 193, Column: 22}, 11, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 193, Column: 22}, _r, "key"), mml.Ref(mml.Pos{Path: "definitions.mml", Line: 193, Column: 31}, _r, "symbol")).(bool)) {
+//line definitions.mml:194:3
+				return _resultErrors.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 194, Column: 10}, []interface{}{_duplicate.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 194, Column: 23}, []interface{}{_r, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 194, Column: 36}, _r, "symbol")})})
+			}
+//line definitions.mml:197:2
+			_result = _emptyResults
+//line definitions.mml:198:2
+			if _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 198, Column: 5}, []interface{}{"expression", _r}).(bool) {
+//line definitions.mml:199:3
+				mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 199, Column: 3}, _context, "capturing", true)
+//line definitions.mml:200:3
+				_result = _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 200, Column: 12}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 200, Column: 24}, _r, "expression")})
+//line definitions.mml:201:3
+				mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 201, Column: 3}, _context, "capturing", false)
+			}
+//line definitions.mml:204:2
+			if _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 204, Column: 5}, []interface{}{"key", _r}).(bool) {
+//line definitions.mml:205:3
+				_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 205, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 205, Column: 19}, _r, "key"), mml.NewList()})
+			}
+//line definitions.mml:208:2
+			_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 208, Column: 2}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 208, Column: 18}, _r, "symbol"), func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 208, Column: 28}, []interface{}{"expression", _r}).(bool) {
+					return mml.Ref(mml.Pos{Path: "definitions.mml", Line: 208, Column: 51}, _result, "values")
+				}
+				return mml.NewList(0)
+			}()})
+//line definitions.mml:209:2
+			return _result
+		}, FixedArgs: 2}
+//line definitions.mml:212:1
+		_loop = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _l = a[1]
+			var _c interface{}
+//line definitions.mml:213:2
+			_c = _extend.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 213, Column: 8}, []interface{}{_context})
+//line definitions.mml:214:2
+			return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 214, Column: 9}, []interface{}{func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 215, Column: 3}, []interface{}{"expression", _l}).(bool) {
+					return _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 215, Column: 26}, []interface{}{_c, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 215, Column: 32}, _l, "expression")})
+				}
+				return _emptyResults
+			}(), _wrapWithReturn.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 216, Column: 3}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 216, Column: 3}, []interface{}{_c, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 216, Column: 9}, _l, "body")})})})
+		}, FixedArgs: 2}
+//line definitions.mml:220:1
+		_definition = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _d = a[1]
+			var _r interface{}
+//line definitions.mml:221:2
+			if _definedCurrent.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 221, Column: 5}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 221, Column: 29}, _d, "symbol")}).(bool) {
+//line definitions.mml:222:3
+				return _resultErrors.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 222, Column: 10}, []interface{}{_duplicate.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 222, Column: 23}, []interface{}{_d, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 222, Column: 36}, _d, "symbol")})})
+			}
+//line definitions.mml:225:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 225, Column: 2}, _context, "capturing", true)
+//line definitions.mml:226:2
+			_r = _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 226, Column: 8}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 226, Column: 20}, _d, "expression")})
+//line definitions.mml:227:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 227, Column: 2}, _context, "capturing", false)
+//line definitions.mml:229:2
+			_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 229, Column: 2}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 229, Column: 18}, _d, "symbol"), mml.Ref(mml.Pos{Path: "definitions.mml", Line: 229, Column: 28}, _r, "values")})
+//line definitions.mml:230:2
+			return _r
+		}, FixedArgs: 2}
+//line definitions.mml:233:1
+		_assignment = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _a = a[1]
+			var _cr interface{}
+			var _er interface{}
+//line definitions.mml:234:2
+			_cr = _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 234, Column: 9}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 234, Column: 21}, _a, "capture")})
+//line definitions.mml:235:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 235, Column: 2}, _context, "capturing", true)
+//line definitions.mml:236:2
+			_er = _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 236, Column: 9}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 236, Column: 21}, _a, "value")})
+//line definitions.mml:237:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 237, Column: 2}, _context, "capturing", false)
+//line definitions.mml:238:2
+			return _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 238, Column: 9}, []interface{}{_cr, _er})
+		}, FixedArgs: 2}
+//line definitions.mml:241:1
+		_validateUse = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _u = a[1]
+//line definitions.mml:242:2
+			if mml.BinaryOp(mml.Pos{Path: "definitions.mml", Line: 242, Column: 5}, 11, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 242, Column: 5}, _u, "capture"), "").(bool) {
+//line definitions.mml:243:3
+				if _definedCurrent.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 243, Column: 6}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 243, Column: 30}, _u, "path")}).(bool) {
+//line definitions.mml:244:4
+					return _resultErrors.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 244, Column: 11}, []interface{}{_duplicate.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 244, Column: 24}, []interface{}{_u, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 244, Column: 37}, _u, "path")})})
+				}
+//line definitions.mml:247:3
+				_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 247, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 247, Column: 19}, _u, "path"), mml.NewList(mml.NewStruct(nil))})
+//line definitions.mml:248:3
+				return _emptyResults
+			}
+//line definitions.mml:251:2
+			if mml.BinaryOp(mml.Pos{Path: "definitions.mml", Line: 251, Column: 5}, 11, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 251, Column: 5}, _u, "capture"), ".").(bool) {
+//line definitions.mml:252:3
+				for __iter := mml.Iterate(mml.Pos{Path: "definitions.mml", Line: 252, Column: 7}, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 252, Column: 15}, _u, "exportNames")); __iter.Next(); {
+					_name := __iter.Value()
+//line definitions.mml:253:4
+					if _definedCurrent.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 253, Column: 7}, []interface{}{_context, _name}).(bool) {
+//line definitions.mml:254:5
+						return _resultErrors.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 254, Column: 12}, []interface{}{_duplicate.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 254, Column: 25}, []interface{}{_u, _name})})
+					}
+//line definitions.mml:257:4
+					_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 257, Column: 4}, []interface{}{_context, _name, mml.NewList(mml.NewStruct(nil))})
+				}
+//line definitions.mml:260:3
+				return _emptyResults
+			}
+//line definitions.mml:263:2
+			if _definedCurrent.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 263, Column: 5}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 263, Column: 29}, _u, "capture")}).(bool) {
+//line definitions.mml:264:3
+				return _resultErrors.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 264, Column: 10}, []interface{}{_duplicate.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 264, Column: 23}, []interface{}{_u, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 264, Column: 36}, _u, "path")})})
+			}
+//line definitions.mml:267:2
+			_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 267, Column: 2}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 267, Column: 18}, _u, "capture"), mml.NewList(mml.NewStruct(nil))})
+//line definitions.mml:268:2
+			return _emptyResults
+		}, FixedArgs: 2}
+//line definitions.mml:271:1
+		_statements = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _s = a[1]
+			var _r interface{}
+//line definitions.mml:272:2
+			_r = _emptyResults
+//line definitions.mml:274:2
+			for __iter := mml.Iterate(mml.Pos{Path: "definitions.mml", Line: 274, Column: 6}, _s); __iter.Next(); {
+				_si := __iter.Value()
+				var _ri interface{}
+//line definitions.mml:275:3
+				_ri = _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 275, Column: 10}, []interface{}{_context, _si})
+//line definitions.mml:276:3
+				if (_has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 276, Column: 6}, []interface{}{"type", _si}).(bool) && mml.BinaryOp(mml.Pos{Path: "definitions.mml", Line: 276, Column: 25}, 11, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 276, Column: 25}, _si, "type"), "ret").(bool)) {
+//line definitions.mml:277:4
+					_r = _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 277, Column: 8}, []interface{}{_r, _ri})
+				} else {
+//line definitions.mml:279:4
+					_r = _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 279, Column: 8}, []interface{}{_r, _resultErrors.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 279, Column: 24}, mml.NewList().Concat(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 279, Column: 37}, _ri, "errors").(*mml.List)).Items())})
+				}
+			}
+//line definitions.mml:283:2
+			for __iter := mml.Iterate(mml.Pos{Path: "definitions.mml", Line: 283, Column: 6}, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 283, Column: 11}, _context, "unexpanded")); __iter.Next(); {
+				_f := __iter.Value()
+//line definitions.mml:284:3
+				_r = _mergeResults.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 284, Column: 7}, []interface{}{_r, _expandFunction.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 284, Column: 23}, []interface{}{_f})})
+			}
+//line definitions.mml:287:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 287, Column: 2}, _context, "unexpanded", mml.NewList())
+//line definitions.mml:288:2
+			return _r
+		}, FixedArgs: 2}
+//line definitions.mml:291:1
+		_do = &mml.Function{F: func(a []interface{}) interface{} {
+		__tail:
+			for {
+				var _context = a[0]
+				var _code = a[1]
+//line definitions.mml:292:2
+				if !_has.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 292, Column: 6}, []interface{}{"type", _code}).(bool) {
+//line definitions.mml:293:3
+					return _emptyResults
+				}
+//line definitions.mml:296:2
+				switch mml.Ref(mml.Pos{Path: "definitions.mml", Line: 296, Column: 9}, _code, "type") {
+				case "comment":
+//line definitions.mml:298:3
+					return _emptyResults
+				case "symbol":
+//line definitions.mml:300:3
+					return _symbol.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 300, Column: 10}, []interface{}{_context, _code})
+				case "list":
+//line definitions.mml:302:3
+					return _list.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 302, Column: 10}, []interface{}{_context, _code})
+				case "entry":
+//line definitions.mml:304:3
+					return _entry.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 304, Column: 10}, []interface{}{_context, _code})
+				case "expression-key":
+//line definitions.mml:306:3
+					a = []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 306, Column: 22}, _code, "value")}
+					continue __tail
+				case "struct":
+//line definitions.mml:308:3
+					return _struct.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 308, Column: 10}, []interface{}{_context, _code})
+				case "function":
+//line definitions.mml:310:3
+					return _function.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 310, Column: 10}, []interface{}{_context, _code})
+				case "range-expression":
+//line definitions.mml:312:3
+					return _rangeExpression.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 312, Column: 10}, []interface{}{_context, _code})
+				case "indexer":
+//line definitions.mml:314:3
+					return _indexer.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 314, Column: 10}, []interface{}{_context, _code})
+				case "spread":
+//line definitions.mml:316:3
+					return _spread.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 316, Column: 10}, []interface{}{_context, _code})
+				case "function-application":
+//line definitions.mml:318:3
+					return _application.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 318, Column: 10}, []interface{}{_context, _code})
+				case "interop":
+//line definitions.mml:320:3
+					return _validateInterop.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 320, Column: 10}, []interface{}{_code})
+				case "unary":
+//line definitions.mml:322:3
+					return _unary.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 322, Column: 10}, []interface{}{_context, _code})
+				case "binary":
+//line definitions.mml:324:3
+					return _binary.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 324, Column: 10}, []interface{}{_context, _code})
+				case "cond":
+//line definitions.mml:326:3
+					return _cond.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 326, Column: 10}, []interface{}{_context, _code})
+				case "switch-case":
+//line definitions.mml:328:3
+					return _validateCase.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 328, Column: 10}, []interface{}{_context, _code})
+				case "switch-statement":
+//line definitions.mml:330:3
+					return _validateSwitch.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 330, Column: 10}, []interface{}{_context, _code})
+				case "send":
+//line definitions.mml:332:3
+					return _validateSend.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 332, Column: 10}, []interface{}{_context, _code})
+				case "receive":
+//line definitions.mml:334:3
+					return _validateReceive.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 334, Column: 10}, []interface{}{_context, _code})
+				case "go":
+//line definitions.mml:336:3
+					return _validateGo.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 336, Column: 10}, []interface{}{_context, _code})
+				case "defer":
+//line definitions.mml:338:3
+					return _validateDefer.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 338, Column: 10}, []interface{}{_context, _code})
+				case "select-case":
+//line definitions.mml:340:3
+					return _validateCase.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 340, Column: 10}, []interface{}{_context, _code})
+				case "select":
+//line definitions.mml:342:3
+					return _validateSelect.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 342, Column: 10}, []interface{}{_context, _code})
+				case "range-over":
+//line definitions.mml:344:3
+					return _rangeOver.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 344, Column: 10}, []interface{}{_context, _code})
+				case "loop":
+//line definitions.mml:346:3
+					return _loop.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 346, Column: 10}, []interface{}{_context, _code})
+				case "definition":
+//line definitions.mml:348:3
+					return _definition.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 348, Column: 10}, []interface{}{_context, _code})
+				case "definition-list":
+//line definitions.mml:350:3
+					return _definitions.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 350, Column: 10}, []interface{}{_context, _code})
+				case "assign":
+//line definitions.mml:352:3
+					return _assignment.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 352, Column: 10}, []interface{}{_context, _code})
+				case "assign-list":
+//line definitions.mml:354:3
+					return _assignments.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 354, Column: 10}, []interface{}{_context, _code})
+				case "ret":
+//line definitions.mml:356:3
+					return _ret.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 356, Column: 10}, []interface{}{_context, _code})
+				case "control-statement":
+//line definitions.mml:358:3
+					return _emptyResults
+				case "use":
+//line definitions.mml:360:3
+					return _validateUse.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 360, Column: 10}, []interface{}{_context, _code})
+				case "use-list":
+//line definitions.mml:362:3
+					return _useList.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 362, Column: 10}, []interface{}{_context, _code})
+				default:
+//line definitions.mml:364:3
+					return _statements.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 364, Column: 10}, []interface{}{_context, mml.Ref(mml.Pos{Path: "definitions.mml", Line: 364, Column: 30}, _code, "statements")})
+				}
 				return nil
-			},
-			FixedArgs: 2,
-		};
-_modules = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _entryPath = a[0];
-				;
-				mml.Nop(_entryPath);
-				return _parseModule.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["stack"] = &mml.List{Values: []interface{}{}};s.Values["parsed"] = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }();; return s }(), _entryPath)}).Values)
-			},
-			FixedArgs: 1,
-		}; exports["modules"] = _modules
+			}
+		}, FixedArgs: 2}
+//line definitions.mml:369:1
+		_topLevel = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context interface{}
+//line definitions.mml:370:2
+			_context = _newContext.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 370, Column: 14}, []interface{}{})
+//line definitions.mml:371:2
+			for __iter := mml.Iterate(mml.Pos{Path: "definitions.mml", Line: 371, Column: 6}, _keys.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 371, Column: 11}, []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 371, Column: 16}, _mmlcode, "builtin")})); __iter.Next(); {
+				_b := __iter.Value()
+//line definitions.mml:372:3
+				_define.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 372, Column: 3}, []interface{}{_context, _b, mml.NewList()})
+			}
+//line definitions.mml:375:2
+			return _context
+		}, FixedArgs: 0}
+		exports.Set("topLevel", _topLevel)
+//line definitions.mml:379:1
+		_validate = &mml.Function{F: func(a []interface{}) interface{} {
+			var _code = a[0]
+//line definitions.mml:379:26
+			return mml.Ref(mml.Pos{Path: "definitions.mml", Line: 379, Column: 26}, _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 379, Column: 26}, []interface{}{_topLevel.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 379, Column: 29}, []interface{}{}), _code}), "errors")
+		}, FixedArgs: 1}
+		exports.Set("validate", _validate)
+//line definitions.mml:383:1
+		_validateLine = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _code = a[1]
+			var _definitions interface{}
+			var _result interface{}
+//line definitions.mml:384:2
+			_definitions = mml.NewStruct(nil).Merge(mml.Ref(mml.Pos{Path: "definitions.mml", Line: 384, Column: 19}, _context, "definitions").(*mml.Struct))
+//line definitions.mml:385:2
+			_result = _do.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 385, Column: 13}, []interface{}{_context, _code})
+//line definitions.mml:386:2
+			if (_len.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 386, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "definitions.mml", Line: 386, Column: 9}, _result, "errors")}).(int) > 0) {
+//line definitions.mml:387:3
+				mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 387, Column: 3}, _context, "definitions", _definitions)
+			}
+//line definitions.mml:390:2
+			return mml.Ref(mml.Pos{Path: "definitions.mml", Line: 390, Column: 9}, _result, "errors")
+		}, FixedArgs: 2}
+		exports.Set("validateLine", _validateLine)
+//line definitions.mml:393:1
+		_isDefined = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _name = a[1]
+//line definitions.mml:393:37
+			return _definedCurrent.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 393, Column: 37}, []interface{}{_context, _name})
+		}, FixedArgs: 2}
+		exports.Set("isDefined", _isDefined)
+//line definitions.mml:396:1
+		_undefine = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _name = a[1]
+			var _d interface{}
+//line definitions.mml:397:2
+			_d = mml.Ref(mml.Pos{Path: "definitions.mml", Line: 397, Column: 8}, _context, "definitions")
+//line definitions.mml:398:2
+			mml.SetRef(mml.Pos{Path: "definitions.mml", Line: 398, Column: 2}, _context, "definitions", _fold.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 400, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _k = a[0]
+				var _s = a[1]
+//line definitions.mml:400:20
+				return mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With(_k.(string), mml.Ref(mml.Pos{Path: "definitions.mml", Line: 400, Column: 32}, _d, _k))
+			}, FixedArgs: 2}, mml.NewStruct(nil)}).(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 398, Column: 24}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 399, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _k = a[0]
+//line definitions.mml:399:19
+				return mml.BinaryOp(mml.Pos{Path: "definitions.mml", Line: 399, Column: 19}, 12, _k, _name)
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_keys.(*mml.Function).CallAt(mml.Pos{Path: "definitions.mml", Line: 398, Column: 24}, []interface{}{_d})})}))
+			return nil
+		}, FixedArgs: 2}
+		exports.Set("undefine", _undefine)
+//line main.go:3713
 		return exports
 	})
-modulePath = "definitions.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _newContext interface{};
-var _extend interface{};
-var _definedCurrent interface{};
-var _define interface{};
-var _assign interface{};
-var _defined interface{};
-var _capture interface{};
-var _values interface{};
-var _results interface{};
-var _resultValues interface{};
-var _resultErrors interface{};
-var _emptyResults interface{};
-var _mergeResults interface{};
-var _wrapWithReturn interface{};
-var _all interface{};
-var _scoped interface{};
-var _allScoped interface{};
-var _fields interface{};
-var _fieldsIfHas interface{};
-var _list interface{};
-var _struct interface{};
-var _rangeExpression interface{};
-var _indexer interface{};
-var _spread interface{};
-var _unary interface{};
-var _binary interface{};
-var _validateSend interface{};
-var _validateGo interface{};
-var _validateDefer interface{};
-var _definitions interface{};
-var _assignments interface{};
-var _ret interface{};
-var _useList interface{};
-var _undefined interface{};
-var _duplicate interface{};
-var _expandFunction interface{};
-var _symbol interface{};
-var _entry interface{};
-var _function interface{};
-var _application interface{};
-var _cond interface{};
-var _validateCase interface{};
-var _validateSwitch interface{};
-var _validateReceive interface{};
-var _validateSelect interface{};
-var _rangeOver interface{};
-var _loop interface{};
-var _definition interface{};
-var _assignment interface{};
-var _validateUse interface{};
-var _statements interface{};
-var _do interface{};
-var _validate interface{};
-var _mmlcode interface{};
-var _fold interface{};
-var _foldr interface{};
-var _map interface{};
-var _filter interface{};
-var _contains interface{};
-var _sort interface{};
-var _flat interface{};
-var _uniq interface{};
-var _join interface{};
-var _joins interface{};
-var _formats interface{};
-var _enum interface{};
-var _log interface{};
-var _onlyErr interface{};
-var _passErr interface{};
-mml.Nop(_newContext, _extend, _definedCurrent, _define, _assign, _defined, _capture, _values, _results, _resultValues, _resultErrors, _emptyResults, _mergeResults, _wrapWithReturn, _all, _scoped, _allScoped, _fields, _fieldsIfHas, _list, _struct, _rangeExpression, _indexer, _spread, _unary, _binary, _validateSend, _validateGo, _validateDefer, _definitions, _assignments, _ret, _useList, _undefined, _duplicate, _expandFunction, _symbol, _entry, _function, _application, _cond, _validateCase, _validateSwitch, _validateReceive, _validateSelect, _rangeOver, _loop, _definition, _assignment, _validateUse, _statements, _do, _validate, _mmlcode, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _uniq, _join, _joins, _formats, _enum, _log, _onlyErr, _passErr);
-var __lang = mml.Modules.Use("lang.mml");;_fold = __lang.Values["fold"];
-_foldr = __lang.Values["foldr"];
-_map = __lang.Values["map"];
-_filter = __lang.Values["filter"];
-_contains = __lang.Values["contains"];
-_sort = __lang.Values["sort"];
-_flat = __lang.Values["flat"];
-_uniq = __lang.Values["uniq"];
-_join = __lang.Values["join"];
-_joins = __lang.Values["joins"];
-_formats = __lang.Values["formats"];
-_enum = __lang.Values["enum"];
-_log = __lang.Values["log"];
-_onlyErr = __lang.Values["onlyErr"];
-_passErr = __lang.Values["passErr"];
-_mmlcode = mml.Modules.Use("code.mml");
-_newContext = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["definitions"] = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }();s.Values["unexpanded"] = &mml.List{Values: []interface{}{}};s.Values["capturing"] = false;; return s }()
-			},
-			FixedArgs: 0,
-		};
-_extend = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-				;
-				mml.Nop(_context);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _newContext.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values).(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["parent"] = _context;; return s }()
-			},
-			FixedArgs: 1,
-		};
-_definedCurrent = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _n = a[1];
-				;
-				mml.Nop(_context, _n);
-				return _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _n, mml.Ref(_context, "definitions"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_define = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _n = a[1];
-var _v = a[2];
-				;
-				mml.Nop(_context, _n, _v);
-				return _capture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _n, _v)}).Values)
-			},
-			FixedArgs: 3,
-		};
-_assign = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _n = a[1];
-var _v = a[2];
-				;
-				mml.Nop(_context, _n, _v);
-				return _capture.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _n, _v)}).Values)
-			},
-			FixedArgs: 3,
-		};
-_defined = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _n = a[1];
-				;
-				mml.Nop(_context, _n);
-				return (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _n, mml.Ref(_context, "definitions"))}).Values).(bool) || (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "parent", _context)}).Values).(bool) && _defined.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_context, "parent"), _n)}).Values).(bool)))
-			},
-			FixedArgs: 2,
-		};
-_capture = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _n = a[1];
-var _v = a[2];
-				;
-				mml.Nop(_context, _n, _v);
-				return mml.SetRef(mml.Ref(_context, "definitions"), _n, func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _n, mml.Ref(_context, "definitions"))}).Values); if c.(bool) { return &mml.List{Values: append(append([]interface{}{}, mml.Ref(mml.Ref(_context, "definitions"), _n).(*mml.List).Values...), _v.(*mml.List).Values...)} } else { return _v } }())
-			},
-			FixedArgs: 3,
-		};
-_values = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _n = a[1];
-				;
-				mml.Nop(_context, _n);
-				return func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _n, mml.Ref(_context, "definitions"))}).Values); if c.(bool) { return mml.Ref(mml.Ref(_context, "definitions"), _n) } else { return func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "parent", _context)}).Values); if c.(bool) { return _values.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_context, "parent"), _n)}).Values) } else { return &mml.List{Values: []interface{}{}} } }() } }()
-			},
-			FixedArgs: 2,
-		};
-_results = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _v = a[0];
-var _e = a[1];
-				;
-				mml.Nop(_v, _e);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["values"] = _v;s.Values["errors"] = _e;; return s }()
-			},
-			FixedArgs: 2,
-		};
-_resultValues = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _v = &mml.List{a[0:]};
-				;
-				mml.Nop(_v);
-				return _results.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _v, &mml.List{Values: []interface{}{}})}).Values)
-			},
-			FixedArgs: 0,
-		};
-_resultErrors = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _e = &mml.List{a[0:]};
-				;
-				mml.Nop(_e);
-				return _results.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: []interface{}{}}, _e)}).Values)
-			},
-			FixedArgs: 0,
-		};
-_emptyResults = _results.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: []interface{}{}}, &mml.List{Values: []interface{}{}})}).Values);
-_mergeResults = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = &mml.List{a[0:]};
-				;
-				mml.Nop(_r);
-				var _mergeTwo interface{};
-mml.Nop(_mergeTwo);
-_mergeTwo = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _left = a[0];
-var _right = a[1];
-				;
-				mml.Nop(_left, _right);
-				return _results.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: append(append([]interface{}{}, mml.Ref(_left, "values").(*mml.List).Values...), mml.Ref(_right, "values").(*mml.List).Values...)}, &mml.List{Values: append(append([]interface{}{}, mml.Ref(_left, "errors").(*mml.List).Values...), mml.Ref(_right, "errors").(*mml.List).Values...)})}).Values)
-			},
-			FixedArgs: 2,
-		};
-return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _mergeTwo, _emptyResults, _r)}).Values);
-				return nil
-			},
-			FixedArgs: 0,
-		};
-_wrapWithReturn = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0];
-				;
-				mml.Nop(_r);
-				return _results.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _v = a[0];
-				;
-				mml.Nop(_v);
-				return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["type"] = "ret";s.Values["value"] = _v;; return s }()
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_r, "values"))}).Values), mml.Ref(_r, "errors"))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_all = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_context, _l);
-				return (&mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0];
-				;
-				mml.Nop(_r);
-				return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _r.(*mml.List).Values...)}).Values)
-			},
-			FixedArgs: 1,
-		}).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context)}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_scoped = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _code = a[1];
-				;
-				mml.Nop(_context, _code);
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _extend.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context)}).Values), _code)}).Values)
-			},
-			FixedArgs: 2,
-		};
-_allScoped = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_context, _l);
-				return (&mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0];
-				;
-				mml.Nop(_r);
-				return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _r.(*mml.List).Values...)}).Values)
-			},
-			FixedArgs: 1,
-		}).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _scoped.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context)}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_fields = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _s = a[1];
-var _f = a[2];
-				;
-				mml.Nop(_context, _s, _f);
-				return _all.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-				;
-				mml.Nop(_f);
-				return mml.Ref(_s, _f)
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f)}).Values))}).Values)
-			},
-			FixedArgs: 3,
-		};
-_fieldsIfHas = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _f = a[1];
-var _s = a[2];
-				;
-				mml.Nop(_context, _f, _s);
-				return _fields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _s)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-				;
-				mml.Nop(_f);
-				return _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f, _s)}).Values)
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f)}).Values))}).Values)
-			},
-			FixedArgs: 3,
-		};
-_list = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_context, _l);
-				return _all.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_l, "values"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_struct = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _s = a[1];
-				;
-				mml.Nop(_context, _s);
-				return _all.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_s, "entries"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_rangeExpression = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _r = a[1];
-				;
-				mml.Nop(_context, _r);
-				return _fieldsIfHas.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, &mml.List{Values: append([]interface{}{}, "from", "to")}, _r)}).Values)
-			},
-			FixedArgs: 2,
-		};
-_indexer = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _i = a[1];
-				;
-				mml.Nop(_context, _i);
-				return _all.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, &mml.List{Values: append([]interface{}{}, mml.Ref(_i, "index"), mml.Ref(_i, "expression"))})}).Values)
-			},
-			FixedArgs: 2,
-		};
-_spread = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _s = a[1];
-				;
-				mml.Nop(_context, _s);
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_s, "value"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_unary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _u = a[1];
-				;
-				mml.Nop(_context, _u);
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_u, "arg"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_binary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _b = a[1];
-				;
-				mml.Nop(_context, _b);
-				return _fields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _b, &mml.List{Values: append([]interface{}{}, "left", "right")})}).Values)
-			},
-			FixedArgs: 2,
-		};
-_validateSend = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _s = a[1];
-				;
-				mml.Nop(_context, _s);
-				return _fields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _s, &mml.List{Values: append([]interface{}{}, "channel", "value")})}).Values)
-			},
-			FixedArgs: 2,
-		};
-_validateGo = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _g = a[1];
-				;
-				mml.Nop(_context, _g);
-				return _fields.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _g, &mml.List{Values: append([]interface{}{}, "application")})}).Values)
-			},
-			FixedArgs: 2,
-		};
-_validateDefer = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _d = a[1];
-				;
-				mml.Nop(_context, _d);
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_d, "application"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_definitions = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _d = a[1];
-				;
-				mml.Nop(_context, _d);
-				return _all.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_d, "definitions"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_assignments = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _a = a[1];
-				;
-				mml.Nop(_context, _a);
-				return _all.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_a, "assignments"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_ret = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _r = a[1];
-				;
-				mml.Nop(_context, _r);
-				return _fieldsIfHas.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, &mml.List{Values: append([]interface{}{}, "value")}, _r)}).Values)
-			},
-			FixedArgs: 2,
-		};
-_useList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _u = a[1];
-				;
-				mml.Nop(_context, _u);
-				return _all.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_u, "uses"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_undefined = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _name = a[0];
-				;
-				mml.Nop(_name);
-				return _error.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "undefined: %s", _name)}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_duplicate = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _name = a[0];
-				;
-				mml.Nop(_name);
-				return _error.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "duplicate definition: %s", _name)}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_expandFunction = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-				;
-				mml.Nop(_f);
-				var _c interface{};
-mml.Nop(_c);
-c = mml.Ref(_f, "expanded"); if c.(bool) { ;
-mml.Nop();
-return _emptyResults };
-mml.SetRef(_f, "expanded", true);
-_c = _extend.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_f, "context"))}).Values);
-for _, _p := range mml.Ref(_f, "params").(*mml.List).Values {
-;
-mml.Nop();
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c, _p, &mml.List{Values: []interface{}{}})}).Values)
-};
-c = mml.BinaryOp(12, mml.Ref(_f, "collectParam"), ""); if c.(bool) { ;
-mml.Nop();
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c, mml.Ref(_f, "collectParam"), &mml.List{Values: []interface{}{}})}).Values) };
-return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c, mml.Ref(_f, "statement"))}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_symbol = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _s = a[1];
-				;
-				mml.Nop(_context, _s);
-				var _r interface{};
-mml.Nop(_r);
-_r = func () interface{} { c = _defined.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_s, "name"))}).Values); if c.(bool) { return _resultValues.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _values.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_s, "name"))}).Values).(*mml.List).Values...)}).Values) } else { return _resultErrors.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _undefined.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "name"))}).Values))}).Values) } }();
-c = mml.Ref(_context, "capturing"); if c.(bool) { ;
-mml.Nop();
-return _r };
-for _, _v := range mml.Ref(_r, "values").(*mml.List).Values {
-;
-mml.Nop();
-c = (!_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _v)}).Values).(bool) || mml.BinaryOp(12, mml.Ref(_v, "type"), "function").(bool)); if c.(bool) { ;
-mml.Nop();
-continue };
-_r = _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _r, _expandFunction.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _v)}).Values))}).Values)
-};
-return _r;
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_entry = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _e = a[1];
-				;
-				mml.Nop(_context, _e);
-				var _kr interface{};
-mml.Nop(_kr);
-_kr = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, func () interface{} { c = (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", mml.Ref(_e, "key"))}).Values).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(_e, "key"), "type"), "symbol").(bool)); if c.(bool) { return mml.Ref(mml.Ref(_e, "key"), "name") } else { return mml.Ref(_e, "key") } }())}).Values);
-return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _kr, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_e, "value"))}).Values))}).Values);
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_function = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _f = a[1];
-				;
-				mml.Nop(_context, _f);
-				var _ff interface{};
-mml.Nop(_ff);
-_ff = func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; func() { sp := _f.(*mml.Struct);; for k, v := range sp.Values { s.Values[k] = v }; }();
-s.Values["context"] = _context;s.Values["expanded"] = false;; return s }();
-c = mml.Ref(_context, "capturing"); if c.(bool) { ;
-mml.Nop();
-mml.SetRef(_context, "unexpanded", &mml.List{Values: append(append([]interface{}{}, mml.Ref(_context, "unexpanded").(*mml.List).Values...), _ff)});
-return _resultValues.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ff)}).Values) };
-return _expandFunction.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _ff)}).Values);
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_application = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _a = a[1];
-				;
-				mml.Nop(_context, _a);
-				var _capturing interface{};
-var _r interface{};
-mml.Nop(_capturing, _r);
-_capturing = mml.Ref(_context, "capturing");
-mml.SetRef(_context, "capturing", false);
-_r = _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_a, "function"))}).Values), _all.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_a, "args"))}).Values))}).Values);
-mml.SetRef(_context, "capturing", _capturing);
-return _r;
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_cond = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _c = a[1];
-				;
-				mml.Nop(_context, _c);
-				return func () interface{} { c = mml.Ref(_c, "ternary"); if c.(bool) { return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_c, "condition"))}).Values), _scoped.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_c, "consequent"))}).Values), _fieldsIfHas.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _extend.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context)}).Values), &mml.List{Values: append([]interface{}{}, "alternative")}, _c)}).Values))}).Values) } else { return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_c, "condition"))}).Values), _wrapWithReturn.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _scoped.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_c, "consequent"))}).Values))}).Values), _wrapWithReturn.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _fieldsIfHas.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _extend.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context)}).Values), &mml.List{Values: append([]interface{}{}, "alternative")}, _c)}).Values))}).Values))}).Values) } }()
-			},
-			FixedArgs: 2,
-		};
-_validateCase = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _c = a[1];
-				;
-				mml.Nop(_context, _c);
-				return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_c, "expression"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_c, "body"))}).Values))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_validateSwitch = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _s = a[1];
-				;
-				mml.Nop(_context, _s);
-				return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _fieldsIfHas.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, &mml.List{Values: append([]interface{}{}, "expression")}, _s)}).Values), _wrapWithReturn.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _allScoped.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_s, "cases"))}).Values))}).Values), _wrapWithReturn.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _scoped.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_s, "defaultStatements"))}).Values))}).Values))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_validateReceive = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _r = a[1];
-				;
-				mml.Nop(_context, _r);
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_r, "channel"))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_validateSelect = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _s = a[1];
-				;
-				mml.Nop(_context, _s);
-				return _wrapWithReturn.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _allScoped.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_s, "cases"))}).Values), func () interface{} { c = mml.Ref(_s, "hasDefault"); if c.(bool) { return _scoped.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_s, "defaultStatements"))}).Values) } else { return _emptyResults } }())}).Values))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_rangeOver = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _r = a[1];
-				;
-				mml.Nop(_context, _r);
-				var _result interface{};
-mml.Nop(_result);
-c = !_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", _r)}).Values).(bool); if c.(bool) { ;
-mml.Nop();
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_r, "symbol"), &mml.List{Values: append([]interface{}{}, 0)})}).Values);
-return _emptyResults };
-mml.SetRef(_context, "capturing", true);
-_result = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_r, "expression"))}).Values);
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_r, "symbol"), mml.Ref(_result, "values"))}).Values);
-mml.SetRef(_context, "capturing", false);
-return _result;
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_loop = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _l = a[1];
-				;
-				mml.Nop(_context, _l);
-				var _c interface{};
-mml.Nop(_c);
-_c = _extend.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context)}).Values);
-return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", _l)}).Values); if c.(bool) { return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c, mml.Ref(_l, "expression"))}).Values) } else { return _emptyResults } }(), _wrapWithReturn.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c, mml.Ref(_l, "body"))}).Values))}).Values))}).Values);
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_definition = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _d = a[1];
-				;
-				mml.Nop(_context, _d);
-				var _r interface{};
-mml.Nop(_r);
-c = _definedCurrent.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_d, "symbol"))}).Values); if c.(bool) { ;
-mml.Nop();
-return _resultErrors.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _duplicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_d, "symbol"))}).Values))}).Values) };
-mml.SetRef(_context, "capturing", true);
-_r = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_d, "expression"))}).Values);
-mml.SetRef(_context, "capturing", false);
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_d, "symbol"), mml.Ref(_r, "values"))}).Values);
-return _r;
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_assignment = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _a = a[1];
-				;
-				mml.Nop(_context, _a);
-				var _cr interface{};
-var _er interface{};
-mml.Nop(_cr, _er);
-_cr = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_a, "capture"))}).Values);
-mml.SetRef(_context, "capturing", true);
-_er = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_a, "value"))}).Values);
-mml.SetRef(_context, "capturing", false);
-return _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _cr, _er)}).Values);
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_validateUse = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _u = a[1];
-				;
-				mml.Nop(_context, _u);
-				;
-mml.Nop();
-c = mml.BinaryOp(11, mml.Ref(_u, "capture"), ""); if c.(bool) { ;
-mml.Nop();
-c = _definedCurrent.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_u, "path"))}).Values); if c.(bool) { ;
-mml.Nop();
-return _resultErrors.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _duplicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "path"))}).Values))}).Values) };
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_u, "path"), &mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }())})}).Values);
-return _emptyResults };
-c = mml.BinaryOp(11, mml.Ref(_u, "capture"), "."); if c.(bool) { ;
-mml.Nop();
-for _, _name := range mml.Ref(_u, "exportNames").(*mml.List).Values {
-;
-mml.Nop();
-c = _definedCurrent.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _name)}).Values); if c.(bool) { ;
-mml.Nop();
-return _resultErrors.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _duplicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _name)}).Values))}).Values) };
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _name, &mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }())})}).Values)
-};
-return _emptyResults };
-c = _definedCurrent.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_u, "capture"))}).Values); if c.(bool) { ;
-mml.Nop();
-return _resultErrors.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _duplicate.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "path"))}).Values))}).Values) };
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_u, "capture"), &mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; ; return s }())})}).Values);
-return _emptyResults;
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_statements = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _s = a[1];
-				;
-				mml.Nop(_context, _s);
-				var _r interface{};
-mml.Nop(_r);
-_r = _emptyResults;
-for _, _si := range _s.(*mml.List).Values {
-var _ri interface{};
-mml.Nop(_ri);
-_ri = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _si)}).Values);
-c = (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _si)}).Values).(bool) && mml.BinaryOp(11, mml.Ref(_si, "type"), "ret").(bool)); if c.(bool) { ;
-mml.Nop();
-_r = _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _r, _ri)}).Values) } else { ;
-mml.Nop();
-_r = _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _r, _resultErrors.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_ri, "errors").(*mml.List).Values...)}).Values))}).Values) }
-};
-for _, _f := range mml.Ref(_context, "unexpanded").(*mml.List).Values {
-;
-mml.Nop();
-_r = _mergeResults.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _r, _expandFunction.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _f)}).Values))}).Values)
-};
-mml.SetRef(_context, "unexpanded", &mml.List{Values: []interface{}{}});
-return _r;
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_do = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _context = a[0];
-var _code = a[1];
-				;
-				mml.Nop(_context, _code);
-				;
-mml.Nop();
-c = !_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _code)}).Values).(bool); if c.(bool) { ;
-mml.Nop();
-return _emptyResults };
-switch mml.Ref(_code, "type") {
-case "comment":
-;
-mml.Nop();
-return _emptyResults
-case "symbol":
-;
-mml.Nop();
-return _symbol.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "list":
-;
-mml.Nop();
-return _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "entry":
-;
-mml.Nop();
-return _entry.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "struct":
-;
-mml.Nop();
-return _struct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "function":
-;
-mml.Nop();
-return _function.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "range-expression":
-;
-mml.Nop();
-return _rangeExpression.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "indexer":
-;
-mml.Nop();
-return _indexer.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "spread":
-;
-mml.Nop();
-return _spread.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "function-application":
-;
-mml.Nop();
-return _application.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "unary":
-;
-mml.Nop();
-return _unary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "binary":
-;
-mml.Nop();
-return _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "cond":
-;
-mml.Nop();
-return _cond.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "switch-case":
-;
-mml.Nop();
-return _validateCase.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "switch-statement":
-;
-mml.Nop();
-return _validateSwitch.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "send":
-;
-mml.Nop();
-return _validateSend.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "receive":
-;
-mml.Nop();
-return _validateReceive.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "go":
-;
-mml.Nop();
-return _validateGo.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "defer":
-;
-mml.Nop();
-return _validateDefer.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "select-case":
-;
-mml.Nop();
-return _validateCase.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "select":
-;
-mml.Nop();
-return _validateSelect.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "range-over":
-;
-mml.Nop();
-return _rangeOver.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "loop":
-;
-mml.Nop();
-return _loop.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "definition":
-;
-mml.Nop();
-return _definition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "definition-list":
-;
-mml.Nop();
-return _definitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "assign":
-;
-mml.Nop();
-return _assignment.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "assign-list":
-;
-mml.Nop();
-return _assignments.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "ret":
-;
-mml.Nop();
-return _ret.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "control-statement":
-;
-mml.Nop();
-return _emptyResults
-case "use":
-;
-mml.Nop();
-return _validateUse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-case "use-list":
-;
-mml.Nop();
-return _useList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values)
-default:
-;
-mml.Nop();
-return _statements.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, mml.Ref(_code, "statements"))}).Values)
-};
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_validate = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _code = a[0];
-				;
-				mml.Nop(_code);
-				var _context interface{};
-var _result interface{};
-mml.Nop(_context, _result);
-_context = _newContext.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values);
-for _, _b := range _keys.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_mmlcode, "builtin"))}).Values).(*mml.List).Values {
-;
-mml.Nop();
-_define.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _b, &mml.List{Values: []interface{}{}})}).Values)
-};
-_result = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _context, _code)}).Values);
-return mml.Ref(_result, "errors");
-				return nil
-			},
-			FixedArgs: 1,
-		}; exports["validate"] = _validate
+}
+func init() {
+	mml.Modules.Set("snippets.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _modules interface{}
+		var _moduleInit interface{}
+		var _main interface{}
+		var _goast interface{}
+		_goast = loader.Use("goast.mml")
+//line snippets.mml:3:1
+		_modules = mml.Ref(mml.Pos{Path: "snippets.mml", Line: 3, Column: 13}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 3, Column: 13}, []interface{}{"mml", "Modules"})
+//line snippets.mml:6:1
+		_moduleInit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _body = a[1]
+			var _params interface{}
+			var _results interface{}
+			var _exports interface{}
+			var _init interface{}
+//line snippets.mml:7:2
+			_params = mml.NewList(mml.Ref(mml.Pos{Path: "snippets.mml", Line: 8, Column: 12}, _goast, "field").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 8, Column: 12}, []interface{}{"loader", "*mml.Loader"}))
+			_results = mml.NewList(mml.Ref(mml.Pos{Path: "snippets.mml", Line: 9, Column: 12}, _goast, "field").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 9, Column: 12}, []interface{}{"", "*mml.Struct"}))
+			_exports = mml.Ref(mml.Pos{Path: "snippets.mml", Line: 10, Column: 11}, _goast, "call").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 10, Column: 11}, []interface{}{mml.Ref(mml.Pos{Path: "snippets.mml", Line: 10, Column: 22}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 10, Column: 22}, []interface{}{"mml", "NewStruct"}), mml.Ref(mml.Pos{Path: "snippets.mml", Line: 10, Column: 58}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 10, Column: 58}, []interface{}{"nil"})})
+//line snippets.mml:13:2
+			_init = mml.Ref(mml.Pos{Path: "snippets.mml", Line: 13, Column: 11}, _goast, "funcLit").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 13, Column: 11}, []interface{}{_params, _results, mml.NewList(mml.Ref(mml.Pos{Path: "snippets.mml", Line: 14, Column: 3}, _goast, "define").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 14, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "snippets.mml", Line: 14, Column: 16}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 14, Column: 16}, []interface{}{"exports"}), _exports}), _body, mml.Ref(mml.Pos{Path: "snippets.mml", Line: 16, Column: 3}, _goast, "lineReset").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 16, Column: 3}, []interface{}{}), mml.Ref(mml.Pos{Path: "snippets.mml", Line: 17, Column: 3}, _goast, "returnStmt").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 17, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "snippets.mml", Line: 17, Column: 20}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 17, Column: 20}, []interface{}{"exports"})}))})
+//line snippets.mml:20:2
+			return mml.Ref(mml.Pos{Path: "snippets.mml", Line: 20, Column: 9}, _goast, "call").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 20, Column: 9}, []interface{}{mml.Ref(mml.Pos{Path: "snippets.mml", Line: 20, Column: 20}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 20, Column: 20}, []interface{}{_modules, "Set"}), mml.NewList(mml.Ref(mml.Pos{Path: "snippets.mml", Line: 20, Column: 53}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 20, Column: 53}, []interface{}{_path}), _init)})
+		}, FixedArgs: 2}
+		exports.Set("moduleInit", _moduleInit)
+//line snippets.mml:23:1
+		_main = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+//line snippets.mml:23:22
+			return mml.Ref(mml.Pos{Path: "snippets.mml", Line: 23, Column: 22}, _goast, "funcDecl").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 23, Column: 22}, []interface{}{"main", mml.Ref(mml.Pos{Path: "snippets.mml", Line: 25, Column: 2}, _goast, "call").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 25, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "snippets.mml", Line: 25, Column: 13}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 25, Column: 13}, []interface{}{_modules, "Use"}), mml.Ref(mml.Pos{Path: "snippets.mml", Line: 25, Column: 45}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "snippets.mml", Line: 25, Column: 45}, []interface{}{_path})})})
+		}, FixedArgs: 1}
+		exports.Set("main", _main)
+//line main.go:3752
 		return exports
 	})
-modulePath = "snippets.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _head interface{};
-var _initHead interface{};
-var _initFooter interface{};
-var _moduleHead interface{};
-var _moduleFooter interface{};
-var _mainHead interface{};
-var _mainFooter interface{};
-mml.Nop(_head, _initHead, _initFooter, _moduleHead, _moduleFooter, _mainHead, _mainFooter);
-_head = "// Generated code\npackage main\n\nimport \"github.com/aryszka/mml\"\n"; exports["head"] = _head;
-_initHead = "\nfunc init() {\n\tvar modulePath string\n"; exports["initHead"] = _initHead;
-_initFooter = "\n}\n"; exports["initFooter"] = _initFooter;
-_moduleHead = "\n\tmml.Modules.Set(modulePath, func() map[string]interface{} {\n\t\texports := make(map[string]interface{})\n\n\t\tvar c interface{}\n\t\tmml.Nop(c)\n"; exports["moduleHead"] = _moduleHead;
-_moduleFooter = "\n\t\treturn exports\n\t})\n"; exports["moduleFooter"] = _moduleFooter;
-_mainHead = "\nfunc main() {\n\tmml.Modules.Use(\""; exports["mainHead"] = _mainHead;
-_mainFooter = "\")\n}\n"; exports["mainFooter"] = _mainFooter
+}
+func init() {
+	mml.Modules.Set("goast.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _goIdent interface{}
+		var _goSelector interface{}
+		var _goCall interface{}
+		var _goCallSpread interface{}
+		var _goInt interface{}
+		var _goFloat interface{}
+		var _goString interface{}
+		var _goBool interface{}
+		var _goTypeAssert interface{}
+		var _goUnary interface{}
+		var _goBinary interface{}
+		var _goParen interface{}
+		var _goComposite interface{}
+		var _goKeyValue interface{}
+		var _goIndex interface{}
+		var _goSliceFrom interface{}
+		var _goField interface{}
+		var _goFuncLit interface{}
+		var _goAssign interface{}
+		var _goDefine interface{}
+		var _goInc interface{}
+		var _goDeclare interface{}
+		var _goDeclareValue interface{}
+		var _goDeclareTyped interface{}
+		var _goReturn interface{}
+		var _goIf interface{}
+		var _goIfElse interface{}
+		var _goFor interface{}
+		var _goCase interface{}
+		var _goSwitch interface{}
+		var _goCommCase interface{}
+		var _goSelect interface{}
+		var _goSend interface{}
+		var _goGo interface{}
+		var _goDefer interface{}
+		var _goBranch interface{}
+		var _goLabeled interface{}
+		var _goLine interface{}
+		var _goImport interface{}
+		var _goFuncDecl interface{}
+		var _goFile interface{}
+		var _goRender interface{}
+		var _ident interface{}
+		var _selector interface{}
+		var _call interface{}
+		var _callSpread interface{}
+		var _intLit interface{}
+		var _floatLit interface{}
+		var _stringLit interface{}
+		var _boolLit interface{}
+		var _typeAssert interface{}
+		var _unary interface{}
+		var _binary interface{}
+		var _paren interface{}
+		var _composite interface{}
+		var _keyValue interface{}
+		var _index interface{}
+		var _sliceFrom interface{}
+		var _field interface{}
+		var _funcLit interface{}
+		var _assign interface{}
+		var _define interface{}
+		var _inc interface{}
+		var _declare interface{}
+		var _declareValue interface{}
+		var _declareTyped interface{}
+		var _returnStmt interface{}
+		var _ifStmt interface{}
+		var _ifElse interface{}
+		var _forStmt interface{}
+		var _caseClause interface{}
+		var _switchStmt interface{}
+		var _commClause interface{}
+		var _selectStmt interface{}
+		var _sendStmt interface{}
+		var _goStmt interface{}
+		var _deferStmt interface{}
+		var _branch interface{}
+		var _branchTo interface{}
+		var _labeled interface{}
+		var _line interface{}
+		var _lineReset interface{}
+		var _importSpec interface{}
+		var _funcDecl interface{}
+		var _file interface{}
+		var _render interface{}
+//line goast.mml:4:1
+		_goIdent = mml.Interop(mml.Pos{Path: "goast.mml", Line: 5, Column: 17}, "github.com/aryszka/mml/goast.Ident", __interop_github_46_com_47_aryszka_47_mml_47_goast.Ident)
+		_goSelector = mml.Interop(mml.Pos{Path: "goast.mml", Line: 6, Column: 17}, "github.com/aryszka/mml/goast.Selector", __interop_github_46_com_47_aryszka_47_mml_47_goast.Selector)
+		_goCall = mml.Interop(mml.Pos{Path: "goast.mml", Line: 7, Column: 17}, "github.com/aryszka/mml/goast.Call", __interop_github_46_com_47_aryszka_47_mml_47_goast.Call)
+		_goCallSpread = mml.Interop(mml.Pos{Path: "goast.mml", Line: 8, Column: 17}, "github.com/aryszka/mml/goast.CallSpread", __interop_github_46_com_47_aryszka_47_mml_47_goast.CallSpread)
+		_goInt = mml.Interop(mml.Pos{Path: "goast.mml", Line: 9, Column: 17}, "github.com/aryszka/mml/goast.Int", __interop_github_46_com_47_aryszka_47_mml_47_goast.Int)
+		_goFloat = mml.Interop(mml.Pos{Path: "goast.mml", Line: 10, Column: 17}, "github.com/aryszka/mml/goast.Float", __interop_github_46_com_47_aryszka_47_mml_47_goast.Float)
+		_goString = mml.Interop(mml.Pos{Path: "goast.mml", Line: 11, Column: 17}, "github.com/aryszka/mml/goast.String", __interop_github_46_com_47_aryszka_47_mml_47_goast.String)
+		_goBool = mml.Interop(mml.Pos{Path: "goast.mml", Line: 12, Column: 17}, "github.com/aryszka/mml/goast.Bool", __interop_github_46_com_47_aryszka_47_mml_47_goast.Bool)
+		_goTypeAssert = mml.Interop(mml.Pos{Path: "goast.mml", Line: 13, Column: 17}, "github.com/aryszka/mml/goast.TypeAssert", __interop_github_46_com_47_aryszka_47_mml_47_goast.TypeAssert)
+		_goUnary = mml.Interop(mml.Pos{Path: "goast.mml", Line: 14, Column: 17}, "github.com/aryszka/mml/goast.Unary", __interop_github_46_com_47_aryszka_47_mml_47_goast.Unary)
+		_goBinary = mml.Interop(mml.Pos{Path: "goast.mml", Line: 15, Column: 17}, "github.com/aryszka/mml/goast.Binary", __interop_github_46_com_47_aryszka_47_mml_47_goast.Binary)
+		_goParen = mml.Interop(mml.Pos{Path: "goast.mml", Line: 16, Column: 17}, "github.com/aryszka/mml/goast.Paren", __interop_github_46_com_47_aryszka_47_mml_47_goast.Paren)
+		_goComposite = mml.Interop(mml.Pos{Path: "goast.mml", Line: 17, Column: 17}, "github.com/aryszka/mml/goast.Composite", __interop_github_46_com_47_aryszka_47_mml_47_goast.Composite)
+		_goKeyValue = mml.Interop(mml.Pos{Path: "goast.mml", Line: 18, Column: 17}, "github.com/aryszka/mml/goast.KeyValue", __interop_github_46_com_47_aryszka_47_mml_47_goast.KeyValue)
+		_goIndex = mml.Interop(mml.Pos{Path: "goast.mml", Line: 19, Column: 17}, "github.com/aryszka/mml/goast.Index", __interop_github_46_com_47_aryszka_47_mml_47_goast.Index)
+		_goSliceFrom = mml.Interop(mml.Pos{Path: "goast.mml", Line: 20, Column: 17}, "github.com/aryszka/mml/goast.SliceFrom", __interop_github_46_com_47_aryszka_47_mml_47_goast.SliceFrom)
+		_goField = mml.Interop(mml.Pos{Path: "goast.mml", Line: 21, Column: 17}, "github.com/aryszka/mml/goast.Field", __interop_github_46_com_47_aryszka_47_mml_47_goast.Field)
+		_goFuncLit = mml.Interop(mml.Pos{Path: "goast.mml", Line: 22, Column: 17}, "github.com/aryszka/mml/goast.FuncLit", __interop_github_46_com_47_aryszka_47_mml_47_goast.FuncLit)
+		_goAssign = mml.Interop(mml.Pos{Path: "goast.mml", Line: 23, Column: 17}, "github.com/aryszka/mml/goast.Assign", __interop_github_46_com_47_aryszka_47_mml_47_goast.Assign)
+		_goDefine = mml.Interop(mml.Pos{Path: "goast.mml", Line: 24, Column: 17}, "github.com/aryszka/mml/goast.Define", __interop_github_46_com_47_aryszka_47_mml_47_goast.Define)
+		_goInc = mml.Interop(mml.Pos{Path: "goast.mml", Line: 25, Column: 17}, "github.com/aryszka/mml/goast.Inc", __interop_github_46_com_47_aryszka_47_mml_47_goast.Inc)
+		_goDeclare = mml.Interop(mml.Pos{Path: "goast.mml", Line: 26, Column: 17}, "github.com/aryszka/mml/goast.Declare", __interop_github_46_com_47_aryszka_47_mml_47_goast.Declare)
+		_goDeclareValue = mml.Interop(mml.Pos{Path: "goast.mml", Line: 27, Column: 17}, "github.com/aryszka/mml/goast.DeclareValue", __interop_github_46_com_47_aryszka_47_mml_47_goast.DeclareValue)
+		_goDeclareTyped = mml.Interop(mml.Pos{Path: "goast.mml", Line: 28, Column: 17}, "github.com/aryszka/mml/goast.DeclareTyped", __interop_github_46_com_47_aryszka_47_mml_47_goast.DeclareTyped)
+		_goReturn = mml.Interop(mml.Pos{Path: "goast.mml", Line: 29, Column: 17}, "github.com/aryszka/mml/goast.Return", __interop_github_46_com_47_aryszka_47_mml_47_goast.Return)
+		_goIf = mml.Interop(mml.Pos{Path: "goast.mml", Line: 30, Column: 17}, "github.com/aryszka/mml/goast.If", __interop_github_46_com_47_aryszka_47_mml_47_goast.If)
+		_goIfElse = mml.Interop(mml.Pos{Path: "goast.mml", Line: 31, Column: 17}, "github.com/aryszka/mml/goast.IfElse", __interop_github_46_com_47_aryszka_47_mml_47_goast.IfElse)
+		_goFor = mml.Interop(mml.Pos{Path: "goast.mml", Line: 32, Column: 17}, "github.com/aryszka/mml/goast.For", __interop_github_46_com_47_aryszka_47_mml_47_goast.For)
+		_goCase = mml.Interop(mml.Pos{Path: "goast.mml", Line: 33, Column: 17}, "github.com/aryszka/mml/goast.Case", __interop_github_46_com_47_aryszka_47_mml_47_goast.Case)
+		_goSwitch = mml.Interop(mml.Pos{Path: "goast.mml", Line: 34, Column: 17}, "github.com/aryszka/mml/goast.Switch", __interop_github_46_com_47_aryszka_47_mml_47_goast.Switch)
+		_goCommCase = mml.Interop(mml.Pos{Path: "goast.mml", Line: 35, Column: 17}, "github.com/aryszka/mml/goast.CommCase", __interop_github_46_com_47_aryszka_47_mml_47_goast.CommCase)
+		_goSelect = mml.Interop(mml.Pos{Path: "goast.mml", Line: 36, Column: 17}, "github.com/aryszka/mml/goast.Select", __interop_github_46_com_47_aryszka_47_mml_47_goast.Select)
+		_goSend = mml.Interop(mml.Pos{Path: "goast.mml", Line: 37, Column: 17}, "github.com/aryszka/mml/goast.Send", __interop_github_46_com_47_aryszka_47_mml_47_goast.Send)
+		_goGo = mml.Interop(mml.Pos{Path: "goast.mml", Line: 38, Column: 17}, "github.com/aryszka/mml/goast.Go", __interop_github_46_com_47_aryszka_47_mml_47_goast.Go)
+		_goDefer = mml.Interop(mml.Pos{Path: "goast.mml", Line: 39, Column: 17}, "github.com/aryszka/mml/goast.Defer", __interop_github_46_com_47_aryszka_47_mml_47_goast.Defer)
+		_goBranch = mml.Interop(mml.Pos{Path: "goast.mml", Line: 40, Column: 17}, "github.com/aryszka/mml/goast.Branch", __interop_github_46_com_47_aryszka_47_mml_47_goast.Branch)
+		_goLabeled = mml.Interop(mml.Pos{Path: "goast.mml", Line: 41, Column: 17}, "github.com/aryszka/mml/goast.Labeled", __interop_github_46_com_47_aryszka_47_mml_47_goast.Labeled)
+		_goLine = mml.Interop(mml.Pos{Path: "goast.mml", Line: 42, Column: 17}, "github.com/aryszka/mml/goast.Line", __interop_github_46_com_47_aryszka_47_mml_47_goast.Line)
+		_goImport = mml.Interop(mml.Pos{Path: "goast.mml", Line: 43, Column: 17}, "github.com/aryszka/mml/goast.Import", __interop_github_46_com_47_aryszka_47_mml_47_goast.Import)
+		_goFuncDecl = mml.Interop(mml.Pos{Path: "goast.mml", Line: 44, Column: 17}, "github.com/aryszka/mml/goast.FuncDecl", __interop_github_46_com_47_aryszka_47_mml_47_goast.FuncDecl)
+		_goFile = mml.Interop(mml.Pos{Path: "goast.mml", Line: 45, Column: 17}, "github.com/aryszka/mml/goast.File", __interop_github_46_com_47_aryszka_47_mml_47_goast.File)
+		_goRender = mml.Interop(mml.Pos{Path: "goast.mml", Line: 46, Column: 17}, "github.com/aryszka/mml/goast.Render", __interop_github_46_com_47_aryszka_47_mml_47_goast.Render)
+//line goast.mml:50:1
+		_ident = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+//line goast.mml:51:33
+			return _goIdent.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 51, Column: 33}, []interface{}{_name})
+		}, FixedArgs: 1}
+		exports.Set("ident", _ident)
+		_selector = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+			var _name = a[1]
+//line goast.mml:52:33
+			return _goSelector.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 52, Column: 33}, []interface{}{_x, _name})
+		}, FixedArgs: 2}
+		exports.Set("selector", _selector)
+		_call = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+			var _args = a[1]
+//line goast.mml:53:33
+			return _goCall.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 53, Column: 33}, []interface{}{_f, _args})
+		}, FixedArgs: 2}
+		exports.Set("call", _call)
+		_callSpread = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+			var _args = a[1]
+//line goast.mml:54:33
+			return _goCallSpread.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 54, Column: 33}, []interface{}{_f, _args})
+		}, FixedArgs: 2}
+		exports.Set("callSpread", _callSpread)
+		_intLit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _i = a[0]
+//line goast.mml:55:33
+			return _goInt.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 55, Column: 33}, []interface{}{_i})
+		}, FixedArgs: 1}
+		exports.Set("intLit", _intLit)
+		_floatLit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+//line goast.mml:56:33
+			return _goFloat.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 56, Column: 33}, []interface{}{_f})
+		}, FixedArgs: 1}
+		exports.Set("floatLit", _floatLit)
+		_stringLit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+//line goast.mml:57:33
+			return _goString.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 57, Column: 33}, []interface{}{_s})
+		}, FixedArgs: 1}
+		exports.Set("stringLit", _stringLit)
+		_boolLit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _b = a[0]
+//line goast.mml:58:33
+			return _goBool.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 58, Column: 33}, []interface{}{_b})
+		}, FixedArgs: 1}
+		exports.Set("boolLit", _boolLit)
+		_typeAssert = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+			var _t = a[1]
+//line goast.mml:59:33
+			return _goTypeAssert.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 59, Column: 33}, []interface{}{_x, _t})
+		}, FixedArgs: 2}
+		exports.Set("typeAssert", _typeAssert)
+		_unary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _op = a[0]
+			var _x = a[1]
+//line goast.mml:60:33
+			return _goUnary.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 60, Column: 33}, []interface{}{_op, _x})
+		}, FixedArgs: 2}
+		exports.Set("unary", _unary)
+		_binary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _op = a[0]
+			var _x = a[1]
+			var _y = a[2]
+//line goast.mml:61:33
+			return _goBinary.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 61, Column: 33}, []interface{}{_op, _x, _y})
+		}, FixedArgs: 3}
+		exports.Set("binary", _binary)
+		_paren = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+//line goast.mml:62:33
+			return _goParen.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 62, Column: 33}, []interface{}{_x})
+		}, FixedArgs: 1}
+		exports.Set("paren", _paren)
+		_composite = &mml.Function{F: func(a []interface{}) interface{} {
+			var _t = a[0]
+			var _elements = a[1]
+//line goast.mml:63:33
+			return _goComposite.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 63, Column: 33}, []interface{}{_t, _elements})
+		}, FixedArgs: 2}
+		exports.Set("composite", _composite)
+		_keyValue = &mml.Function{F: func(a []interface{}) interface{} {
+			var _k = a[0]
+			var _v = a[1]
+//line goast.mml:64:33
+			return _goKeyValue.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 64, Column: 33}, []interface{}{_k, _v})
+		}, FixedArgs: 2}
+		exports.Set("keyValue", _keyValue)
+		_index = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+			var _i = a[1]
+//line goast.mml:65:33
+			return _goIndex.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 65, Column: 33}, []interface{}{_x, _i})
+		}, FixedArgs: 2}
+		exports.Set("index", _index)
+		_sliceFrom = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+			var _from = a[1]
+//line goast.mml:66:33
+			return _goSliceFrom.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 66, Column: 33}, []interface{}{_x, _from})
+		}, FixedArgs: 2}
+		exports.Set("sliceFrom", _sliceFrom)
+		_field = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _t = a[1]
+//line goast.mml:67:33
+			return _goField.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 67, Column: 33}, []interface{}{_name, _t})
+		}, FixedArgs: 2}
+		exports.Set("field", _field)
+		_funcLit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _params = a[0]
+			var _results = a[1]
+			var _body = a[2]
+//line goast.mml:68:33
+			return _goFuncLit.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 68, Column: 33}, []interface{}{_params, _results, _body})
+		}, FixedArgs: 3}
+		exports.Set("funcLit", _funcLit)
+//line goast.mml:72:1
+		_assign = &mml.Function{F: func(a []interface{}) interface{} {
+			var _left = a[0]
+			var _right = a[1]
+//line goast.mml:73:36
+			return _goAssign.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 73, Column: 36}, []interface{}{_left, _right})
+		}, FixedArgs: 2}
+		exports.Set("assign", _assign)
+		_define = &mml.Function{F: func(a []interface{}) interface{} {
+			var _left = a[0]
+			var _right = a[1]
+//line goast.mml:74:36
+			return _goDefine.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 74, Column: 36}, []interface{}{_left, _right})
+		}, FixedArgs: 2}
+		exports.Set("define", _define)
+		_inc = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+//line goast.mml:75:36
+			return _goInc.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 75, Column: 36}, []interface{}{_x})
+		}, FixedArgs: 1}
+		exports.Set("inc", _inc)
+		_declare = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _t = a[1]
+//line goast.mml:76:36
+			return _goDeclare.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 76, Column: 36}, []interface{}{_name, _t})
+		}, FixedArgs: 2}
+		exports.Set("declare", _declare)
+		_declareValue = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _v = a[1]
+//line goast.mml:77:36
+			return _goDeclareValue.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 77, Column: 36}, []interface{}{_name, _v})
+		}, FixedArgs: 2}
+		exports.Set("declareValue", _declareValue)
+		_declareTyped = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _t = a[1]
+			var _v = a[2]
+//line goast.mml:78:36
+			return _goDeclareTyped.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 78, Column: 36}, []interface{}{_name, _t, _v})
+		}, FixedArgs: 3}
+		exports.Set("declareTyped", _declareTyped)
+		_returnStmt = &mml.Function{F: func(a []interface{}) interface{} {
+			var _values = a[0]
+//line goast.mml:79:36
+			return _goReturn.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 79, Column: 36}, []interface{}{_values})
+		}, FixedArgs: 1}
+		exports.Set("returnStmt", _returnStmt)
+		_ifStmt = &mml.Function{F: func(a []interface{}) interface{} {
+			var _condition = a[0]
+			var _body = a[1]
+//line goast.mml:80:36
+			return _goIf.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 80, Column: 36}, []interface{}{_condition, _body})
+		}, FixedArgs: 2}
+		exports.Set("ifStmt", _ifStmt)
+		_ifElse = &mml.Function{F: func(a []interface{}) interface{} {
+			var _condition = a[0]
+			var _body = a[1]
+			var _other = a[2]
+//line goast.mml:81:36
+			return _goIfElse.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 81, Column: 36}, []interface{}{_condition, _body, _other})
+		}, FixedArgs: 3}
+		exports.Set("ifElse", _ifElse)
+		_forStmt = &mml.Function{F: func(a []interface{}) interface{} {
+			var _init = a[0]
+			var _condition = a[1]
+			var _post = a[2]
+			var _b = a[3]
+//line goast.mml:82:36
+			return _goFor.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 82, Column: 36}, []interface{}{_init, _condition, _post, _b})
+		}, FixedArgs: 4}
+		exports.Set("forStmt", _forStmt)
+		_caseClause = &mml.Function{F: func(a []interface{}) interface{} {
+			var _values = a[0]
+			var _body = a[1]
+//line goast.mml:83:36
+			return _goCase.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 83, Column: 36}, []interface{}{_values, _body})
+		}, FixedArgs: 2}
+		exports.Set("caseClause", _caseClause)
+		_switchStmt = &mml.Function{F: func(a []interface{}) interface{} {
+			var _tag = a[0]
+			var _cases = a[1]
+//line goast.mml:84:36
+			return _goSwitch.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 84, Column: 36}, []interface{}{_tag, _cases})
+		}, FixedArgs: 2}
+		exports.Set("switchStmt", _switchStmt)
+		_commClause = &mml.Function{F: func(a []interface{}) interface{} {
+			var _communication = a[0]
+			var _body = a[1]
+//line goast.mml:85:36
+			return _goCommCase.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 85, Column: 36}, []interface{}{_communication, _body})
+		}, FixedArgs: 2}
+		exports.Set("commClause", _commClause)
+		_selectStmt = &mml.Function{F: func(a []interface{}) interface{} {
+			var _cases = a[0]
+//line goast.mml:86:36
+			return _goSelect.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 86, Column: 36}, []interface{}{_cases})
+		}, FixedArgs: 1}
+		exports.Set("selectStmt", _selectStmt)
+		_sendStmt = &mml.Function{F: func(a []interface{}) interface{} {
+			var _channel = a[0]
+			var _value = a[1]
+//line goast.mml:87:36
+			return _goSend.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 87, Column: 36}, []interface{}{_channel, _value})
+		}, FixedArgs: 2}
+		exports.Set("sendStmt", _sendStmt)
+		_goStmt = &mml.Function{F: func(a []interface{}) interface{} {
+			var _application = a[0]
+//line goast.mml:88:36
+			return _goGo.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 88, Column: 36}, []interface{}{_application})
+		}, FixedArgs: 1}
+		exports.Set("goStmt", _goStmt)
+		_deferStmt = &mml.Function{F: func(a []interface{}) interface{} {
+			var _application = a[0]
+//line goast.mml:89:36
+			return _goDefer.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 89, Column: 36}, []interface{}{_application})
+		}, FixedArgs: 1}
+		exports.Set("deferStmt", _deferStmt)
+		_branch = &mml.Function{F: func(a []interface{}) interface{} {
+			var _control = a[0]
+//line goast.mml:90:36
+			return _goBranch.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 90, Column: 36}, []interface{}{_control, ""})
+		}, FixedArgs: 1}
+		exports.Set("branch", _branch)
+		_branchTo = &mml.Function{F: func(a []interface{}) interface{} {
+			var _control = a[0]
+			var _label = a[1]
+//line goast.mml:91:36
+			return _goBranch.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 91, Column: 36}, []interface{}{_control, _label})
+		}, FixedArgs: 2}
+		exports.Set("branchTo", _branchTo)
+		_labeled = &mml.Function{F: func(a []interface{}) interface{} {
+			var _label = a[0]
+			var _s = a[1]
+//line goast.mml:92:36
+			return _goLabeled.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 92, Column: 36}, []interface{}{_label, _s})
+		}, FixedArgs: 2}
+		exports.Set("labeled", _labeled)
+		_line = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+			var _number = a[1]
+			var _column = a[2]
+//line goast.mml:93:36
+			return _goLine.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 93, Column: 36}, []interface{}{_path, _number, _column})
+		}, FixedArgs: 3}
+		exports.Set("line", _line)
+		_lineReset = &mml.Function{F: func(a []interface{}) interface{} {
+//line goast.mml:94:36
+			return _goLine.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 94, Column: 36}, []interface{}{"", 0, 0})
+		}, FixedArgs: 0}
+		exports.Set("lineReset", _lineReset)
+//line goast.mml:98:1
+		_importSpec = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _path = a[1]
+//line goast.mml:99:29
+			return _goImport.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 99, Column: 29}, []interface{}{_name, _path})
+		}, FixedArgs: 2}
+		exports.Set("importSpec", _importSpec)
+		_funcDecl = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _body = a[1]
+//line goast.mml:100:29
+			return _goFuncDecl.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 100, Column: 29}, []interface{}{_name, _body})
+		}, FixedArgs: 2}
+		exports.Set("funcDecl", _funcDecl)
+		_file = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _imports = a[1]
+			var _decls = a[2]
+//line goast.mml:101:29
+			return _goFile.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 101, Column: 29}, []interface{}{_name, _imports, _decls})
+		}, FixedArgs: 3}
+		exports.Set("file", _file)
+		_render = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+			var _name = a[1]
+//line goast.mml:102:29
+			return _goRender.(*mml.Function).CallAt(mml.Pos{Path: "goast.mml", Line: 102, Column: 29}, []interface{}{_f, _name})
+		}, FixedArgs: 2}
+		exports.Set("render", _render)
+//line main.go:4193
 		return exports
 	})
-modulePath = "compile.mml"
-	mml.Modules.Set(modulePath, func() map[string]interface{} {
-		exports := make(map[string]interface{})
-
-		var c interface{}
-		mml.Nop(c)
-var _notEmpty interface{};
-var _compileInt interface{};
-var _compileFloat interface{};
-var _compileBool interface{};
-var _getScope interface{};
-var _comment interface{};
-var _compileString interface{};
-var _symbol interface{};
-var _cond interface{};
-var _spreadList interface{};
-var _compileCase interface{};
-var _compileSend interface{};
-var _compileReceive interface{};
-var _compileGo interface{};
-var _definitions interface{};
-var _assigns interface{};
-var _ret interface{};
-var _control interface{};
-var _useList interface{};
-var _list interface{};
-var _entry interface{};
-var _expressionKey interface{};
-var _struct interface{};
-var _paramList interface{};
-var _function interface{};
-var _indexer interface{};
-var _application interface{};
-var _unary interface{};
-var _binary interface{};
-var _ternary interface{};
-var _compileIf interface{};
-var _compileSwitch interface{};
-var _compileSelect interface{};
-var _compileDefer interface{};
-var _rangeOver interface{};
-var _loop interface{};
-var _definition interface{};
-var _assign interface{};
-var _statements interface{};
-var _compileUse interface{};
-var _do interface{};
-var _errors interface{};
-var _code interface{};
-var _strings interface{};
-var _fold interface{};
-var _foldr interface{};
-var _map interface{};
-var _filter interface{};
-var _contains interface{};
-var _sort interface{};
-var _flat interface{};
-var _uniq interface{};
-var _join interface{};
-var _joins interface{};
-var _formats interface{};
-var _enum interface{};
-var _log interface{};
-var _onlyErr interface{};
-var _passErr interface{};
-mml.Nop(_notEmpty, _compileInt, _compileFloat, _compileBool, _getScope, _comment, _compileString, _symbol, _cond, _spreadList, _compileCase, _compileSend, _compileReceive, _compileGo, _definitions, _assigns, _ret, _control, _useList, _list, _entry, _expressionKey, _struct, _paramList, _function, _indexer, _application, _unary, _binary, _ternary, _compileIf, _compileSwitch, _compileSelect, _compileDefer, _rangeOver, _loop, _definition, _assign, _statements, _compileUse, _do, _errors, _code, _strings, _fold, _foldr, _map, _filter, _contains, _sort, _flat, _uniq, _join, _joins, _formats, _enum, _log, _onlyErr, _passErr);
-var __lang = mml.Modules.Use("lang.mml");;_fold = __lang.Values["fold"];
-_foldr = __lang.Values["foldr"];
-_map = __lang.Values["map"];
-_filter = __lang.Values["filter"];
-_contains = __lang.Values["contains"];
-_sort = __lang.Values["sort"];
-_flat = __lang.Values["flat"];
-_uniq = __lang.Values["uniq"];
-_join = __lang.Values["join"];
-_joins = __lang.Values["joins"];
-_formats = __lang.Values["formats"];
-_enum = __lang.Values["enum"];
-_log = __lang.Values["log"];
-_onlyErr = __lang.Values["onlyErr"];
-_passErr = __lang.Values["passErr"];
-_errors = mml.Modules.Use("errors.mml");
-_code = mml.Modules.Use("code.mml");
-_strings = mml.Modules.Use("strings.mml");
-_notEmpty = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0];
-				;
-				mml.Nop(_l);
-				return _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return mml.BinaryOp(12, _s, "")
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _l)}).Values)
-			},
-			FixedArgs: 1,
-		};
-_compileInt = _string;
-_compileFloat = _string;
-_compileBool = _string;
-_getScope = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _statements = &mml.List{a[0:]};
-				;
-				mml.Nop(_statements);
-				var _defs interface{};
-var _uses interface{};
-var _inlineUses interface{};
-var _namedUses interface{};
-var _unnamedUses interface{};
-mml.Nop(_defs, _uses, _inlineUses, _namedUses, _unnamedUses);
-_defs = mml.Ref(_code, "flattenedStatements").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "definition", "definition-list", "definitions", _statements)}).Values);
-_uses = mml.Ref(_code, "flattenedStatements").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "use", "use-list", "uses", _statements)}).Values);
-_inlineUses = _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return mml.Ref(_u, "exportNames")
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "exportNames")}).Values))}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return mml.BinaryOp(11, mml.Ref(_u, "capture"), ".")
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _uses)}).Values))}).Values))}).Values))}).Values);
-_namedUses = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return (mml.BinaryOp(12, mml.Ref(_u, "capture"), ".").(bool) && mml.BinaryOp(12, mml.Ref(_u, "capture"), "").(bool))
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _uses)}).Values);
-_unnamedUses = _filter.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return mml.BinaryOp(11, mml.Ref(_u, "capture"), "")
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _uses)}).Values);
-return _flat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0];
-				;
-				mml.Nop(_d);
-				return mml.Ref(_d, "symbol")
-			},
-			FixedArgs: 1,
-		}, _defs)}).Values), _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return mml.Ref(_u, "capture")
-			},
-			FixedArgs: 1,
-		}, _namedUses)}).Values), _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return mml.Ref(_u, "path")
-			},
-			FixedArgs: 1,
-		}, _unnamedUses)}).Values), _inlineUses)})}).Values);
+}
+func init() {
+	mml.Modules.Set("compile.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _variable interface{}
+		var _mmlCall interface{}
+		var _method interface{}
+		var _asChannel interface{}
+		var _loaderUse interface{}
+		var _pos interface{}
+		var _lineDirective interface{}
+		var _typed interface{}
+		var _boxed interface{}
+		var _assert interface{}
+		var _compileStatement interface{}
+		var _isBoolOp interface{}
+		var _boolValue interface{}
+		var _comment interface{}
+		var _symbol interface{}
+		var _cond interface{}
+		var _spreadList interface{}
+		var _compileCase interface{}
+		var _compileSend interface{}
+		var _compileReceive interface{}
+		var _compileGo interface{}
+		var _compileDefer interface{}
+		var _definitions interface{}
+		var _assigns interface{}
+		var _useList interface{}
+		var _ret interface{}
+		var _control interface{}
+		var _isSpread interface{}
+		var _list interface{}
+		var _argList interface{}
+		var _entryKey interface{}
+		var _entry interface{}
+		var _expressionKey interface{}
+		var _struct interface{}
+		var _paramList interface{}
+		var _tailLabel string
+		var _withPos interface{}
+		var _isSelfCall interface{}
+		var _tailReturn interface{}
+		var _tailStatement interface{}
+		var _tailCall interface{}
+		var _function interface{}
+		var _indexer interface{}
+		var _application interface{}
+		var _interop interface{}
+		var _unaryOperators interface{}
+		var _binaryOperators interface{}
+		var _unary interface{}
+		var _binary interface{}
+		var _ternary interface{}
+		var _compileIf interface{}
+		var _switchTag interface{}
+		var _compileSwitch interface{}
+		var _compileSelectCase interface{}
+		var _compileSelect interface{}
+		var _rangeOver interface{}
+		var _iterates interface{}
+		var _loopVariables interface{}
+		var _loop interface{}
+		var _value interface{}
+		var _definitionValue interface{}
+		var _definition interface{}
+		var _assign interface{}
+		var _statements interface{}
+		var _bindReferenced interface{}
+		var _compileUse interface{}
+		var _do interface{}
+		var _code interface{}
+		var _goast interface{}
+		var _types interface{}
+		var _fold interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var __lang = loader.Use("lang.mml")
+		_fold = mml.Ref(mml.Pos{Path: "compile.mml", Line: 2, Column: 2}, __lang, "fold")
+		_map = mml.Ref(mml.Pos{Path: "compile.mml", Line: 2, Column: 2}, __lang, "map")
+		_filter = mml.Ref(mml.Pos{Path: "compile.mml", Line: 2, Column: 2}, __lang, "filter")
+		_contains = mml.Ref(mml.Pos{Path: "compile.mml", Line: 2, Column: 2}, __lang, "contains")
+		_code = loader.Use("code.mml")
+		_goast = loader.Use("goast.mml")
+		_types = loader.Use("types.mml")
+//line compile.mml:8:1
+		_variable = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+//line compile.mml:9:22
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 9, Column: 22}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 9, Column: 22}, []interface{}{mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 9, Column: 34}, 9, "_", _name)})
+		}, FixedArgs: 1}
+		_mmlCall = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _args = a[1]
+//line compile.mml:10:22
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 10, Column: 22}, _goast, "call").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 10, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 10, Column: 33}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 10, Column: 33}, []interface{}{"mml", _name}), _args})
+		}, FixedArgs: 2}
+		_method = &mml.Function{F: func(a []interface{}) interface{} {
+			var _x = a[0]
+			var _name = a[1]
+			var _a = a[2]
+//line compile.mml:11:22
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 11, Column: 22}, _goast, "call").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 11, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 11, Column: 33}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 11, Column: 33}, []interface{}{_x, _name}), _a})
+		}, FixedArgs: 3}
+		_asChannel = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:12:22
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 12, Column: 22}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 12, Column: 22}, []interface{}{_assert.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 12, Column: 37}, []interface{}{_c, "*mml.Channel"}), "C"})
+		}, FixedArgs: 1}
+		_loaderUse = &mml.Function{F: func(a []interface{}) interface{} {
+			var _u = a[0]
+//line compile.mml:13:22
+			return _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 13, Column: 22}, []interface{}{"loader", "Use", mml.Ref(mml.Pos{Path: "compile.mml", Line: 13, Column: 46}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 13, Column: 46}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 13, Column: 62}, _u, "modulePath")})})
+		}, FixedArgs: 1}
+//line compile.mml:16:1
+		_pos = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:16:11
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 16, Column: 11}, []interface{}{"pos", _c}).(bool) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 17, Column: 2}, _goast, "composite").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 17, Column: 2}, []interface{}{"mml.Pos", mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 18, Column: 3}, _goast, "keyValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 18, Column: 3}, []interface{}{"Path", mml.Ref(mml.Pos{Path: "compile.mml", Line: 18, Column: 26}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 18, Column: 26}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 18, Column: 42}, mml.Ref(mml.Pos{}, _c, "pos"), "path")})}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 19, Column: 3}, _goast, "keyValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 19, Column: 3}, []interface{}{"Line", mml.Ref(mml.Pos{Path: "compile.mml", Line: 19, Column: 26}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 19, Column: 26}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 19, Column: 39}, mml.Ref(mml.Pos{}, _c, "pos"), "line")})}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 20, Column: 3}, _goast, "keyValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 20, Column: 3}, []interface{}{"Column", mml.Ref(mml.Pos{Path: "compile.mml", Line: 20, Column: 28}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 20, Column: 28}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 20, Column: 41}, mml.Ref(mml.Pos{}, _c, "pos"), "column")})}))})
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 22, Column: 2}, _goast, "composite").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 22, Column: 2}, []interface{}{"mml.Pos", mml.NewList()})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:25:1
+		_lineDirective = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+			var _code = a[1]
+//line compile.mml:25:27
+			return func() interface{} {
+				if (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 25, Column: 27}, []interface{}{"pos", _c}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 25, Column: 44}, 12, _code, mml.NewList()).(bool)) {
+					return mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 26, Column: 3}, _goast, "line").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 26, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 26, Column: 14}, mml.Ref(mml.Pos{}, _c, "pos"), "path"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 26, Column: 26}, mml.Ref(mml.Pos{}, _c, "pos"), "line"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 26, Column: 38}, mml.Ref(mml.Pos{}, _c, "pos"), "column")}), _code)
+				}
+				return _code
+			}()
+		}, FixedArgs: 2}
+//line compile.mml:30:1
+		_typed = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+			var _t = a[1]
+//line compile.mml:30:16
+			return func() interface{} {
+				if mml.Ref(mml.Pos{Path: "compile.mml", Line: 30, Column: 16}, _types, "isNative").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 30, Column: 16}, []interface{}{_c}).(bool) {
+					return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 30, Column: 36}, []interface{}{_c})
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 30, Column: 44}, _goast, "typeAssert").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 30, Column: 44}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 30, Column: 61}, []interface{}{_c}), _t})
+			}()
+		}, FixedArgs: 2}
+//line compile.mml:33:1
+		_boxed = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:33:13
+			return func() interface{} {
+				if mml.Ref(mml.Pos{Path: "compile.mml", Line: 33, Column: 13}, _types, "isNative").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 33, Column: 13}, []interface{}{_c}).(bool) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 34, Column: 2}, _goast, "index").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 34, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 34, Column: 14}, _goast, "composite").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 34, Column: 14}, []interface{}{"[]interface{}", mml.NewList(_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 34, Column: 48}, []interface{}{_c}))}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 34, Column: 57}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 34, Column: 57}, []interface{}{0})})
+				}
+				return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 35, Column: 2}, []interface{}{_c})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:37:1
+		_assert = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+			var _t = a[1]
+//line compile.mml:37:17
+			return func() interface{} {
+				if (mml.Ref(mml.Pos{Path: "compile.mml", Line: 37, Column: 17}, _types, "isNative").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 37, Column: 17}, []interface{}{_c}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 37, Column: 38}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 37, Column: 38}, _types, "goType").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 37, Column: 38}, []interface{}{_c}), _t).(bool)) {
+					return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 37, Column: 61}, []interface{}{_c})
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 37, Column: 69}, _goast, "typeAssert").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 37, Column: 69}, []interface{}{_boxed.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 37, Column: 86}, []interface{}{_c}), _t})
+			}()
+		}, FixedArgs: 2}
+//line compile.mml:39:1
+		_compileStatement = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+//line compile.mml:39:24
+			return _lineDirective.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 39, Column: 24}, []interface{}{_s, _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 39, Column: 41}, []interface{}{_s})})
+		}, FixedArgs: 1}
+//line compile.mml:41:1
+		_isBoolOp = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:42:2
+			return (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 42, Column: 2}, []interface{}{"type", _c}).(bool) && ((mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 43, Column: 3}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 43, Column: 3}, _c, "type"), "unary").(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 43, Column: 24}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 43, Column: 24}, _c, "op"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 43, Column: 32}, _code, "logicalNot")).(bool)) || (mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 44, Column: 3}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 44, Column: 3}, _c, "type"), "binary").(bool) && (mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 44, Column: 26}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 44, Column: 26}, _c, "op"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 44, Column: 34}, _code, "logicalAnd")).(bool) || mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 44, Column: 53}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 44, Column: 53}, _c, "op"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 44, Column: 61}, _code, "logicalOr")).(bool)))))
+		}, FixedArgs: 1}
+//line compile.mml:48:1
+		_boolValue = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:48:17
+			return func() interface{} {
+				if _isBoolOp.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 48, Column: 17}, []interface{}{_c}).(bool) {
+					return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 48, Column: 31}, []interface{}{_c})
+				}
+				return _assert.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 48, Column: 39}, []interface{}{_c, "bool"})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:50:1
+		_comment = &mml.Function{F: func(a []interface{}) interface{} {
+//line compile.mml:51:20
+			return mml.NewList()
+		}, FixedArgs: 1}
+		_symbol = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+//line compile.mml:52:20
+			return _variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 52, Column: 20}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 52, Column: 29}, _s, "name")})
+		}, FixedArgs: 1}
+		_cond = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:53:20
+			return func() interface{} {
+				if mml.Ref(mml.Pos{Path: "compile.mml", Line: 53, Column: 20}, _c, "ternary").(bool) {
+					return _ternary.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 53, Column: 32}, []interface{}{_c})
+				}
+				return _compileIf.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 53, Column: 45}, []interface{}{_c})
+			}()
+		}, FixedArgs: 1}
+		_spreadList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+//line compile.mml:54:20
+			return _assert.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 54, Column: 20}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 54, Column: 27}, _s, "value"), "*mml.List"})
+		}, FixedArgs: 1}
+		_compileCase = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:55:20
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 55, Column: 20}, _goast, "caseClause").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 55, Column: 20}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 55, Column: 37}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 55, Column: 40}, _c, "expression")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 55, Column: 55}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 55, Column: 58}, _c, "body")})})
+		}, FixedArgs: 1}
+		_compileSend = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+//line compile.mml:56:20
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 56, Column: 20}, _goast, "sendStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 56, Column: 20}, []interface{}{_asChannel.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 56, Column: 35}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 56, Column: 45}, _s, "channel")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 56, Column: 57}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 56, Column: 60}, _s, "value")})})
+		}, FixedArgs: 1}
+		_compileReceive = &mml.Function{F: func(a []interface{}) interface{} {
+			var _r = a[0]
+//line compile.mml:57:20
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 57, Column: 20}, _goast, "unary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 57, Column: 20}, []interface{}{"<-", _asChannel.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 57, Column: 38}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 57, Column: 48}, _r, "channel")})})
+		}, FixedArgs: 1}
+		_compileGo = &mml.Function{F: func(a []interface{}) interface{} {
+			var _g = a[0]
+//line compile.mml:58:20
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 58, Column: 20}, _goast, "goStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 58, Column: 20}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 58, Column: 33}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 58, Column: 36}, _g, "application")})})
+		}, FixedArgs: 1}
+		_compileDefer = &mml.Function{F: func(a []interface{}) interface{} {
+			var _d = a[0]
+//line compile.mml:59:20
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 59, Column: 20}, _goast, "deferStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 59, Column: 20}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 59, Column: 36}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 59, Column: 39}, _d, "application")})})
+		}, FixedArgs: 1}
+		_definitions = &mml.Function{F: func(a []interface{}) interface{} {
+			var _l = a[0]
+//line compile.mml:60:20
+			return _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 60, Column: 20}, []interface{}{_do, mml.Ref(mml.Pos{Path: "compile.mml", Line: 60, Column: 28}, _l, "definitions")})
+		}, FixedArgs: 1}
+		_assigns = &mml.Function{F: func(a []interface{}) interface{} {
+			var _l = a[0]
+//line compile.mml:61:20
+			return _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 61, Column: 20}, []interface{}{_do, mml.Ref(mml.Pos{Path: "compile.mml", Line: 61, Column: 28}, _l, "assignments")})
+		}, FixedArgs: 1}
+		_useList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _u = a[0]
+//line compile.mml:62:20
+			return _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 62, Column: 20}, []interface{}{_do, mml.Ref(mml.Pos{Path: "compile.mml", Line: 62, Column: 28}, _u, "uses")})
+		}, FixedArgs: 1}
+		_ret = &mml.Function{F: func(a []interface{}) interface{} {
+			var _r = a[0]
+//line compile.mml:63:20
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 63, Column: 20}, _goast, "returnStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 63, Column: 20}, []interface{}{func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 63, Column: 37}, []interface{}{"value", _r}).(bool) {
+					return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 63, Column: 55}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 63, Column: 58}, _r, "value")})
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 63, Column: 69}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 63, Column: 69}, []interface{}{"nil"})
+			}()})
+		}, FixedArgs: 1}
+		_control = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:64:20
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 64, Column: 20}, _goast, "branch").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 64, Column: 20}, []interface{}{func() interface{} {
+				if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 64, Column: 33}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 64, Column: 33}, _c, "control"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 64, Column: 46}, _code, "breakControl")).(bool) {
+					return "break"
+				}
+				return "continue"
+			}()})
+		}, FixedArgs: 1}
+//line compile.mml:67:1
+		_isSpread = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:67:16
+			return (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 67, Column: 16}, []interface{}{"type", _c}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 67, Column: 34}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 67, Column: 34}, _c, "type"), "spread").(bool))
+		}, FixedArgs: 1}
+//line compile.mml:69:1
+		_list = &mml.Function{F: func(a []interface{}) interface{} {
+			var _l = a[0]
+			var _selectSpread interface{}
+			var _groupSpread interface{}
+			var _appendSpread interface{}
+			var _appendSpreads interface{}
+			var _appendGroups interface{}
+			var _appendSimples interface{}
+			var _appendGroup interface{}
+//line compile.mml:70:2
+			_selectSpread = &mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+//line compile.mml:70:21
+				return func() interface{} {
+					if _isSpread.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 70, Column: 21}, []interface{}{_c}).(bool) {
+						return mml.NewStruct(nil).With("spread", _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 70, Column: 44}, []interface{}{_c}))
+					}
+					return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 70, Column: 53}, []interface{}{_c})
+				}()
+			}, FixedArgs: 1}
+//line compile.mml:72:2
+			_groupSpread = _fold.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 72, Column: 18}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _item = a[0]
+				var _groups = a[1]
+				var _i int
+				var _isSpread bool
+				var _groupIsSpread bool
+				var _appendNewSimple interface{}
+				var _appendNewSpread interface{}
+				var _appendSimple interface{}
+				var _appendSpread interface{}
+//line compile.mml:73:3
+				_i = (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 74, Column: 18}, []interface{}{_groups}).(int) - 1)
+				_isSpread = _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 75, Column: 18}, []interface{}{"spread", _item}).(bool)
+				_groupIsSpread = ((_i >= 0) && _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 76, Column: 28}, []interface{}{"spread", mml.Ref(mml.Pos{Path: "compile.mml", Line: 76, Column: 42}, _groups, _i)}).(bool))
+//line compile.mml:79:3
+				_appendNewSimple = &mml.Function{F: func(a []interface{}) interface{} {
+//line compile.mml:80:22
+					return mml.NewList().Concat(_groups.(*mml.List)).Append(mml.NewStruct(nil).With("simple", mml.NewList(_item)))
+				}, FixedArgs: 0}
+				_appendNewSpread = &mml.Function{F: func(a []interface{}) interface{} {
+//line compile.mml:81:22
+					return mml.NewList().Concat(_groups.(*mml.List)).Append(mml.NewStruct(nil).With("spread", mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 81, Column: 44}, _item, "spread"))))
+				}, FixedArgs: 0}
+				_appendSimple = &mml.Function{F: func(a []interface{}) interface{} {
+//line compile.mml:82:22
+					return mml.NewList().Concat(mml.RefRange(mml.Pos{Path: "compile.mml", Line: 82, Column: 23}, _groups, nil, _i).(*mml.List)).Append(mml.NewStruct(nil).With("simple", mml.NewList().Concat(mml.Ref(mml.Pos{Path: "compile.mml", Line: 82, Column: 48}, mml.Ref(mml.Pos{}, _groups, _i), "simple").(*mml.List)).Append(_item)))
+				}, FixedArgs: 0}
+				_appendSpread = &mml.Function{F: func(a []interface{}) interface{} {
+//line compile.mml:83:22
+					return mml.NewList().Concat(mml.RefRange(mml.Pos{Path: "compile.mml", Line: 83, Column: 23}, _groups, nil, _i).(*mml.List)).Append(mml.NewStruct(nil).With("spread", mml.NewList().Concat(mml.Ref(mml.Pos{Path: "compile.mml", Line: 83, Column: 48}, mml.Ref(mml.Pos{}, _groups, _i), "spread").(*mml.List)).Append(mml.Ref(mml.Pos{Path: "compile.mml", Line: 83, Column: 69}, _item, "spread"))))
+				}, FixedArgs: 0}
+//line compile.mml:86:3
+				switch {
+				case (((_i < 0) || _groupIsSpread) && !_isSpread):
+//line compile.mml:88:4
+					return _appendNewSimple.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 88, Column: 11}, []interface{}{})
+				case (((_i < 0) || !_groupIsSpread) && _isSpread):
+//line compile.mml:90:4
+					return _appendNewSpread.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 90, Column: 11}, []interface{}{})
+				case (!_groupIsSpread && !_isSpread):
+//line compile.mml:92:4
+					return _appendSimple.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 92, Column: 11}, []interface{}{})
+				case (_groupIsSpread && _isSpread):
+//line compile.mml:94:4
+					return _appendSpread.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 94, Column: 11}, []interface{}{})
+				}
 				return nil
-			},
-			FixedArgs: 0,
-		};
-_comment = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var __ = a[0];
-				;
-				mml.Nop(__);
+			}, FixedArgs: 2}, mml.NewList()})
+//line compile.mml:99:2
+			_appendSpread = &mml.Function{F: func(a []interface{}) interface{} {
+				var _item = a[0]
+				var _code = a[1]
+//line compile.mml:100:30
+				return _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 100, Column: 30}, []interface{}{_code, "Concat", _item})
+			}, FixedArgs: 2}
+			_appendSpreads = &mml.Function{F: func(a []interface{}) interface{} {
+				var _code = a[0]
+				var _group = a[1]
+//line compile.mml:101:30
+				return _fold.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 101, Column: 30}, []interface{}{_appendSpread, _code, _group})
+			}, FixedArgs: 2}
+			_appendGroups = &mml.Function{F: func(a []interface{}) interface{} {
+				var _groups = a[0]
+//line compile.mml:102:30
+				return func() interface{} {
+					if (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 102, Column: 30}, []interface{}{_groups}).(int) == 0) {
+						return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 102, Column: 49}, []interface{}{"NewList", mml.NewList()})
+					}
+					return _fold.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 102, Column: 74}, []interface{}{_appendGroup, mml.NewList(), _groups})
+				}()
+			}, FixedArgs: 1}
+//line compile.mml:105:2
+			_appendSimples = &mml.Function{F: func(a []interface{}) interface{} {
+				var _code = a[0]
+				var _group = a[1]
+//line compile.mml:105:32
+				return func() interface{} {
+					if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 105, Column: 32}, 11, _code, mml.NewList()).(bool) {
+						return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 106, Column: 3}, []interface{}{"NewList", _group})
+					}
+					return _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 107, Column: 3}, []interface{}{_code, "Append", _group})
+				}()
+			}, FixedArgs: 2}
+//line compile.mml:109:2
+			_appendGroup = &mml.Function{F: func(a []interface{}) interface{} {
+				var _group = a[0]
+				var _code = a[1]
+//line compile.mml:109:30
+				return func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 109, Column: 30}, []interface{}{"spread", _group}).(bool) {
+						return _appendSpreads.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 110, Column: 3}, []interface{}{func() interface{} {
+							if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 110, Column: 17}, 11, _code, mml.NewList()).(bool) {
+								return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 110, Column: 30}, []interface{}{"NewList", mml.NewList()})
+							}
+							return _code
+						}(), mml.Ref(mml.Pos{Path: "compile.mml", Line: 110, Column: 61}, _group, "spread")})
+					}
+					return _appendSimples.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 111, Column: 3}, []interface{}{_code, mml.Ref(mml.Pos{Path: "compile.mml", Line: 111, Column: 23}, _group, "simple")})
+				}()
+			}, FixedArgs: 2}
+//line compile.mml:113:2
+			return _appendGroups.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 113, Column: 9}, []interface{}{_groupSpread.(*mml.Function).CallAt(mml.Pos{}, []interface{}{_map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 114, Column: 5}, []interface{}{_selectSpread}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 113, Column: 9}, _l, "values")})})})
+		}, FixedArgs: 1}
+//line compile.mml:119:1
+		_argList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _a = a[0]
+//line compile.mml:119:15
+			return func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 119, Column: 15}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 119, Column: 19}, []interface{}{_isSpread, _a})}).(int) > 0) {
+					return _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 120, Column: 2}, []interface{}{_list.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 120, Column: 9}, []interface{}{mml.NewStruct(nil).With("values", _a)}), "Items", mml.NewList()})
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 121, Column: 2}, _goast, "composite").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 121, Column: 2}, []interface{}{"[]interface{}", _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 121, Column: 35}, []interface{}{_do, _a})})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:123:1
+		_entryKey = &mml.Function{F: func(a []interface{}) interface{} {
+			var _k = a[0]
+//line compile.mml:124:2
+			return func() interface{} {
+				if _isString.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 124, Column: 2}, []interface{}{_k}).(bool) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 124, Column: 16}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 124, Column: 16}, []interface{}{_k})
+				}
+				return func() interface{} {
+					if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 125, Column: 2}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 125, Column: 2}, _k, "type"), "symbol").(bool) {
+						return mml.Ref(mml.Pos{Path: "compile.mml", Line: 125, Column: 23}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 125, Column: 23}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 125, Column: 39}, _k, "name")})
+					}
+					return _assert.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 126, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 126, Column: 9}, _k, "value"), "string"})
+				}()
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:128:1
+		_entry = &mml.Function{F: func(a []interface{}) interface{} {
+			var _e = a[0]
+//line compile.mml:128:13
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 128, Column: 13}, _goast, "keyValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 128, Column: 13}, []interface{}{_entryKey.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 128, Column: 28}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 128, Column: 37}, _e, "key")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 128, Column: 45}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 128, Column: 48}, _e, "value")})})
+		}, FixedArgs: 1}
+//line compile.mml:130:1
+		_expressionKey = &mml.Function{F: func(a []interface{}) interface{} {
+			var _k = a[0]
+//line compile.mml:130:21
+			return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 130, Column: 21}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 130, Column: 24}, _k, "value")})
+		}, FixedArgs: 1}
+//line compile.mml:132:1
+		_struct = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+			var _entry interface{}
+//line compile.mml:133:2
+			_entry = &mml.Function{F: func(a []interface{}) interface{} {
+				var _e = a[0]
+				var _code = a[1]
+//line compile.mml:133:20
+				return func() interface{} {
+					if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 133, Column: 20}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 133, Column: 20}, _e, "type"), "spread").(bool) {
+						return _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 134, Column: 3}, []interface{}{_code, "Merge", _assert.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 134, Column: 25}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 134, Column: 32}, _e, "value"), "*mml.Struct"})})
+					}
+					return _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 135, Column: 3}, []interface{}{_code, "With", mml.NewList(_entryKey.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 135, Column: 25}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 135, Column: 34}, _e, "key")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 135, Column: 42}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 135, Column: 45}, _e, "value")}))})
+				}()
+			}, FixedArgs: 2}
+//line compile.mml:137:2
+			return _fold.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 137, Column: 9}, []interface{}{_entry, _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 137, Column: 21}, []interface{}{"NewStruct", mml.Ref(mml.Pos{Path: "compile.mml", Line: 137, Column: 42}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 137, Column: 42}, []interface{}{"nil"})}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 137, Column: 63}, _s, "entries")})
+		}, FixedArgs: 1}
+//line compile.mml:140:1
+		_paramList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _params = a[0]
+			var _collectParam = a[1]
+			var _p interface{}
+//line compile.mml:141:2
+			_p = mml.NewList()
+//line compile.mml:142:2
+			for __iter := mml.Iterate(mml.Pos{Path: "compile.mml", Line: 142, Column: 6}, _params); __iter.Next(); {
+				_i, _name := __iter.Key(), __iter.Value()
+//line compile.mml:143:3
+				_p = mml.NewList().Concat(_p.(*mml.List)).Append(mml.Ref(mml.Pos{Path: "compile.mml", Line: 143, Column: 14}, _goast, "declareValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 143, Column: 14}, []interface{}{mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 143, Column: 33}, 9, "_", _name), mml.Ref(mml.Pos{Path: "compile.mml", Line: 143, Column: 45}, _goast, "index").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 143, Column: 45}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 143, Column: 57}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 143, Column: 57}, []interface{}{"a"}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 143, Column: 75}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 143, Column: 75}, []interface{}{_i})})}))
+			}
+//line compile.mml:146:2
+			if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 146, Column: 5}, 12, _collectParam, "").(bool) {
+				var _rest interface{}
+//line compile.mml:147:3
+				_rest = mml.Ref(mml.Pos{Path: "compile.mml", Line: 147, Column: 12}, _goast, "sliceFrom").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 147, Column: 12}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 147, Column: 28}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 147, Column: 28}, []interface{}{"a"}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 147, Column: 46}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 147, Column: 46}, []interface{}{_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 147, Column: 59}, []interface{}{_params})})})
+//line compile.mml:148:3
+				_p = mml.NewList().Concat(_p.(*mml.List)).Append(mml.Ref(mml.Pos{Path: "compile.mml", Line: 148, Column: 14}, _goast, "declareValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 148, Column: 14}, []interface{}{mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 148, Column: 33}, 9, "_", _collectParam), mml.Ref(mml.Pos{Path: "compile.mml", Line: 148, Column: 53}, _goast, "callSpread").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 148, Column: 53}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 148, Column: 70}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 148, Column: 70}, []interface{}{"mml", "NewList"}), _rest})}))
+			}
+//line compile.mml:151:2
+			return _p
+		}, FixedArgs: 2}
+//line compile.mml:158:1
+		_tailLabel = "__tail"
+//line compile.mml:160:1
+		_withPos = &mml.Function{F: func(a []interface{}) interface{} {
+			var _from = a[0]
+			var _c = a[1]
+//line compile.mml:160:21
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 160, Column: 21}, []interface{}{"pos", _from}).(bool) {
+					return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("pos", mml.Ref(mml.Pos{Path: "compile.mml", Line: 160, Column: 52}, _from, "pos"))
+				}
+				return _c
+			}()
+		}, FixedArgs: 2}
+//line compile.mml:162:1
+		_isSelfCall = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _arity = a[1]
+			var _c = a[2]
+//line compile.mml:163:2
+			return ((((((_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 163, Column: 2}, []interface{}{"type", _c}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 164, Column: 2}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 164, Column: 2}, _c, "type"), "function-application").(bool)) && _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 165, Column: 2}, []interface{}{"type", mml.Ref(mml.Pos{Path: "compile.mml", Line: 165, Column: 14}, _c, "function")}).(bool)) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 166, Column: 2}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 166, Column: 2}, mml.Ref(mml.Pos{}, _c, "function"), "type"), "symbol").(bool)) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 167, Column: 2}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 167, Column: 2}, mml.Ref(mml.Pos{}, _c, "function"), "name"), _name).(bool)) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 168, Column: 2}, 16, _len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 168, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 168, Column: 6}, _c, "args")}), _arity).(bool)) && (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 169, Column: 2}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 169, Column: 6}, []interface{}{_isSpread, mml.Ref(mml.Pos{Path: "compile.mml", Line: 169, Column: 23}, _c, "args")})}).(int) == 0))
+		}, FixedArgs: 3}
+//line compile.mml:172:1
+		_tailReturn = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _arity = a[1]
+			var _c = a[2]
+//line compile.mml:173:2
+			switch {
+			case _isSelfCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 174, Column: 7}, []interface{}{_name, _arity, _c}):
+//line compile.mml:175:3
+				return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("type", "tail-call")
+			case ((_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 176, Column: 7}, []interface{}{"type", _c}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 176, Column: 25}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 176, Column: 25}, _c, "type"), "cond").(bool)) && mml.Ref(mml.Pos{Path: "compile.mml", Line: 176, Column: 45}, _c, "ternary").(bool)):
+//line compile.mml:177:3
+				return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("ternary", false).With("consequent", mml.NewStruct(nil).With("type", "statement-list").With("statements", mml.NewList(_tailReturn.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 180, Column: 55}, []interface{}{_name, _arity, mml.Ref(mml.Pos{Path: "compile.mml", Line: 180, Column: 79}, _c, "consequent")})))).With("alternative", mml.NewStruct(nil).With("type", "statement-list").With("statements", mml.NewList(_tailReturn.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 181, Column: 55}, []interface{}{_name, _arity, mml.Ref(mml.Pos{Path: "compile.mml", Line: 181, Column: 79}, _c, "alternative")}))))
+			default:
+//line compile.mml:184:3
+				return _withPos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 184, Column: 10}, []interface{}{_c, mml.NewStruct(nil).With("type", "ret").With("value", _c)})
+			}
+			return nil
+		}, FixedArgs: 3}
+//line compile.mml:189:1
+		_tailStatement = &mml.Function{F: func(a []interface{}) interface{} {
+			var _name = a[0]
+			var _arity = a[1]
+			var _s = a[2]
+			var _defines interface{}
+			var _rangesOver interface{}
+			var _tail interface{}
+//line compile.mml:190:2
+			_defines = &mml.Function{F: func(a []interface{}) interface{} {
+				var _d = a[0]
+//line compile.mml:190:16
+				return ((_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 190, Column: 16}, []interface{}{"type", _d}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 190, Column: 34}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 190, Column: 34}, _d, "type"), "definition").(bool)) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 190, Column: 60}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 190, Column: 60}, _d, "symbol"), _name).(bool))
+			}, FixedArgs: 1}
+//line compile.mml:191:2
+			_rangesOver = &mml.Function{F: func(a []interface{}) interface{} {
+				var _e = a[0]
+//line compile.mml:191:19
+				return ((_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 191, Column: 19}, []interface{}{"type", _e}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 191, Column: 37}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 191, Column: 37}, _e, "type"), "range-over").(bool)) && ((_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 192, Column: 3}, []interface{}{"key", _e}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 192, Column: 20}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 192, Column: 20}, _e, "key"), _name).(bool)) || (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 193, Column: 3}, []interface{}{"symbol", _e}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 193, Column: 23}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 193, Column: 23}, _e, "symbol"), _name).(bool))))
+			}, FixedArgs: 1}
+//line compile.mml:196:2
+			_tail = _tailStatement.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 196, Column: 11}, []interface{}{_name, _arity})
+//line compile.mml:197:2
+			if !_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 197, Column: 6}, []interface{}{"type", _s}).(bool) {
+//line compile.mml:198:3
+				return _s
+			}
+//line compile.mml:201:2
+			switch mml.Ref(mml.Pos{Path: "compile.mml", Line: 201, Column: 9}, _s, "type") {
+			case "ret":
+//line compile.mml:203:3
+				return func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 203, Column: 10}, []interface{}{"value", _s}).(bool) {
+						return _withPos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 203, Column: 28}, []interface{}{_s, _tailReturn.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 203, Column: 39}, []interface{}{_name, _arity, mml.Ref(mml.Pos{Path: "compile.mml", Line: 203, Column: 63}, _s, "value")})})
+					}
+					return _s
+				}()
+			case "cond":
+				var _consequent interface{}
+//line compile.mml:205:3
+				_consequent = mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("consequent", _tail.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 205, Column: 37}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 205, Column: 42}, _s, "consequent")}))
+//line compile.mml:206:3
+				return func() interface{} {
+					if mml.Ref(mml.Pos{Path: "compile.mml", Line: 206, Column: 10}, _s, "ternary").(bool) {
+						return _s
+					}
+					return func() interface{} {
+						if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 206, Column: 26}, []interface{}{"alternative", _s}).(bool) {
+							return mml.NewStruct(nil).Merge(_consequent.(*mml.Struct)).With("alternative", _tail.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 206, Column: 79}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 206, Column: 84}, _s, "alternative")}))
+						}
+						return _consequent
+					}()
+				}()
+			case "switch-statement":
+//line compile.mml:208:3
+				return mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("cases", _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 208, Column: 24}, []interface{}{_tail, mml.Ref(mml.Pos{Path: "compile.mml", Line: 208, Column: 34}, _s, "cases")})).With("defaultStatements", _tail.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 208, Column: 63}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 208, Column: 68}, _s, "defaultStatements")}))
+			case "select":
+				var _cases interface{}
+//line compile.mml:210:3
+				_cases = mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("cases", _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 210, Column: 27}, []interface{}{_tail, mml.Ref(mml.Pos{Path: "compile.mml", Line: 210, Column: 37}, _s, "cases")}))
+//line compile.mml:211:3
+				return func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 211, Column: 10}, []interface{}{"defaultStatements", _s}).(bool) {
+						return mml.NewStruct(nil).Merge(_cases.(*mml.Struct)).With("defaultStatements", _tail.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 211, Column: 70}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 211, Column: 75}, _s, "defaultStatements")}))
+					}
+					return _cases
+				}()
+			case "switch-case":
+//line compile.mml:213:3
+				return mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("body", _tail.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 213, Column: 23}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 213, Column: 28}, _s, "body")}))
+			case "select-case":
+//line compile.mml:215:3
+				return func() interface{} {
+					if _defines.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 215, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 215, Column: 18}, _s, "expression")}).(bool) {
+						return _s
+					}
+					return mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("body", _tail.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 215, Column: 51}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 215, Column: 56}, _s, "body")}))
+				}()
+			case "loop":
+//line compile.mml:217:3
+				return func() interface{} {
+					if (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 217, Column: 10}, []interface{}{"expression", _s}).(bool) && _rangesOver.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 217, Column: 34}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 217, Column: 45}, _s, "expression")}).(bool)) {
+						return _s
+					}
+					return mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("body", _tail.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 217, Column: 78}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 217, Column: 83}, _s, "body")}))
+				}()
+			case "statement-list":
+//line compile.mml:219:3
+				return func() interface{} {
+					if _contains.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 219, Column: 10}, []interface{}{_name, mml.Ref(mml.Pos{Path: "compile.mml", Line: 219, Column: 25}, _code, "getScope").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 219, Column: 25}, mml.NewList().Concat(mml.Ref(mml.Pos{Path: "compile.mml", Line: 219, Column: 39}, _s, "statements").(*mml.List)).Items())}).(bool) {
+						return _s
+					}
+					return mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("statements", _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 219, Column: 82}, []interface{}{_tail, mml.Ref(mml.Pos{Path: "compile.mml", Line: 219, Column: 92}, _s, "statements")}))
+				}()
+			default:
+//line compile.mml:221:3
+				return _s
+			}
+			return nil
+		}, FixedArgs: 3}
+//line compile.mml:225:1
+		_tailCall = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:225:16
+			return mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 226, Column: 2}, _goast, "assign").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 226, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 226, Column: 15}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 226, Column: 15}, []interface{}{"a"}), _argList.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 226, Column: 33}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 226, Column: 41}, _c, "args")})}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 227, Column: 2}, _goast, "branchTo").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 227, Column: 2}, []interface{}{"continue", _tailLabel}))
+		}, FixedArgs: 1}
+//line compile.mml:231:1
+		_function = &mml.Function{F: func(a []interface{}) interface{} {
+			var _f = a[0]
+			var _name = a[1]
+			var _isStatementList bool
+			var _shadowed bool
+			var _tail interface{}
+			var _loops bool
+			var _statement interface{}
+			var _statements interface{}
+			var _last interface{}
+			var _returns bool
+			var _body interface{}
+			var _params interface{}
+			var _results interface{}
+			var _block interface{}
+//line compile.mml:232:2
+			_isStatementList = (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 233, Column: 19}, []interface{}{"type", mml.Ref(mml.Pos{Path: "compile.mml", Line: 233, Column: 31}, _f, "statement")}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 233, Column: 47}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 233, Column: 47}, mml.Ref(mml.Pos{}, _f, "statement"), "type"), "statement-list").(bool))
+			_shadowed = ((mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 234, Column: 19}, 11, _name, "").(bool) || _contains.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 234, Column: 33}, []interface{}{_name, mml.Ref(mml.Pos{Path: "compile.mml", Line: 234, Column: 48}, _f, "params")}).(bool)) || mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 234, Column: 61}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 234, Column: 61}, _f, "collectParam"), _name).(bool))
+			_tail = func() interface{} {
+				if _isStatementList {
+					return _tailStatement.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 236, Column: 4}, []interface{}{_name, _len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 236, Column: 24}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 236, Column: 28}, _f, "params")}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 236, Column: 39}, _f, "statement")})
+				}
+				return mml.NewStruct(nil).With("type", "statement-list").With("statements", mml.NewList(_tailReturn.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 237, Column: 42}, []interface{}{_name, _len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 237, Column: 59}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 237, Column: 63}, _f, "params")}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 237, Column: 74}, _f, "statement")})))
+			}()
+			_loops = (!_shadowed && (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 238, Column: 32}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 238, Column: 36}, _code, "findCode").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 238, Column: 36}, []interface{}{"tail-call", _tail})}).(int) > 0))
+			_statement = func() interface{} {
+				if _loops {
+					return _tail
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 239, Column: 34}, _f, "statement")
+			}()
+			_statements = func() interface{} {
+				if _isStatementList || _loops {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 240, Column: 46}, _statement, "statements")
+				}
+				return mml.NewList()
+			}()
+			_last = func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 241, Column: 19}, []interface{}{_statements}).(int) > 0) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 241, Column: 41}, _statements, (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 241, Column: 52}, []interface{}{_statements}).(int) - 1))
+				}
+				return mml.NewStruct(nil)
+			}()
+			_returns = (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 242, Column: 19}, []interface{}{"type", _last}).(bool) && (mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 242, Column: 41}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 242, Column: 41}, _last, "type"), "ret").(bool) || mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 242, Column: 63}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 242, Column: 63}, _last, "type"), "tail-call").(bool)))
+//line compile.mml:246:2
+			_body = func() interface{} {
+				if _isStatementList || _loops {
+					return mml.NewList(_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 247, Column: 4}, []interface{}{_statement}), func() interface{} {
+						if _returns || !_isStatementList {
+							return mml.NewList()
+						}
+						return mml.Ref(mml.Pos{Path: "compile.mml", Line: 247, Column: 54}, _goast, "returnStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 247, Column: 54}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 247, Column: 71}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 247, Column: 71}, []interface{}{"nil"})})
+					}())
+				}
+				return _lineDirective.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 248, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 248, Column: 17}, _f, "statement"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 248, Column: 30}, _goast, "returnStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 248, Column: 30}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 248, Column: 47}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 248, Column: 50}, _f, "statement")})})})
+			}()
+//line compile.mml:250:2
+			_params = mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 251, Column: 12}, _goast, "field").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 251, Column: 12}, []interface{}{"a", "[]interface{}"}))
+			_results = mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 252, Column: 12}, _goast, "field").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 252, Column: 12}, []interface{}{"", "interface{}"}))
+			_block = mml.NewList(_paramList.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 253, Column: 12}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 253, Column: 22}, _f, "params"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 253, Column: 32}, _f, "collectParam")}), _body)
+//line compile.mml:256:2
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 256, Column: 9}, _goast, "unary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 256, Column: 9}, []interface{}{"&", mml.Ref(mml.Pos{Path: "compile.mml", Line: 256, Column: 26}, _goast, "composite").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 256, Column: 26}, []interface{}{"mml.Function", mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 257, Column: 3}, _goast, "keyValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 257, Column: 3}, []interface{}{"F", mml.Ref(mml.Pos{Path: "compile.mml", Line: 257, Column: 23}, _goast, "funcLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 257, Column: 23}, []interface{}{_params, _results, func() interface{} {
+				if _loops {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 260, Column: 12}, _goast, "labeled").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 260, Column: 12}, []interface{}{_tailLabel, mml.Ref(mml.Pos{Path: "compile.mml", Line: 260, Column: 37}, _goast, "forStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 260, Column: 37}, []interface{}{mml.NewList(), mml.NewList(), mml.NewList(), _block})})
+				}
+				return _block
+			}()})}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 262, Column: 3}, _goast, "keyValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 262, Column: 3}, []interface{}{"FixedArgs", mml.Ref(mml.Pos{Path: "compile.mml", Line: 262, Column: 31}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 262, Column: 31}, []interface{}{_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 262, Column: 44}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 262, Column: 48}, _f, "params")})})}))})})
+		}, FixedArgs: 2}
+//line compile.mml:266:1
+		_indexer = &mml.Function{F: func(a []interface{}) interface{} {
+			var _i = a[0]
+//line compile.mml:267:2
+			return func() interface{} {
+				if (!_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 267, Column: 3}, []interface{}{"type", mml.Ref(mml.Pos{Path: "compile.mml", Line: 267, Column: 15}, _i, "index")}).(bool) || mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 267, Column: 27}, 12, mml.Ref(mml.Pos{Path: "compile.mml", Line: 267, Column: 27}, mml.Ref(mml.Pos{}, _i, "index"), "type"), "range-expression").(bool)) {
+					return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 268, Column: 2}, []interface{}{"Ref", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 268, Column: 18}, []interface{}{_i}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 268, Column: 26}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 268, Column: 29}, _i, "expression")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 268, Column: 44}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 268, Column: 47}, _i, "index")}))})
+				}
+				return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 269, Column: 2}, []interface{}{"RefRange", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 270, Column: 3}, []interface{}{_i}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 271, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 271, Column: 6}, _i, "expression")}), func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 272, Column: 3}, []interface{}{"from", mml.Ref(mml.Pos{Path: "compile.mml", Line: 272, Column: 15}, _i, "index")}).(bool) {
+						return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 272, Column: 26}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 272, Column: 29}, mml.Ref(mml.Pos{}, _i, "index"), "from")})
+					}
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 272, Column: 45}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 272, Column: 45}, []interface{}{"nil"})
+				}(), func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 273, Column: 3}, []interface{}{"to", mml.Ref(mml.Pos{Path: "compile.mml", Line: 273, Column: 13}, _i, "index")}).(bool) {
+						return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 273, Column: 24}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 273, Column: 27}, mml.Ref(mml.Pos{}, _i, "index"), "to")})
+					}
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 273, Column: 41}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 273, Column: 41}, []interface{}{"nil"})
+				}())})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:277:1
+		_application = &mml.Function{F: func(a []interface{}) interface{} {
+			var _a = a[0]
+//line compile.mml:277:19
+			return _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 277, Column: 19}, []interface{}{func() interface{} {
+				if (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 278, Column: 2}, []interface{}{"type", mml.Ref(mml.Pos{Path: "compile.mml", Line: 278, Column: 14}, _a, "function")}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 278, Column: 29}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 278, Column: 29}, mml.Ref(mml.Pos{}, _a, "function"), "type"), "function").(bool)) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 279, Column: 3}, _goast, "paren").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 279, Column: 3}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 279, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 279, Column: 18}, _a, "function")})})
+				}
+				return _assert.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 280, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 280, Column: 10}, _a, "function"), "*mml.Function"})
+			}(), "CallAt", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 282, Column: 3}, []interface{}{_a}), _argList.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 282, Column: 11}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 282, Column: 19}, _a, "args")}))})
+		}, FixedArgs: 1}
+//line compile.mml:285:1
+		_interop = &mml.Function{F: func(a []interface{}) interface{} {
+			var _i = a[0]
+//line compile.mml:285:15
+			return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 285, Column: 15}, []interface{}{"Interop", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 286, Column: 2}, []interface{}{_i}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 287, Column: 2}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 287, Column: 2}, []interface{}{mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 287, Column: 18}, 9, mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 287, Column: 18}, 9, mml.Ref(mml.Pos{Path: "compile.mml", Line: 287, Column: 18}, mml.Ref(mml.Pos{}, _i, "args"), 0), "."), mml.Ref(mml.Pos{Path: "compile.mml", Line: 287, Column: 36}, mml.Ref(mml.Pos{}, _i, "args"), 1))}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 288, Column: 2}, _goast, "selector").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 288, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 288, Column: 17}, _code, "interopAlias").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 288, Column: 17}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 288, Column: 35}, mml.Ref(mml.Pos{}, _i, "args"), 0)}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 288, Column: 47}, mml.Ref(mml.Pos{}, _i, "args"), 1)}))})
+		}, FixedArgs: 1}
+//line compile.mml:292:1
+		_unaryOperators = mml.NewList("^", "+", "-", "!")
+		_binaryOperators = mml.NewList("&", "|", "^", "&^", "<<", ">>", "*", "/", "%", "+", "-", "==", "!=", "<", "<=", ">", ">=")
+//line compile.mml:297:1
+		_unary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _u = a[0]
+//line compile.mml:298:2
+			return func() interface{} {
+				if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 298, Column: 2}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 298, Column: 2}, _u, "op"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 298, Column: 10}, _code, "logicalNot")).(bool) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 298, Column: 28}, _goast, "unary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 298, Column: 28}, []interface{}{"!", _boolValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 298, Column: 45}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 298, Column: 55}, _u, "arg")})})
+				}
+				return func() interface{} {
+					if mml.Ref(mml.Pos{Path: "compile.mml", Line: 299, Column: 2}, _types, "isNative").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 299, Column: 2}, []interface{}{_u}).(bool) {
+						return mml.Ref(mml.Pos{Path: "compile.mml", Line: 299, Column: 22}, _goast, "unary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 299, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 299, Column: 34}, _unaryOperators, mml.Ref(mml.Pos{Path: "compile.mml", Line: 299, Column: 48}, _u, "op")), _typed.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 299, Column: 56}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 299, Column: 62}, _u, "arg"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 299, Column: 69}, _u, "goType")})})
+					}
+					return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 300, Column: 2}, []interface{}{"UnaryOp", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 300, Column: 22}, []interface{}{_u}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 300, Column: 30}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 300, Column: 30}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 300, Column: 43}, _u, "op")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 300, Column: 50}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 300, Column: 53}, _u, "arg")}))})
+				}()
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:303:1
+		_binary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _b = a[0]
+//line compile.mml:304:2
+			switch {
+			case (mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 305, Column: 7}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 305, Column: 7}, _b, "op"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 305, Column: 15}, _code, "logicalAnd")).(bool) || mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 305, Column: 34}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 305, Column: 34}, _b, "op"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 305, Column: 42}, _code, "logicalOr")).(bool)):
+//line compile.mml:306:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 306, Column: 10}, _goast, "paren").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 306, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 306, Column: 22}, _goast, "binary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 306, Column: 22}, []interface{}{func() interface{} {
+					if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 307, Column: 4}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 307, Column: 4}, _b, "op"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 307, Column: 12}, _code, "logicalAnd")).(bool) {
+						return "&&"
+					}
+					return "||"
+				}(), _boolValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 308, Column: 4}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 308, Column: 14}, _b, "left")}), _boolValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 309, Column: 4}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 309, Column: 14}, _b, "right")})})})
+			case mml.Ref(mml.Pos{Path: "compile.mml", Line: 311, Column: 7}, _types, "isNative").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 311, Column: 7}, []interface{}{_b}):
+				var _t interface{}
+//line compile.mml:312:3
+				_t = mml.Ref(mml.Pos{Path: "compile.mml", Line: 312, Column: 9}, _types, "goType").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 312, Column: 9}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 312, Column: 22}, _b, "left")})
+//line compile.mml:313:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 313, Column: 10}, _goast, "paren").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 313, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 313, Column: 22}, _goast, "binary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 313, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 313, Column: 35}, _binaryOperators, mml.Ref(mml.Pos{Path: "compile.mml", Line: 313, Column: 50}, _b, "op")), _typed.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 313, Column: 58}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 313, Column: 64}, _b, "left"), _t}), _typed.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 313, Column: 76}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 313, Column: 82}, _b, "right"), _t})})})
+			default:
+//line compile.mml:315:3
+				return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 315, Column: 10}, []interface{}{"BinaryOp", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 315, Column: 31}, []interface{}{_b}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 315, Column: 39}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 315, Column: 39}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 315, Column: 52}, _b, "op")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 315, Column: 59}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 315, Column: 62}, _b, "left")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 315, Column: 71}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 315, Column: 74}, _b, "right")}))})
+			}
+			return nil
+		}, FixedArgs: 1}
+//line compile.mml:319:1
+		_ternary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+			var _f interface{}
+//line compile.mml:320:2
+			_f = mml.Ref(mml.Pos{Path: "compile.mml", Line: 320, Column: 8}, _goast, "funcLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 320, Column: 8}, []interface{}{mml.NewList(), mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 320, Column: 27}, _goast, "field").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 320, Column: 27}, []interface{}{"", "interface{}"})), mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 321, Column: 3}, _goast, "ifStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 321, Column: 3}, []interface{}{_boolValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 321, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 321, Column: 26}, _c, "condition")}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 321, Column: 40}, _goast, "returnStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 321, Column: 40}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 321, Column: 57}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 321, Column: 60}, _c, "consequent")})})}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 322, Column: 3}, _goast, "returnStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 322, Column: 3}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 322, Column: 20}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 322, Column: 23}, _c, "alternative")})}))})
+//line compile.mml:325:2
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 325, Column: 9}, _goast, "call").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 325, Column: 9}, []interface{}{_f, mml.NewList()})
+		}, FixedArgs: 1}
+//line compile.mml:328:1
+		_compileIf = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:328:17
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 328, Column: 17}, []interface{}{"alternative", _c}).(bool) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 329, Column: 2}, _goast, "ifElse").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 329, Column: 2}, []interface{}{_boolValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 329, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 329, Column: 25}, _c, "condition")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 329, Column: 39}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 329, Column: 42}, _c, "consequent")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 329, Column: 57}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 329, Column: 60}, _c, "alternative")})})
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 330, Column: 2}, _goast, "ifStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 330, Column: 2}, []interface{}{_boolValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 330, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 330, Column: 25}, _c, "condition")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 330, Column: 39}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 330, Column: 42}, _c, "consequent")})})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:333:1
+		_switchTag = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+			var _t interface{}
+			var _incompatible int
+//line compile.mml:334:2
+			_t = mml.Ref(mml.Pos{Path: "compile.mml", Line: 334, Column: 8}, _types, "goType").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 334, Column: 8}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 334, Column: 21}, _s, "expression")})
+//line compile.mml:335:2
+			_incompatible = _len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 335, Column: 19}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 335, Column: 30}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _c = a[0]
+//line compile.mml:335:44
+				return (mml.Ref(mml.Pos{Path: "compile.mml", Line: 335, Column: 44}, _types, "isNative").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 335, Column: 44}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 335, Column: 59}, _c, "expression")}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 335, Column: 76}, 12, mml.Ref(mml.Pos{Path: "compile.mml", Line: 335, Column: 76}, _types, "goType").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 335, Column: 76}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 335, Column: 89}, _c, "expression")}), _t).(bool))
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 335, Column: 19}, _s, "cases")})}).(int)
+//line compile.mml:336:2
+			return func() interface{} {
+				if (mml.Ref(mml.Pos{Path: "compile.mml", Line: 336, Column: 9}, _types, "isNative").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 336, Column: 9}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 336, Column: 24}, _s, "expression")}).(bool) && (_incompatible > 0)) {
+					return _boxed.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 336, Column: 60}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 336, Column: 66}, _s, "expression")})
+				}
+				return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 336, Column: 82}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 336, Column: 85}, _s, "expression")})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:339:1
+		_compileSwitch = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+			var _cases interface{}
+//line compile.mml:340:2
+			_cases = mml.NewList(_map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 341, Column: 3}, []interface{}{_do, mml.Ref(mml.Pos{Path: "compile.mml", Line: 341, Column: 11}, _s, "cases")}), func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 342, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 342, Column: 7}, mml.Ref(mml.Pos{}, _s, "defaultStatements"), "statements")}).(int) > 0) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 342, Column: 45}, _goast, "caseClause").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 342, Column: 45}, []interface{}{mml.NewList(), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 342, Column: 66}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 342, Column: 69}, _s, "defaultStatements")})})
+				}
+				return mml.NewList()
+			}())
+//line compile.mml:345:2
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 345, Column: 9}, _goast, "switchStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 345, Column: 9}, []interface{}{func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 345, Column: 26}, []interface{}{"expression", _s}).(bool) {
+					return _switchTag.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 345, Column: 49}, []interface{}{_s})
+				}
+				return mml.NewList()
+			}(), _cases})
+		}, FixedArgs: 1}
+//line compile.mml:348:1
+		_compileSelectCase = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line compile.mml:348:25
+			return func() interface{} {
+				if (!_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 348, Column: 26}, []interface{}{"type", mml.Ref(mml.Pos{Path: "compile.mml", Line: 348, Column: 38}, _c, "expression")}).(bool) || mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 348, Column: 55}, 12, mml.Ref(mml.Pos{Path: "compile.mml", Line: 348, Column: 55}, mml.Ref(mml.Pos{}, _c, "expression"), "type"), "definition").(bool)) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 349, Column: 2}, _goast, "commClause").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 349, Column: 2}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 349, Column: 19}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 349, Column: 22}, _c, "expression")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 349, Column: 37}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 349, Column: 40}, _c, "body")})})
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 350, Column: 2}, _goast, "commClause").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 350, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 351, Column: 3}, _goast, "define").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 351, Column: 3}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 351, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 351, Column: 25}, mml.Ref(mml.Pos{}, _c, "expression"), "symbol")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 351, Column: 47}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 351, Column: 50}, mml.Ref(mml.Pos{}, _c, "expression"), "expression")})}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 352, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 352, Column: 6}, _c, "body")})})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:355:1
+		_compileSelect = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+//line compile.mml:355:21
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 355, Column: 21}, _goast, "selectStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 355, Column: 21}, []interface{}{mml.NewList(_map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 356, Column: 2}, []interface{}{_do, mml.Ref(mml.Pos{Path: "compile.mml", Line: 356, Column: 10}, _s, "cases")}), func() interface{} {
+				if mml.Ref(mml.Pos{Path: "compile.mml", Line: 357, Column: 2}, _s, "hasDefault").(bool) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 357, Column: 17}, _goast, "commClause").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 357, Column: 17}, []interface{}{mml.NewList(), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 357, Column: 38}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 357, Column: 41}, _s, "defaultStatements")})})
+				}
+				return mml.NewList()
+			}())})
+		}, FixedArgs: 1}
+//line compile.mml:361:1
+		_rangeOver = &mml.Function{F: func(a []interface{}) interface{} {
+			var _r = a[0]
+			var _counter interface{}
+			var _withRangeExpression interface{}
+			var _iterate interface{}
+//line compile.mml:362:2
+			_counter = &mml.Function{F: func(a []interface{}) interface{} {
+				var _from = a[0]
+				var _condition = a[1]
+//line compile.mml:362:30
+				return func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 362, Column: 30}, []interface{}{"key", _r}).(bool) {
+						return mml.NewStruct(nil).With("init", mml.Ref(mml.Pos{Path: "compile.mml", Line: 364, Column: 15}, _goast, "define").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 364, Column: 15}, []interface{}{mml.NewList(_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 364, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 364, Column: 38}, _r, "key")}), _variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 364, Column: 46}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 364, Column: 55}, _r, "symbol")})), mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 364, Column: 68}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 364, Column: 68}, []interface{}{0}), _from)})).With("condition", _condition).With("post", mml.Ref(mml.Pos{Path: "compile.mml", Line: 366, Column: 15}, _goast, "assign").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 366, Column: 15}, []interface{}{mml.NewList(_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 366, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 366, Column: 38}, _r, "key")}), _variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 366, Column: 46}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 366, Column: 55}, _r, "symbol")})), mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 367, Column: 5}, _goast, "binary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 367, Column: 5}, []interface{}{"+", _variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 367, Column: 23}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 367, Column: 32}, _r, "key")}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 367, Column: 40}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 367, Column: 40}, []interface{}{1})}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 368, Column: 5}, _goast, "binary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 368, Column: 5}, []interface{}{"+", _variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 368, Column: 23}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 368, Column: 32}, _r, "symbol")}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 368, Column: 43}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 368, Column: 43}, []interface{}{1})}))}))
+					}
+					return mml.NewStruct(nil).With("init", mml.Ref(mml.Pos{Path: "compile.mml", Line: 372, Column: 15}, _goast, "define").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 372, Column: 15}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 372, Column: 28}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 372, Column: 37}, _r, "symbol")}), _from})).With("condition", _condition).With("post", mml.Ref(mml.Pos{Path: "compile.mml", Line: 374, Column: 15}, _goast, "inc").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 374, Column: 15}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 374, Column: 25}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 374, Column: 34}, _r, "symbol")})}))
+				}()
+			}, FixedArgs: 2}
+//line compile.mml:377:2
+			_withRangeExpression = &mml.Function{F: func(a []interface{}) interface{} {
+//line compile.mml:377:27
+				return _counter.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 377, Column: 27}, []interface{}{func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 378, Column: 3}, []interface{}{"from", mml.Ref(mml.Pos{Path: "compile.mml", Line: 378, Column: 15}, _r, "expression")}).(bool) {
+						return _assert.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 378, Column: 31}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 378, Column: 38}, mml.Ref(mml.Pos{}, _r, "expression"), "from"), "int"})
+					}
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 378, Column: 66}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 378, Column: 66}, []interface{}{0})
+				}(), func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 379, Column: 3}, []interface{}{"to", mml.Ref(mml.Pos{Path: "compile.mml", Line: 379, Column: 13}, _r, "expression")}).(bool) {
+						return mml.Ref(mml.Pos{Path: "compile.mml", Line: 380, Column: 4}, _goast, "binary").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 380, Column: 4}, []interface{}{"<", _variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 380, Column: 22}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 380, Column: 31}, _r, "symbol")}), _assert.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 380, Column: 42}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 380, Column: 49}, mml.Ref(mml.Pos{}, _r, "expression"), "to"), "int"})})
+					}
+					return mml.NewList()
+				}()})
+			}, FixedArgs: 0}
+//line compile.mml:386:2
+			_iterate = &mml.Function{F: func(a []interface{}) interface{} {
+//line compile.mml:386:15
+				return mml.NewStruct(nil).With("init", mml.Ref(mml.Pos{Path: "compile.mml", Line: 387, Column: 14}, _goast, "define").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 387, Column: 14}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 387, Column: 27}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 387, Column: 27}, []interface{}{"__iter"}), _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 387, Column: 50}, []interface{}{"Iterate", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 387, Column: 70}, []interface{}{_r}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 387, Column: 78}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 387, Column: 81}, _r, "expression")}))})})).With("condition", _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 388, Column: 14}, []interface{}{"__iter", "Next", mml.NewList()})).With("post", mml.NewList())
+			}, FixedArgs: 0}
+//line compile.mml:392:2
+			switch {
+			case !_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 393, Column: 8}, []interface{}{"expression", _r}).(bool):
+//line compile.mml:394:3
+				return _counter.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 394, Column: 10}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 394, Column: 18}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 394, Column: 18}, []interface{}{0}), mml.NewList()})
+			case (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 395, Column: 7}, []interface{}{"type", mml.Ref(mml.Pos{Path: "compile.mml", Line: 395, Column: 19}, _r, "expression")}).(bool) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 395, Column: 36}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 395, Column: 36}, mml.Ref(mml.Pos{}, _r, "expression"), "type"), "range-expression").(bool)):
+//line compile.mml:396:3
+				return _withRangeExpression.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 396, Column: 10}, []interface{}{})
+			default:
+//line compile.mml:398:3
+				return _iterate.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 398, Column: 10}, []interface{}{})
+			}
+			return nil
+		}, FixedArgs: 1}
+//line compile.mml:402:1
+		_iterates = &mml.Function{F: func(a []interface{}) interface{} {
+			var _e = a[0]
+//line compile.mml:403:2
+			return (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 403, Column: 2}, []interface{}{"expression", _e}).(bool) && (!_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 404, Column: 4}, []interface{}{"type", mml.Ref(mml.Pos{Path: "compile.mml", Line: 404, Column: 16}, _e, "expression")}).(bool) || mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 404, Column: 33}, 12, mml.Ref(mml.Pos{Path: "compile.mml", Line: 404, Column: 33}, mml.Ref(mml.Pos{}, _e, "expression"), "type"), "range-expression").(bool)))
+		}, FixedArgs: 1}
+//line compile.mml:406:1
+		_loopVariables = &mml.Function{F: func(a []interface{}) interface{} {
+			var _r = a[0]
+//line compile.mml:406:21
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 406, Column: 21}, []interface{}{"key", _r}).(bool) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 407, Column: 2}, _goast, "define").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 407, Column: 2}, []interface{}{mml.NewList(_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 407, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 407, Column: 25}, _r, "key")}), _variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 407, Column: 33}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 407, Column: 42}, _r, "symbol")})), mml.NewList(_method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 408, Column: 3}, []interface{}{"__iter", "Key", mml.NewList()}), _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 409, Column: 3}, []interface{}{"__iter", "Value", mml.NewList()}))})
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 411, Column: 2}, _goast, "define").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 411, Column: 2}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 411, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 411, Column: 24}, _r, "symbol")}), _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 411, Column: 35}, []interface{}{"__iter", "Value", mml.NewList()})})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:413:1
+		_loop = &mml.Function{F: func(a []interface{}) interface{} {
+			var _l = a[0]
+			var _e interface{}
+			var _r interface{}
+//line compile.mml:414:2
+			if !_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 414, Column: 6}, []interface{}{"expression", _l}).(bool) {
+//line compile.mml:415:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 415, Column: 10}, _goast, "forStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 415, Column: 10}, []interface{}{mml.NewList(), mml.NewList(), mml.NewList(), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 415, Column: 36}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 415, Column: 39}, _l, "body")})})
+			}
+//line compile.mml:418:2
+			_e = mml.Ref(mml.Pos{Path: "compile.mml", Line: 418, Column: 8}, _l, "expression")
+//line compile.mml:419:2
+			if (!_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 419, Column: 6}, []interface{}{"type", _e}).(bool) || mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 419, Column: 24}, 12, mml.Ref(mml.Pos{Path: "compile.mml", Line: 419, Column: 24}, _e, "type"), "range-over").(bool)) {
+//line compile.mml:420:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 420, Column: 10}, _goast, "forStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 420, Column: 10}, []interface{}{mml.NewList(), _boolValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 420, Column: 28}, []interface{}{_e}), mml.NewList(), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 420, Column: 46}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 420, Column: 49}, _l, "body")})})
+			}
+//line compile.mml:423:2
+			_r = _rangeOver.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 423, Column: 8}, []interface{}{_e})
+//line compile.mml:424:2
+			return mml.Ref(mml.Pos{Path: "compile.mml", Line: 424, Column: 9}, _goast, "forStmt").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 424, Column: 9}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 424, Column: 23}, _r, "init"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 424, Column: 31}, _r, "condition"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 424, Column: 44}, _r, "post"), mml.NewList(func() interface{} {
+				if _iterates.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 424, Column: 53}, []interface{}{_e}).(bool) {
+					return _loopVariables.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 424, Column: 67}, []interface{}{_e})
+				}
+				return mml.NewList()
+			}(), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 424, Column: 90}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 424, Column: 93}, _l, "body")}))})
+		}, FixedArgs: 1}
+//line compile.mml:428:1
+		_value = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+			var _v = a[1]
+//line compile.mml:428:16
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 428, Column: 16}, []interface{}{"goType", _c}).(bool) {
+					return _typed.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 428, Column: 35}, []interface{}{_v, mml.Ref(mml.Pos{Path: "compile.mml", Line: 428, Column: 44}, _c, "goType")})
+				}
+				return _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 428, Column: 56}, []interface{}{_v})
+			}()
+		}, FixedArgs: 2}
+//line compile.mml:430:1
+		_definitionValue = &mml.Function{F: func(a []interface{}) interface{} {
+			var _d = a[0]
+//line compile.mml:430:23
+			return func() interface{} {
+				if ((!mml.Ref(mml.Pos{Path: "compile.mml", Line: 430, Column: 24}, _d, "mutable").(bool) && _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 430, Column: 37}, []interface{}{"type", mml.Ref(mml.Pos{Path: "compile.mml", Line: 430, Column: 49}, _d, "expression")}).(bool)) && mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 430, Column: 66}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 430, Column: 66}, mml.Ref(mml.Pos{}, _d, "expression"), "type"), "function").(bool)) {
+					return _function.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 431, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 431, Column: 11}, _d, "expression"), mml.Ref(mml.Pos{Path: "compile.mml", Line: 431, Column: 25}, _d, "symbol")})
+				}
+				return _value.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 432, Column: 2}, []interface{}{_d, mml.Ref(mml.Pos{Path: "compile.mml", Line: 432, Column: 11}, _d, "expression")})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:434:1
+		_definition = &mml.Function{F: func(a []interface{}) interface{} {
+			var _d = a[0]
+//line compile.mml:434:18
+			return func() interface{} {
+				if mml.Ref(mml.Pos{Path: "compile.mml", Line: 434, Column: 18}, _d, "exported").(bool) {
+					return mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 436, Column: 3}, _goast, "assign").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 436, Column: 3}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 436, Column: 16}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 436, Column: 25}, _d, "symbol")}), _definitionValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 436, Column: 36}, []interface{}{_d})}), _method.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 437, Column: 3}, []interface{}{"exports", "Set", mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 437, Column: 29}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 437, Column: 29}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 437, Column: 45}, _d, "symbol")}), _variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 437, Column: 56}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 437, Column: 65}, _d, "symbol")}))}))
+				}
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 439, Column: 2}, _goast, "assign").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 439, Column: 2}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 439, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 439, Column: 24}, _d, "symbol")}), _definitionValue.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 439, Column: 35}, []interface{}{_d})})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:441:1
+		_assign = &mml.Function{F: func(a []interface{}) interface{} {
+			var _a = a[0]
+//line compile.mml:441:14
+			return func() interface{} {
+				if mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 441, Column: 14}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 441, Column: 14}, mml.Ref(mml.Pos{}, _a, "capture"), "type"), "symbol").(bool) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 442, Column: 2}, _goast, "assign").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 442, Column: 2}, []interface{}{_do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 442, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 442, Column: 18}, _a, "capture")}), _value.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 442, Column: 30}, []interface{}{_a, mml.Ref(mml.Pos{Path: "compile.mml", Line: 442, Column: 39}, _a, "value")})})
+				}
+				return _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 443, Column: 2}, []interface{}{"SetRef", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 443, Column: 21}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 443, Column: 25}, _a, "capture")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 443, Column: 37}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 443, Column: 40}, mml.Ref(mml.Pos{}, _a, "capture"), "expression")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 443, Column: 63}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 443, Column: 66}, mml.Ref(mml.Pos{}, _a, "capture"), "index")}), _do.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 443, Column: 84}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 443, Column: 87}, _a, "value")}))})
+			}()
+		}, FixedArgs: 1}
+//line compile.mml:445:1
+		_statements = &mml.Function{F: func(a []interface{}) interface{} {
+			var _l = a[0]
+			var _declare interface{}
+			var _inlineUses interface{}
+			var _referenced interface{}
+//line compile.mml:446:2
+			_declare = &mml.Function{F: func(a []interface{}) interface{} {
+				var _name = a[0]
+//line compile.mml:446:19
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 446, Column: 19}, _goast, "declare").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 446, Column: 19}, []interface{}{mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 447, Column: 3}, 9, "_", _name), func() interface{} {
+					if (_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 448, Column: 3}, []interface{}{"types", _l}).(bool) && _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 448, Column: 22}, []interface{}{_name, mml.Ref(mml.Pos{Path: "compile.mml", Line: 448, Column: 32}, _l, "types")}).(bool)) {
+						return mml.Ref(mml.Pos{Path: "compile.mml", Line: 448, Column: 43}, mml.Ref(mml.Pos{}, _l, "types"), _name)
+					}
+					return "interface{}"
+				}()})
+			}, FixedArgs: 1}
+//line compile.mml:451:2
+			_inlineUses = _filter.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 452, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line compile.mml:452:19
+				return mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 452, Column: 19}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 452, Column: 19}, _u, "capture"), ".")
+			}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 451, Column: 17}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 451, Column: 17}, _code, "flattenedStatements").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 451, Column: 17}, []interface{}{"use", "use-list", "uses", mml.Ref(mml.Pos{Path: "compile.mml", Line: 451, Column: 69}, _l, "statements")})})
+//line compile.mml:453:2
+			_referenced = func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 453, Column: 17}, []interface{}{_inlineUses}).(int) == 0) {
+					return mml.NewList()
+				}
+				return _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 453, Column: 86}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+					var _s = a[0]
+//line compile.mml:453:97
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 453, Column: 97}, _s, "name")
+				}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 453, Column: 45}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 453, Column: 45}, _code, "findCode").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 453, Column: 45}, []interface{}{"symbol", mml.Ref(mml.Pos{Path: "compile.mml", Line: 453, Column: 69}, _l, "statements")})})
+			}()
+//line compile.mml:454:2
+			return mml.NewList(_map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 455, Column: 3}, []interface{}{_declare, mml.Ref(mml.Pos{Path: "compile.mml", Line: 455, Column: 16}, _code, "getScope").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 455, Column: 16}, mml.NewList().Concat(mml.Ref(mml.Pos{Path: "compile.mml", Line: 455, Column: 30}, _l, "statements").(*mml.List)).Items())}), _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 456, Column: 3}, []interface{}{_compileStatement, func() interface{} {
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 456, Column: 25}, []interface{}{_inlineUses}).(int) == 0) {
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 456, Column: 48}, _l, "statements")
+				}
+				return _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 456, Column: 63}, []interface{}{_bindReferenced.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 456, Column: 67}, []interface{}{_referenced}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 456, Column: 95}, _l, "statements")})
+			}()}))
+		}, FixedArgs: 1}
+//line compile.mml:461:1
+		_bindReferenced = &mml.Function{F: func(a []interface{}) interface{} {
+			var _referenced = a[0]
+			var _s = a[1]
+			var _bind interface{}
+//line compile.mml:462:2
+			_bind = &mml.Function{F: func(a []interface{}) interface{} {
+				var _u = a[0]
+//line compile.mml:462:13
+				return func() interface{} {
+					if (mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 462, Column: 13}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 462, Column: 13}, _u, "capture"), ".").(bool) && _has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 462, Column: 33}, []interface{}{"exportNames", _u}).(bool)) {
+						return mml.NewStruct(nil).Merge(_u.(*mml.Struct)).With("exportNames", _filter.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 463, Column: 23}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+							var _name = a[0]
+//line compile.mml:463:40
+							return _contains.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 463, Column: 40}, []interface{}{_name, _referenced})
+						}, FixedArgs: 1}, mml.Ref(mml.Pos{Path: "compile.mml", Line: 463, Column: 68}, _u, "exportNames")}))
+					}
+					return _u
+				}()
+			}, FixedArgs: 1}
+//line compile.mml:466:2
+			switch {
+			case !_has.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 467, Column: 8}, []interface{}{"type", _s}).(bool):
+//line compile.mml:468:3
+				return _s
+			case mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 469, Column: 7}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 469, Column: 7}, _s, "type"), "use"):
+//line compile.mml:470:3
+				return _bind.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 470, Column: 10}, []interface{}{_s})
+			case mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 471, Column: 7}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 471, Column: 7}, _s, "type"), "use-list"):
+//line compile.mml:472:3
+				return mml.NewStruct(nil).Merge(_s.(*mml.Struct)).With("uses", _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 472, Column: 23}, []interface{}{_bind, mml.Ref(mml.Pos{Path: "compile.mml", Line: 472, Column: 33}, _s, "uses")}))
+			default:
+//line compile.mml:474:3
+				return _s
+			}
+			return nil
+		}, FixedArgs: 2}
+//line compile.mml:478:1
+		_compileUse = &mml.Function{F: func(a []interface{}) interface{} {
+			var _u = a[0]
+//line compile.mml:479:2
+			switch {
+			case mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 480, Column: 7}, 11, mml.Ref(mml.Pos{Path: "compile.mml", Line: 480, Column: 7}, _u, "capture"), "."):
+				var _module interface{}
+//line compile.mml:481:3
+				_module = mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 481, Column: 14}, 9, "__", mml.Ref(mml.Pos{Path: "compile.mml", Line: 481, Column: 21}, _code, "getModuleName").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 481, Column: 21}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 481, Column: 40}, _u, "path")}))
+//line compile.mml:482:3
+				return mml.NewList(mml.Ref(mml.Pos{Path: "compile.mml", Line: 483, Column: 4}, _goast, "declareValue").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 483, Column: 4}, []interface{}{_module, _loaderUse.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 483, Column: 31}, []interface{}{_u})}), _map.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 484, Column: 4}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+					var _name = a[0]
+//line compile.mml:485:15
+					return mml.Ref(mml.Pos{Path: "compile.mml", Line: 485, Column: 15}, _goast, "assign").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 485, Column: 15}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 486, Column: 6}, []interface{}{_name}), _mmlCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 487, Column: 6}, []interface{}{"Ref", mml.NewList(_pos.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 487, Column: 22}, []interface{}{_u}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 487, Column: 30}, _goast, "ident").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 487, Column: 30}, []interface{}{_module}), mml.Ref(mml.Pos{Path: "compile.mml", Line: 487, Column: 51}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 487, Column: 51}, []interface{}{_name}))})})
+				}, FixedArgs: 1}, mml.Ref(mml.Pos{Path: "compile.mml", Line: 489, Column: 5}, _u, "exportNames")}))
+			case mml.BinaryOp(mml.Pos{Path: "compile.mml", Line: 492, Column: 7}, 12, mml.Ref(mml.Pos{Path: "compile.mml", Line: 492, Column: 7}, _u, "capture"), ""):
+//line compile.mml:493:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 493, Column: 10}, _goast, "assign").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 493, Column: 10}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 493, Column: 23}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 493, Column: 32}, _u, "capture")}), _loaderUse.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 493, Column: 44}, []interface{}{_u})})
+			default:
+//line compile.mml:495:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 495, Column: 10}, _goast, "assign").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 495, Column: 10}, []interface{}{_variable.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 495, Column: 23}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 495, Column: 32}, _code, "getModuleName").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 495, Column: 32}, []interface{}{mml.Ref(mml.Pos{Path: "compile.mml", Line: 495, Column: 51}, _u, "path")})}), _loaderUse.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 495, Column: 61}, []interface{}{_u})})
+			}
+			return nil
+		}, FixedArgs: 1}
+//line compile.mml:502:1
+		_do = &mml.Function{F: func(a []interface{}) interface{} {
+			var _code = a[0]
+//line compile.mml:503:2
+			switch {
+			case _isInt.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 504, Column: 7}, []interface{}{_code}):
+//line compile.mml:505:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 505, Column: 10}, _goast, "intLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 505, Column: 10}, []interface{}{_code})
+			case _isFloat.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 506, Column: 7}, []interface{}{_code}):
+//line compile.mml:507:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 507, Column: 10}, _goast, "floatLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 507, Column: 10}, []interface{}{_code})
+			case _isString.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 508, Column: 7}, []interface{}{_code}):
+//line compile.mml:509:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 509, Column: 10}, _goast, "stringLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 509, Column: 10}, []interface{}{_code})
+			case _isBool.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 510, Column: 7}, []interface{}{_code}):
+//line compile.mml:511:3
+				return mml.Ref(mml.Pos{Path: "compile.mml", Line: 511, Column: 10}, _goast, "boolLit").(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 511, Column: 10}, []interface{}{_code})
+			}
+//line compile.mml:514:2
+			switch mml.Ref(mml.Pos{Path: "compile.mml", Line: 514, Column: 9}, _code, "type") {
+			case "comment":
+//line compile.mml:516:3
+				return _comment.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 516, Column: 10}, []interface{}{_code})
+			case "symbol":
+//line compile.mml:518:3
+				return _symbol.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 518, Column: 10}, []interface{}{_code})
+			case "list":
+//line compile.mml:520:3
+				return _list.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 520, Column: 10}, []interface{}{_code})
+			case "expression-key":
+//line compile.mml:522:3
+				return _expressionKey.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 522, Column: 10}, []interface{}{_code})
+			case "entry":
+//line compile.mml:524:3
+				return _entry.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 524, Column: 10}, []interface{}{_code})
+			case "struct":
+//line compile.mml:526:3
+				return _struct.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 526, Column: 10}, []interface{}{_code})
+			case "function":
+//line compile.mml:528:3
+				return _function.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 528, Column: 10}, []interface{}{_code, ""})
+			case "indexer":
+//line compile.mml:530:3
+				return _indexer.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 530, Column: 10}, []interface{}{_code})
+			case "spread":
+//line compile.mml:532:3
+				return _spreadList.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 532, Column: 10}, []interface{}{_code})
+			case "interop":
+//line compile.mml:534:3
+				return _interop.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 534, Column: 10}, []interface{}{_code})
+			case "function-application":
+//line compile.mml:536:3
+				return _application.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 536, Column: 10}, []interface{}{_code})
+			case "unary":
+//line compile.mml:538:3
+				return _unary.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 538, Column: 10}, []interface{}{_code})
+			case "binary":
+//line compile.mml:540:3
+				return _binary.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 540, Column: 10}, []interface{}{_code})
+			case "cond":
+//line compile.mml:542:3
+				return _cond.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 542, Column: 10}, []interface{}{_code})
+			case "switch-case":
+//line compile.mml:544:3
+				return _compileCase.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 544, Column: 10}, []interface{}{_code})
+			case "switch-statement":
+//line compile.mml:546:3
+				return _compileSwitch.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 546, Column: 10}, []interface{}{_code})
+			case "send":
+//line compile.mml:548:3
+				return _compileSend.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 548, Column: 10}, []interface{}{_code})
+			case "receive":
+//line compile.mml:550:3
+				return _compileReceive.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 550, Column: 10}, []interface{}{_code})
+			case "go":
+//line compile.mml:552:3
+				return _compileGo.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 552, Column: 10}, []interface{}{_code})
+			case "defer":
+//line compile.mml:554:3
+				return _compileDefer.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 554, Column: 10}, []interface{}{_code})
+			case "select-case":
+//line compile.mml:556:3
+				return _compileSelectCase.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 556, Column: 10}, []interface{}{_code})
+			case "select":
+//line compile.mml:558:3
+				return _compileSelect.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 558, Column: 10}, []interface{}{_code})
+			case "loop":
+//line compile.mml:560:3
+				return _loop.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 560, Column: 10}, []interface{}{_code})
+			case "definition":
+//line compile.mml:562:3
+				return _definition.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 562, Column: 10}, []interface{}{_code})
+			case "definition-list":
+//line compile.mml:564:3
+				return _definitions.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 564, Column: 10}, []interface{}{_code})
+			case "assign":
+//line compile.mml:566:3
+				return _assign.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 566, Column: 10}, []interface{}{_code})
+			case "assign-list":
+//line compile.mml:568:3
+				return _assigns.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 568, Column: 10}, []interface{}{_code})
+			case "ret":
+//line compile.mml:570:3
+				return _ret.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 570, Column: 10}, []interface{}{_code})
+			case "tail-call":
+//line compile.mml:572:3
+				return _tailCall.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 572, Column: 10}, []interface{}{_code})
+			case "control-statement":
+//line compile.mml:574:3
+				return _control.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 574, Column: 10}, []interface{}{_code})
+			case "use":
+//line compile.mml:576:3
+				return _compileUse.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 576, Column: 10}, []interface{}{_code})
+			case "use-list":
+//line compile.mml:578:3
+				return _useList.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 578, Column: 10}, []interface{}{_code})
+			default:
+//line compile.mml:580:3
+				return _statements.(*mml.Function).CallAt(mml.Pos{Path: "compile.mml", Line: 580, Column: 10}, []interface{}{_code})
+			}
+			return nil
+		}, FixedArgs: 1}
+		exports.Set("do", _do)
+//line main.go:5437
+		return exports
+	})
+}
+func init() {
+	mml.Modules.Set("types.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _primitives interface{}
+		var _builtinTypes interface{}
+		var _guards interface{}
+		var _ordering interface{}
+		var _bitwise interface{}
+		var _literalType interface{}
+		var _isLiteral interface{}
+		var _goType interface{}
+		var _isNative interface{}
+		var _withType interface{}
+		var _unknown interface{}
+		var _narrowed interface{}
+		var _counter interface{}
+		var _typedVariable interface{}
+		var _isTyped interface{}
+		var _isSpread interface{}
+		var _isBuiltinCall interface{}
+		var _narrowing interface{}
+		var _canNarrow interface{}
+		var _narrow interface{}
+		var _symbol interface{}
+		var _unary interface{}
+		var _isZero interface{}
+		var _binaryType interface{}
+		var _binary interface{}
+		var _ternary interface{}
+		var _application interface{}
+		var _shallow interface{}
+		var _inferFields interface{}
+		var _function interface{}
+		var _cond interface{}
+		var _selectCase interface{}
+		var _loop interface{}
+		var _definition interface{}
+		var _assign interface{}
+		var _declare interface{}
+		var _statementList interface{}
+		var _infer interface{}
+		var _annotate interface{}
+		var _code interface{}
+		var _fold interface{}
+		var _map interface{}
+		var _filter interface{}
+		var _contains interface{}
+		var _flat interface{}
+		var __lang = loader.Use("lang.mml")
+		_fold = mml.Ref(mml.Pos{Path: "types.mml", Line: 17, Column: 2}, __lang, "fold")
+		_map = mml.Ref(mml.Pos{Path: "types.mml", Line: 17, Column: 2}, __lang, "map")
+		_filter = mml.Ref(mml.Pos{Path: "types.mml", Line: 17, Column: 2}, __lang, "filter")
+		_contains = mml.Ref(mml.Pos{Path: "types.mml", Line: 17, Column: 2}, __lang, "contains")
+		_flat = mml.Ref(mml.Pos{Path: "types.mml", Line: 17, Column: 2}, __lang, "flat")
+		_code = loader.Use("code.mml")
+//line types.mml:21:1
+		_primitives = mml.NewList("int", "float64", "string", "bool")
+//line types.mml:24:1
+		_builtinTypes = mml.NewStruct(nil).With("len", mml.NewStruct(nil).With("goType", "int").With("args", 1)).With("runeLen", mml.NewStruct(nil).With("goType", "int").With("args", 1)).With("string", mml.NewStruct(nil).With("goType", "string").With("args", 1)).With("format", mml.NewStruct(nil).With("goType", "string").With("args", 2)).With("has", mml.NewStruct(nil).With("goType", "bool").With("args", 2)).With("isError", mml.NewStruct(nil).With("goType", "bool").With("args", 1)).With("isBool", mml.NewStruct(nil).With("goType", "bool").With("args", 1)).With("isInt", mml.NewStruct(nil).With("goType", "bool").With("args", 1)).With("isFloat", mml.NewStruct(nil).With("goType", "bool").With("args", 1)).With("isString", mml.NewStruct(nil).With("goType", "bool").With("args", 1))
+//line types.mml:38:1
+		_guards = mml.NewStruct(nil).With("isBool", "bool").With("isInt", "int").With("isFloat", "float64").With("isString", "string")
+//line types.mml:45:1
+		_ordering = mml.NewList(mml.Ref(mml.Pos{Path: "types.mml", Line: 46, Column: 12}, _code, "less"), mml.Ref(mml.Pos{Path: "types.mml", Line: 46, Column: 23}, _code, "lessOrEq"), mml.Ref(mml.Pos{Path: "types.mml", Line: 46, Column: 38}, _code, "greater"), mml.Ref(mml.Pos{Path: "types.mml", Line: 46, Column: 52}, _code, "greaterOrEq"))
+		_bitwise = mml.NewList(mml.Ref(mml.Pos{Path: "types.mml", Line: 47, Column: 12}, _code, "binaryAnd"), mml.Ref(mml.Pos{Path: "types.mml", Line: 47, Column: 28}, _code, "binaryOr"), mml.Ref(mml.Pos{Path: "types.mml", Line: 47, Column: 43}, _code, "xor"), mml.Ref(mml.Pos{Path: "types.mml", Line: 47, Column: 53}, _code, "andNot"))
+//line types.mml:50:1
+		_literalType = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line types.mml:51:2
+			return func() interface{} {
+				if _isInt.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 51, Column: 2}, []interface{}{_c}).(bool) {
+					return "int"
+				}
+				return func() interface{} {
+					if _isFloat.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 52, Column: 2}, []interface{}{_c}).(bool) {
+						return "float64"
+					}
+					return func() interface{} {
+						if _isString.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 53, Column: 2}, []interface{}{_c}).(bool) {
+							return "string"
+						}
+						return func() interface{} {
+							if _isBool.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 54, Column: 2}, []interface{}{_c}).(bool) {
+								return "bool"
+							}
+							return ""
+						}()
+					}()
+				}()
+			}()
+		}, FixedArgs: 1}
+//line types.mml:57:1
+		_isLiteral = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line types.mml:57:17
+			return mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 57, Column: 17}, 12, _literalType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 57, Column: 17}, []interface{}{_c}), "")
+		}, FixedArgs: 1}
+//line types.mml:60:1
+		_goType = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line types.mml:60:21
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 60, Column: 21}, []interface{}{"goType", _c}).(bool) {
+					return mml.Ref(mml.Pos{Path: "types.mml", Line: 60, Column: 40}, _c, "goType")
+				}
+				return _literalType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 60, Column: 51}, []interface{}{_c})
+			}()
+		}, FixedArgs: 1}
+		exports.Set("goType", _goType)
+//line types.mml:63:1
+		_isNative = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line types.mml:63:23
+			return (_isLiteral.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 63, Column: 23}, []interface{}{_c}).(bool) || (_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 63, Column: 39}, []interface{}{"native", _c}).(bool) && mml.Ref(mml.Pos{Path: "types.mml", Line: 63, Column: 59}, _c, "native").(bool)))
+		}, FixedArgs: 1}
+		exports.Set("isNative", _isNative)
+//line types.mml:65:1
+		_withType = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+			var _t = a[1]
+			var _native = a[2]
+//line types.mml:65:27
+			return func() interface{} {
+				if mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 65, Column: 27}, 11, _t, "").(bool) {
+					return _c
+				}
+				return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("goType", _t).With("native", _native)
+			}()
+		}, FixedArgs: 3}
+//line types.mml:69:1
+		_unknown = &mml.Function{F: func(a []interface{}) interface{} {
+			var _mutable = a[0]
+//line types.mml:70:19
+			return mml.NewStruct(nil).With("goType", "").With("native", false).With("mutable", _mutable)
+		}, FixedArgs: 1}
+		_narrowed = &mml.Function{F: func(a []interface{}) interface{} {
+			var _t = a[0]
+//line types.mml:71:19
+			return mml.NewStruct(nil).With("goType", _t).With("native", false).With("mutable", false)
+		}, FixedArgs: 1}
+		_counter = &mml.Function{F: func(a []interface{}) interface{} {
+//line types.mml:72:19
+			return mml.NewStruct(nil).With("goType", "int").With("native", true).With("mutable", false)
+		}, FixedArgs: 0}
+//line types.mml:75:1
+		_typedVariable = &mml.Function{F: func(a []interface{}) interface{} {
+			var _t = a[0]
+			var _mutable = a[1]
+//line types.mml:75:31
+			return mml.NewStruct(nil).With("goType", _t).With("native", true).With("mutable", _mutable).With("state", mml.NewStruct(nil).With("conflict", false))
+		}, FixedArgs: 2}
+//line types.mml:77:1
+		_isTyped = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _name = a[1]
+//line types.mml:77:23
+			return (_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 77, Column: 23}, []interface{}{_name, _env}).(bool) && _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 77, Column: 41}, []interface{}{"state", mml.Ref(mml.Pos{Path: "types.mml", Line: 77, Column: 54}, _env, _name)}).(bool))
+		}, FixedArgs: 2}
+//line types.mml:79:1
+		_isSpread = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line types.mml:79:16
+			return (_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 79, Column: 16}, []interface{}{"type", _c}).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 79, Column: 34}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 79, Column: 34}, _c, "type"), "spread").(bool))
+		}, FixedArgs: 1}
+//line types.mml:81:1
+		_isBuiltinCall = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _c = a[1]
+			var _names = a[2]
+//line types.mml:82:2
+			return (((((((_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 82, Column: 2}, []interface{}{"type", _c}).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 83, Column: 2}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 83, Column: 2}, _c, "type"), "function-application").(bool)) && _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 84, Column: 2}, []interface{}{"type", mml.Ref(mml.Pos{Path: "types.mml", Line: 84, Column: 14}, _c, "function")}).(bool)) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 85, Column: 2}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 85, Column: 2}, mml.Ref(mml.Pos{}, _c, "function"), "type"), "symbol").(bool)) && _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 86, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 86, Column: 6}, mml.Ref(mml.Pos{}, _c, "function"), "name"), _names}).(bool)) && !_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 87, Column: 3}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 87, Column: 7}, mml.Ref(mml.Pos{}, _c, "function"), "name"), _env}).(bool)) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 88, Column: 2}, 11, _len.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 88, Column: 2}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 88, Column: 6}, _c, "args")}), mml.Ref(mml.Pos{Path: "types.mml", Line: 88, Column: 17}, mml.Ref(mml.Pos{}, _builtinTypes, mml.Ref(mml.Pos{Path: "types.mml", Line: 88, Column: 29}, mml.Ref(mml.Pos{}, _c, "function"), "name")), "args")).(bool)) && (_len.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 89, Column: 2}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 89, Column: 6}, []interface{}{_isSpread, mml.Ref(mml.Pos{Path: "types.mml", Line: 89, Column: 23}, _c, "args")})}).(int) == 0))
+		}, FixedArgs: 3}
+//line types.mml:92:1
+		_narrowing = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _c = a[1]
+//line types.mml:93:2
+			switch {
+			case ((_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 94, Column: 7}, []interface{}{"type", _c}).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 94, Column: 25}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 94, Column: 25}, _c, "type"), "binary").(bool)) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 94, Column: 47}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 94, Column: 47}, _c, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 94, Column: 55}, _code, "logicalAnd")).(bool)):
+//line types.mml:95:3
+				return mml.NewList().Concat(_narrowing.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 95, Column: 11}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 95, Column: 26}, _c, "left")}).(*mml.List)).Concat(_narrowing.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 95, Column: 38}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 95, Column: 53}, _c, "right")}).(*mml.List))
+			case ((_isBuiltinCall.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 96, Column: 7}, []interface{}{_env, _c, _guards}).(bool) && _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 96, Column: 40}, []interface{}{"type", mml.Ref(mml.Pos{Path: "types.mml", Line: 96, Column: 52}, mml.Ref(mml.Pos{}, _c, "args"), 0)}).(bool)) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 96, Column: 66}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 96, Column: 66}, mml.Ref(mml.Pos{Path: "types.mml", Line: 96, Column: 66}, mml.Ref(mml.Pos{}, _c, "args"), 0), "type"), "symbol").(bool)):
+//line types.mml:97:3
+				return mml.NewList(mml.NewStruct(nil).With("name", mml.Ref(mml.Pos{Path: "types.mml", Line: 97, Column: 18}, mml.Ref(mml.Pos{Path: "types.mml", Line: 97, Column: 18}, mml.Ref(mml.Pos{}, _c, "args"), 0), "name")).With("goType", mml.Ref(mml.Pos{Path: "types.mml", Line: 97, Column: 42}, _guards, mml.Ref(mml.Pos{Path: "types.mml", Line: 97, Column: 48}, mml.Ref(mml.Pos{}, _c, "function"), "name"))))
+			default:
+//line types.mml:99:3
+				return mml.NewList()
+			}
+			return nil
+		}, FixedArgs: 2}
+//line types.mml:104:1
+		_canNarrow = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _name = a[1]
+//line types.mml:104:25
+			return ((_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 104, Column: 25}, []interface{}{_name, _env}).(bool) && !mml.Ref(mml.Pos{Path: "types.mml", Line: 104, Column: 44}, mml.Ref(mml.Pos{}, _env, _name), "mutable").(bool)) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 104, Column: 65}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 104, Column: 65}, mml.Ref(mml.Pos{}, _env, _name), "goType"), "").(bool))
+		}, FixedArgs: 2}
+//line types.mml:106:1
+		_narrow = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _condition = a[1]
+//line types.mml:106:27
+			return _fold.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 106, Column: 27}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _g = a[0]
+				var _e = a[1]
+//line types.mml:107:12
+				return func() interface{} {
+					if _canNarrow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 107, Column: 12}, []interface{}{_e, mml.Ref(mml.Pos{Path: "types.mml", Line: 107, Column: 25}, _g, "name")}).(bool) {
+						return mml.NewStruct(nil).Merge(_e.(*mml.Struct)).With(mml.Ref(mml.Pos{Path: "types.mml", Line: 107, Column: 43}, _g, "name").(string), _narrowed.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 107, Column: 52}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 107, Column: 61}, _g, "goType")}))
+					}
+					return _e
+				}()
+			}, FixedArgs: 2}, _env, _narrowing.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 109, Column: 2}, []interface{}{_env, _condition})})
+		}, FixedArgs: 2}
+//line types.mml:112:1
+		_symbol = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _s = a[1]
+//line types.mml:112:19
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 112, Column: 19}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 112, Column: 23}, _s, "name"), _env}).(bool) {
+					return _withType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 112, Column: 38}, []interface{}{_s, mml.Ref(mml.Pos{Path: "types.mml", Line: 112, Column: 50}, mml.Ref(mml.Pos{}, _env, mml.Ref(mml.Pos{Path: "types.mml", Line: 112, Column: 53}, _s, "name")), "goType"), mml.Ref(mml.Pos{Path: "types.mml", Line: 112, Column: 70}, mml.Ref(mml.Pos{}, _env, mml.Ref(mml.Pos{Path: "types.mml", Line: 112, Column: 73}, _s, "name")), "native")})
+				}
+				return _s
+			}()
+		}, FixedArgs: 2}
+//line types.mml:114:1
+		_unary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _u = a[0]
+			var _arg = a[1]
+			var _r interface{}
+			var _t interface{}
+//line types.mml:115:2
+			_r = mml.NewStruct(nil).Merge(_u.(*mml.Struct)).With("arg", _arg)
+			_t = _goType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 117, Column: 5}, []interface{}{_arg})
+//line types.mml:120:2
+			switch {
+			case mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 121, Column: 7}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 121, Column: 7}, _u, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 121, Column: 15}, _code, "logicalNot")):
+//line types.mml:122:3
+				return _withType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 122, Column: 10}, []interface{}{_r, "bool", true})
+			case ((mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 123, Column: 8}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 123, Column: 8}, _u, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 123, Column: 16}, _code, "plus")).(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 123, Column: 29}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 123, Column: 29}, _u, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 123, Column: 37}, _code, "minus")).(bool)) && (mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 123, Column: 53}, 11, _t, "int").(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 123, Column: 67}, 11, _t, "float64").(bool))):
+//line types.mml:124:3
+				return _withType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 124, Column: 10}, []interface{}{_r, _t, true})
+			case (mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 125, Column: 7}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 125, Column: 7}, _u, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 125, Column: 15}, _code, "binaryNot")).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 125, Column: 33}, 11, _t, "int").(bool)):
+//line types.mml:126:3
+				return _withType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 126, Column: 10}, []interface{}{_r, _t, true})
+			default:
+//line types.mml:128:3
+				return _r
+			}
+			return nil
+		}, FixedArgs: 2}
+//line types.mml:132:1
+		_isZero = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+//line types.mml:132:14
+			return ((_isInt.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 132, Column: 14}, []interface{}{_c}).(bool) && (_c.(int) == 0)) || (_isFloat.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 132, Column: 36}, []interface{}{_c}).(bool) && (_c.(float64) == 0.0)))
+		}, FixedArgs: 1}
+//line types.mml:137:1
+		_binaryType = &mml.Function{F: func(a []interface{}) interface{} {
+			var _b = a[0]
+			var _t interface{}
+			var _same bool
+			var _constant bool
+//line types.mml:138:2
+			_t = _goType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 139, Column: 12}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 139, Column: 19}, _b, "left")})
+			_same = (mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 140, Column: 12}, 12, _t, "").(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 140, Column: 23}, 11, _t, _goType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 140, Column: 28}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 140, Column: 35}, _b, "right")})).(bool))
+			_constant = (_isLiteral.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 141, Column: 12}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 141, Column: 22}, _b, "left")}).(bool) && _isLiteral.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 141, Column: 33}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 141, Column: 43}, _b, "right")}).(bool))
+//line types.mml:144:2
+			switch {
+			case (mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 145, Column: 7}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 145, Column: 7}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 145, Column: 15}, _code, "logicalAnd")).(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 145, Column: 34}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 145, Column: 34}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 145, Column: 42}, _code, "logicalOr")).(bool)):
+//line types.mml:146:3
+				return "bool"
+			case (!_same || _constant):
+//line types.mml:148:3
 				return ""
-			},
-			FixedArgs: 1,
-		};
-_compileString = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\"%s\"", mml.Ref(_strings, "escape").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s)}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_symbol = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s", mml.Ref(_s, "name"))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_cond = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return func () interface{} { c = mml.Ref(_c, "ternary"); if c.(bool) { return _ternary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values) } else { return _compileIf.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values) } }()
-			},
-			FixedArgs: 1,
-		};
-_spreadList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s.(*mml.List).Values...", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "value"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_compileCase = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "case %s:\n%s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "expression"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "body"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_compileSend = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s <- %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "channel"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "value"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_compileReceive = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0];
-				;
-				mml.Nop(_r);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "<- %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_r, "channel"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_compileGo = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _g = a[0];
-				;
-				mml.Nop(_g);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "go %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_g, "application"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_definitions = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0];
-				;
-				mml.Nop(_l);
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, "definitions"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_assigns = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0];
-				;
-				mml.Nop(_l);
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, "assignments"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_ret = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0];
-				;
-				mml.Nop(_r);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "return %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_r, "value"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_control = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return func () interface{} { c = mml.BinaryOp(11, mml.Ref(_c, "control"), mml.Ref(_code, "breakControl")); if c.(bool) { return "break" } else { return "continue" } }()
-			},
-			FixedArgs: 1,
-		};
-_useList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "uses"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_list = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0];
-				;
-				mml.Nop(_l);
-				var _isSpread interface{};
-var _selectSpread interface{};
-var _groupSpread interface{};
-var _appendSimples interface{};
-var _appendSpread interface{};
-var _appendSpreads interface{};
-var _appendGroups interface{};
-var _appendGroup interface{};
-mml.Nop(_isSpread, _selectSpread, _groupSpread, _appendSimples, _appendSpread, _appendSpreads, _appendGroups, _appendGroup);
-_isSpread = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return (mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values), 3).(bool) && mml.BinaryOp(11, mml.RefRange(_c, mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values), 3), nil), "...").(bool))
-			},
-			FixedArgs: 1,
-		};
-_selectSpread = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return func () interface{} { c = _isSpread.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _c)}).Values); if c.(bool) { return func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["spread"] = _c;; return s }() } else { return _c } }()
-			},
-			FixedArgs: 1,
-		};
-_groupSpread = _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _item = a[0];
-var _groups = a[1];
-				;
-				mml.Nop(_item, _groups);
-				var _i interface{};
-var _isSpread interface{};
-var _groupIsSpread interface{};
-var _appendNewSimple interface{};
-var _appendNewSpread interface{};
-var _appendSimple interface{};
-var _appendSpread interface{};
-mml.Nop(_i, _isSpread, _groupIsSpread, _appendNewSimple, _appendNewSpread, _appendSimple, _appendSpread);
-_i = mml.BinaryOp(10, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _groups)}).Values), 1);
-_isSpread = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "spread", _item)}).Values);
-_groupIsSpread = (mml.BinaryOp(16, _i, 0).(bool) && _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "spread", mml.Ref(_groups, _i))}).Values).(bool));
-_appendNewSimple = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				return &mml.List{Values: append(append([]interface{}{}, _groups.(*mml.List).Values...), func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["simple"] = &mml.List{Values: append([]interface{}{}, _item)};; return s }())}
-			},
-			FixedArgs: 0,
-		};
-_appendNewSpread = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				return &mml.List{Values: append(append([]interface{}{}, _groups.(*mml.List).Values...), func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["spread"] = &mml.List{Values: append([]interface{}{}, mml.Ref(_item, "spread"))};; return s }())}
-			},
-			FixedArgs: 0,
-		};
-_appendSimple = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				return &mml.List{Values: append(append([]interface{}{}, mml.RefRange(_groups, nil, _i).(*mml.List).Values...), func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["simple"] = &mml.List{Values: append(append([]interface{}{}, mml.Ref(mml.Ref(_groups, _i), "simple").(*mml.List).Values...), _item)};; return s }())}
-			},
-			FixedArgs: 0,
-		};
-_appendSpread = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				return &mml.List{Values: append(append([]interface{}{}, mml.RefRange(_groups, nil, _i).(*mml.List).Values...), func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["spread"] = &mml.List{Values: append(append([]interface{}{}, mml.Ref(mml.Ref(_groups, _i), "spread").(*mml.List).Values...), mml.Ref(_item, "spread"))};; return s }())}
-			},
-			FixedArgs: 0,
-		};
-switch  {
-case ((mml.BinaryOp(13, _i, 0).(bool) || _groupIsSpread.(bool)) && !_isSpread.(bool)):
-;
-mml.Nop();
-return _appendNewSimple.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-case ((mml.BinaryOp(13, _i, 0).(bool) || !_groupIsSpread.(bool)) && _isSpread.(bool)):
-;
-mml.Nop();
-return _appendNewSpread.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-case (!_groupIsSpread.(bool) && !_isSpread.(bool)):
-;
-mml.Nop();
-return _appendSimple.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-case (_groupIsSpread.(bool) && _isSpread.(bool)):
-;
-mml.Nop();
-return _appendSpread.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-};
-				return nil
-			},
-			FixedArgs: 2,
-		}, &mml.List{Values: []interface{}{}})}).Values);
-_appendSimples = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _code = a[0];
-var _group = a[1];
-				;
-				mml.Nop(_code, _group);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "append(%s, %s)", _code, _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ", ", _group)}).Values))}).Values)
-			},
-			FixedArgs: 2,
-		};
-_appendSpread = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _item = a[0];
-var _code = a[1];
-				;
-				mml.Nop(_item, _code);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "append(%s, %s)", _code, _item)}).Values)
-			},
-			FixedArgs: 2,
-		};
-_appendSpreads = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _code = a[0];
-var _group = a[1];
-				;
-				mml.Nop(_code, _group);
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _appendSpread, _code, _group)}).Values)
-			},
-			FixedArgs: 2,
-		};
-_appendGroups = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _groups = a[0];
-				;
-				mml.Nop(_groups);
-				return _fold.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _appendGroup, "[]interface{}{}", _groups)}).Values)
-			},
-			FixedArgs: 1,
-		};
-_appendGroup = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _group = a[0];
-var _code = a[1];
-				;
-				mml.Nop(_group, _code);
-				return func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "spread", _group)}).Values); if c.(bool) { return _appendSpreads.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code, mml.Ref(_group, "spread"))}).Values) } else { return _appendSimples.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code, mml.Ref(_group, "simple"))}).Values) } }()
-			},
-			FixedArgs: 2,
-		};
-return (&mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "&mml.List{Values: %s}", _c)}).Values)
-			},
-			FixedArgs: 1,
-		}).Call((&mml.List{Values: append([]interface{}{}, _appendGroups.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _groupSpread.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _selectSpread)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, "values"))}).Values))}).Values))}).Values))}).Values))}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_entry = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _e = a[0];
-				;
-				mml.Nop(_e);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\"%s\":%s", func () interface{} { c = (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", mml.Ref(_e, "key"))}).Values).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(_e, "key"), "type"), "symbol").(bool)); if c.(bool) { return mml.Ref(mml.Ref(_e, "key"), "name") } else { return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_e, "key"))}).Values) } }(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_e, "value"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_expressionKey = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _k = a[0];
-				;
-				mml.Nop(_k);
-				return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_k, "value"))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_struct = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				var _entry interface{};
-var _entries interface{};
-mml.Nop(_entry, _entries);
-_entry = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _e = a[0];
-				;
-				mml.Nop(_e);
-				var _v interface{};
-mml.Nop(_v);
-_v = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_e, "value"))}).Values);
-c = mml.BinaryOp(11, mml.Ref(_e, "type"), "spread"); if c.(bool) { var _var interface{};
-var _assign interface{};
-mml.Nop(_var, _assign);
-_var = _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "sp := %s.(*mml.Struct);", _v)}).Values);
-_assign = "for k, v := range sp.Values { s.Values[k] = v };";
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "func() { %s; %s }();\n", _var, _assign)}).Values) };
-c = _isString.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_e, "key"))}).Values); if c.(bool) { ;
-mml.Nop();
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "s.Values[\"%s\"] = %s;", mml.Ref(_e, "key"), _v)}).Values) };
-c = mml.BinaryOp(11, mml.Ref(mml.Ref(_e, "key"), "type"), "symbol"); if c.(bool) { ;
-mml.Nop();
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "s.Values[\"%s\"] = %s;", mml.Ref(mml.Ref(_e, "key"), "name"), _v)}).Values) };
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "s.Values[%s.(string)] = %s;", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_e, "key"))}).Values), _v)}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_entries = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _entry)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "entries"))}).Values);
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; %s; return s }()", _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _entries)}).Values))}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_paramList = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _params = a[0];
-var _collectParam = a[1];
-				;
-				mml.Nop(_params, _collectParam);
-				var _p interface{};
-mml.Nop(_p);
-_p = &mml.List{Values: []interface{}{}};
-for _i := 0; _i < _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _params)}).Values).(int); _i++ {
-;
-mml.Nop();
-_p = &mml.List{Values: append(append([]interface{}{}, _p.(*mml.List).Values...), _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var _%s = a[%d]", mml.Ref(_params, _i), _i)}).Values))}
-};
-c = mml.BinaryOp(12, _collectParam, ""); if c.(bool) { ;
-mml.Nop();
-_p = &mml.List{Values: append(append([]interface{}{}, _p.(*mml.List).Values...), _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var _%s = &mml.List{a[%d:]}", _collectParam, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _params)}).Values))}).Values))} };
-return _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n", _p)}).Values);
-				return nil
-			},
-			FixedArgs: 2,
-		};
-_function = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _f = a[0];
-				;
-				mml.Nop(_f);
-				var _scope interface{};
-var _paramNames interface{};
-mml.Nop(_scope, _paramNames);
-_scope = _getScope.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_f, "statement"))}).Values);
-_paramNames = func () interface{} { c = mml.BinaryOp(11, mml.Ref(_f, "collectParam"), ""); if c.(bool) { return mml.Ref(_f, "params") } else { return &mml.List{Values: append(append([]interface{}{}, mml.Ref(_f, "params").(*mml.List).Values...), mml.Ref(_f, "collectParam"))} } }();
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", mml.Ref(_f, "statement"))}).Values).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(_f, "statement"), "type"), "statement-list").(bool)); if c.(bool) { return "&mml.Function{\n\t\t\tF: func(a []interface{}) interface{} {\n\t\t\t\tvar c interface{}\n\t\t\t\tmml.Nop(c)\n\t\t\t\t%s;\n\t\t\t\t%s;\n\t\t\t\tmml.Nop(%s);\n\t\t\t\t%s;\n\t\t\t\treturn nil\n\t\t\t},\n\t\t\tFixedArgs: %d,\n\t\t}" } else { return "&mml.Function{\n\t\t\tF: func(a []interface{}) interface{} {\n\t\t\t\tvar c interface{}\n\t\t\t\tmml.Nop(c)\n\t\t\t\t%s;\n\t\t\t\t%s;\n\t\t\t\tmml.Nop(%s);\n\t\t\t\treturn %s\n\t\t\t},\n\t\t\tFixedArgs: %d,\n\t\t}" } }(), _paramList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_f, "params"), mml.Ref(_f, "collectParam"))}).Values), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var _%s interface{}", _s)}).Values)
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _scope)}).Values))}).Values), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ", ", _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_strings, "formatOne").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s")}).Values), &mml.List{Values: append(append([]interface{}{}, _scope.(*mml.List).Values...), _paramNames.(*mml.List).Values...)})}).Values))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_f, "statement"))}).Values), _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_f, "params"))}).Values))}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_indexer = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _i = a[0];
-				;
-				mml.Nop(_i);
-				return func () interface{} { c = (!_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", mml.Ref(_i, "index"))}).Values).(bool) || mml.BinaryOp(12, mml.Ref(mml.Ref(_i, "index"), "type"), "range-expression").(bool)); if c.(bool) { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.Ref(%s, %s)", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_i, "expression"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_i, "index"))}).Values))}).Values) } else { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.RefRange(%s, %s, %s)", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_i, "expression"))}).Values), func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "from", mml.Ref(_i, "index"))}).Values); if c.(bool) { return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_i, "index"), "from"))}).Values) } else { return "nil" } }(), func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "to", mml.Ref(_i, "index"))}).Values); if c.(bool) { return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_i, "index"), "to"))}).Values) } else { return "nil" } }())}).Values) } }()
-			},
-			FixedArgs: 1,
-		};
-_application = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _a = a[0];
-				;
-				mml.Nop(_a);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", mml.Ref(_a, "function"))}).Values).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(_a, "function"), "type"), "function").(bool)); if c.(bool) { return "(%s).Call((%s).Values)" } else { return "%s.(*mml.Function).Call((%s).Values)" } }(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "function"))}).Values), _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["values"] = mml.Ref(_a, "args");; return s }())}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_unary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				return func () interface{} { c = mml.BinaryOp(11, mml.Ref(_u, "op"), mml.Ref(_code, "logicalNot")); if c.(bool) { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = _isBool.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "arg"))}).Values); if c.(bool) { return "!%s" } else { return "!%s.(bool)" } }(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "arg"))}).Values))}).Values) } else { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.UnaryOp(%d, %s)", mml.Ref(_u, "op"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "arg"))}).Values))}).Values) } }()
-			},
-			FixedArgs: 1,
-		};
-_binary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _b = a[0];
-				;
-				mml.Nop(_b);
-				var _isBoolOp interface{};
-var _left interface{};
-var _right interface{};
-var _op interface{};
-mml.Nop(_isBoolOp, _left, _right, _op);
-c = (mml.BinaryOp(12, mml.Ref(_b, "op"), mml.Ref(_code, "logicalAnd")).(bool) && mml.BinaryOp(12, mml.Ref(_b, "op"), mml.Ref(_code, "logicalOr")).(bool)); if c.(bool) { ;
-mml.Nop();
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.BinaryOp(%s, %s, %s)", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "op"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "left"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "right"))}).Values))}).Values) };
-_isBoolOp = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return ((_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", _c)}).Values).(bool) && (mml.BinaryOp(11, mml.Ref(_c, "type"), "unary").(bool) && mml.BinaryOp(11, mml.Ref(_c, "op"), mml.Ref(_code, "logicalNot")).(bool))) || (mml.BinaryOp(11, mml.Ref(_c, "type"), "binary").(bool) && (mml.BinaryOp(11, mml.Ref(_c, "op"), mml.Ref(_code, "logicalAnd")).(bool) || mml.BinaryOp(11, mml.Ref(_c, "op"), mml.Ref(_code, "logicalOr")).(bool))))
-			},
-			FixedArgs: 1,
-		};
-_left = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "left"))}).Values);
-_right = _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "right"))}).Values);
-c = (!_isBool.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "left"))}).Values).(bool) && !_isBoolOp.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "left"))}).Values).(bool)); if c.(bool) { ;
-mml.Nop();
-_left = mml.BinaryOp(9, _left, ".(bool)") };
-c = (!_isBool.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "right"))}).Values).(bool) && !_isBoolOp.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_b, "right"))}).Values).(bool)); if c.(bool) { ;
-mml.Nop();
-_right = mml.BinaryOp(9, _right, ".(bool)") };
-_op = "&&";
-c = mml.BinaryOp(11, mml.Ref(_b, "op"), mml.Ref(_code, "logicalOr")); if c.(bool) { ;
-mml.Nop();
-_op = "||" };
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "(%s %s %s)", _left, _op, _right)}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_ternary = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "func () interface{} { c = %s; if c.(bool) { return %s } else { return %s } }()", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "condition"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "consequent"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "alternative"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_compileIf = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "alternative", _c)}).Values); if c.(bool) { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "c = %s; if c.(bool) { %s } else { %s }", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "condition"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "consequent"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "alternative"))}).Values))}).Values) } else { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "c = %s; if c.(bool) { %s }", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "condition"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_c, "consequent"))}).Values))}).Values) } }()
-			},
-			FixedArgs: 1,
-		};
-_compileSwitch = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				var _hasDefault interface{};
-var _cases interface{};
-var _def interface{};
-var _defaultCode interface{};
-mml.Nop(_hasDefault, _cases, _def, _defaultCode);
-_hasDefault = mml.BinaryOp(15, _len.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_s, "defaultStatements"), "statements"))}).Values), 0);
-_cases = _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "cases"))}).Values);
-_def = func () interface{} { c = _hasDefault; if c.(bool) { return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "defaultStatements"))}).Values) } else { return "" } }();
-_defaultCode = func () interface{} { c = _hasDefault; if c.(bool) { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "default:\n%s", _def)}).Values) } else { return "" } }();
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "switch %s {\n%s\n}", func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", _s)}).Values); if c.(bool) { return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "expression"))}).Values) } else { return "" } }(), _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = _hasDefault; if c.(bool) { return &mml.List{Values: append(append([]interface{}{}, _cases.(*mml.List).Values...), _defaultCode)} } else { return _cases } }())}).Values))}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_compileSelect = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return (&mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _c = a[0];
-				;
-				mml.Nop(_c);
-				return mml.Ref(_strings, "formatOne").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "func() interface{} {\nselect {\n%s\n} }()")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = mml.Ref(_s, "hasDefault"); if c.(bool) { return &mml.List{Values: append(append([]interface{}{}, _c.(*mml.List).Values...), mml.Ref(_strings, "formatOne").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "default:\n%s")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "defaultStatements"))}).Values))}).Values))} } else { return _c } }())}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		}).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do)}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_s, "cases"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_compileDefer = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0];
-				;
-				mml.Nop(_d);
-				;
-mml.Nop();
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func () interface{} { c = (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", mml.Ref(mml.Ref(_d, "application"), "function"))}).Values).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(mml.Ref(_d, "application"), "function"), "type"), "function").(bool)); if c.(bool) { return "c = (%s); defer c.Call((%s).Values)" } else { return "defer %s.(*mml.Function).Call((%s).Values)" } }(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_d, "application"), "function"))}).Values), _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, func() interface{} { s := &mml.Struct{Values: make(map[string]interface{})}; s.Values["values"] = mml.Ref(mml.Ref(_d, "application"), "args");; return s }())}).Values))}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_rangeOver = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _r = a[0];
-				;
-				mml.Nop(_r);
-				var _infiniteCounter interface{};
-var _withRangeExpression interface{};
-var _listStyleRange interface{};
-mml.Nop(_infiniteCounter, _withRangeExpression, _listStyleRange);
-_infiniteCounter = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s := 0; true; _%s++", mml.Ref(_r, "symbol"), mml.Ref(_r, "symbol"))}).Values)
-			},
-			FixedArgs: 0,
-		};
-_withRangeExpression = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s := %s; %s; _%s++", mml.Ref(_r, "symbol"), func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "from", mml.Ref(_r, "expression"))}).Values); if c.(bool) { return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_r, "expression"), "from"))}).Values) } else { return "0" } }(), func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "to", mml.Ref(_r, "expression"))}).Values); if c.(bool) { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s < %s.(int)", mml.Ref(_r, "symbol"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_r, "expression"), "to"))}).Values))}).Values) } else { return "true" } }(), mml.Ref(_r, "symbol"))}).Values)
-			},
-			FixedArgs: 0,
-		};
-_listStyleRange = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				;
-				;
-				mml.Nop();
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_, _%s := range %s.(*mml.List).Values", mml.Ref(_r, "symbol"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_r, "expression"))}).Values))}).Values)
-			},
-			FixedArgs: 0,
-		};
-switch  {
-case !_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", _r)}).Values).(bool):
-;
-mml.Nop();
-return _infiniteCounter.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-case (_has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "type", mml.Ref(_r, "expression"))}).Values).(bool) && mml.BinaryOp(11, mml.Ref(mml.Ref(_r, "expression"), "type"), "range-expression").(bool)):
-;
-mml.Nop();
-return _withRangeExpression.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-default:
-;
-mml.Nop();
-return _listStyleRange.(*mml.Function).Call((&mml.List{Values: []interface{}{}}).Values)
-};
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_loop = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _l = a[0];
-				;
-				mml.Nop(_l);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "for %s {\n%s\n}", func () interface{} { c = _has.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "expression", _l)}).Values); if c.(bool) { return _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, "expression"))}).Values) } else { return "" } }(), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_l, "body"))}).Values))}).Values)
-			},
-			FixedArgs: 1,
-		};
-_definition = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _d = a[0];
-				;
-				mml.Nop(_d);
-				return func () interface{} { c = mml.Ref(_d, "exported"); if c.(bool) { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = %s; exports[\"%s\"] = _%s", mml.Ref(_d, "symbol"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_d, "expression"))}).Values), mml.Ref(_d, "symbol"), mml.Ref(_d, "symbol"))}).Values) } else { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = %s", mml.Ref(_d, "symbol"), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_d, "expression"))}).Values))}).Values) } }()
-			},
-			FixedArgs: 1,
-		};
-_assign = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _a = a[0];
-				;
-				mml.Nop(_a);
-				return func () interface{} { c = mml.BinaryOp(11, mml.Ref(mml.Ref(_a, "capture"), "type"), "symbol"); if c.(bool) { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s = %s", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "capture"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "value"))}).Values))}).Values) } else { return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "mml.SetRef(%s, %s, %s)", _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_a, "capture"), "expression"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(mml.Ref(_a, "capture"), "index"))}).Values), _do.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_a, "value"))}).Values))}).Values) } }()
-			},
-			FixedArgs: 1,
-		};
-_statements = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				var _scope interface{};
-var _scopeNames interface{};
-var _statements interface{};
-var _scopeDefs interface{};
-mml.Nop(_scope, _scopeNames, _statements, _scopeDefs);
-_scope = _getScope.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _s.(*mml.List).Values...)}).Values);
-_scopeNames = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ", ", _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_strings, "formatOne").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s")}).Values), _scope)}).Values))}).Values);
-_statements = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _notEmpty.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _do, _s)}).Values))}).Values))}).Values);
-_scopeDefs = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _s = a[0];
-				;
-				mml.Nop(_s);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var _%s interface{}", _s)}).Values)
-			},
-			FixedArgs: 1,
-		})}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _scope)}).Values))}).Values);
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "%s;\nmml.Nop(%s);\n%s", _scopeDefs, _scopeNames, _statements)}).Values);
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_compileUse = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _u = a[0];
-				;
-				mml.Nop(_u);
-				;
-mml.Nop();
-switch  {
-case mml.BinaryOp(11, mml.Ref(_u, "capture"), "."):
-var _useStatement interface{};
-var _assigns interface{};
-mml.Nop(_useStatement, _assigns);
-_useStatement = _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "var __%s = mml.Modules.Use(\"%s.mml\");", mml.Ref(_code, "getModuleName").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "path"))}).Values), mml.Ref(_u, "path"))}).Values);
-_assigns = _join.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";\n")}).Values).(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _map.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _name = a[0];
-				;
-				mml.Nop(_name);
-				return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = __%s.Values[\"%s\"]", _name, mml.Ref(_code, "getModuleName").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "path"))}).Values), _name)}).Values)
-			},
-			FixedArgs: 1,
-		}, mml.Ref(_u, "exportNames"))}).Values))}).Values);
-return _joins.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, ";", _useStatement, _assigns)}).Values)
-case mml.BinaryOp(12, mml.Ref(_u, "capture"), ""):
-;
-mml.Nop();
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = mml.Modules.Use(\"%s.mml\")", mml.Ref(_u, "capture"), mml.Ref(_u, "path"))}).Values)
-default:
-;
-mml.Nop();
-return _formats.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, "_%s = mml.Modules.Use(\"%s.mml\")", mml.Ref(_code, "getModuleName").(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_u, "path"))}).Values), mml.Ref(_u, "path"))}).Values)
-};
-				return nil
-			},
-			FixedArgs: 1,
-		};
-_do = &mml.Function{
-			F: func(a []interface{}) interface{} {
-				var c interface{}
-				mml.Nop(c)
-				var _code = a[0];
-				;
-				mml.Nop(_code);
-				;
-mml.Nop();
-switch  {
-case _isInt.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values):
-;
-mml.Nop();
-return _compileInt.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case _isFloat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values):
-;
-mml.Nop();
-return _compileFloat.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case _isString.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values):
-;
-mml.Nop();
-return _compileString.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case _isBool.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values):
-;
-mml.Nop();
-return _compileBool.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-};
-switch mml.Ref(_code, "type") {
-case "comment":
-;
-mml.Nop();
-return _comment.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "symbol":
-;
-mml.Nop();
-return _symbol.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "list":
-;
-mml.Nop();
-return _list.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "expression-key":
-;
-mml.Nop();
-return _expressionKey.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "entry":
-;
-mml.Nop();
-return _entry.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "struct":
-;
-mml.Nop();
-return _struct.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "function":
-;
-mml.Nop();
-return _function.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "indexer":
-;
-mml.Nop();
-return _indexer.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "spread":
-;
-mml.Nop();
-return _spreadList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "function-application":
-;
-mml.Nop();
-return _application.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "unary":
-;
-mml.Nop();
-return _unary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "binary":
-;
-mml.Nop();
-return _binary.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "cond":
-;
-mml.Nop();
-return _cond.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "switch-case":
-;
-mml.Nop();
-return _compileCase.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "switch-statement":
-;
-mml.Nop();
-return _compileSwitch.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "send":
-;
-mml.Nop();
-return _compileSend.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "receive":
-;
-mml.Nop();
-return _compileReceive.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "go":
-;
-mml.Nop();
-return _compileGo.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "defer":
-;
-mml.Nop();
-return _compileDefer.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "select-case":
-;
-mml.Nop();
-return _compileCase.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "select":
-;
-mml.Nop();
-return _compileSelect.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "range-over":
-;
-mml.Nop();
-return _rangeOver.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "loop":
-;
-mml.Nop();
-return _loop.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "definition":
-;
-mml.Nop();
-return _definition.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "definition-list":
-;
-mml.Nop();
-return _definitions.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "assign":
-;
-mml.Nop();
-return _assign.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "assign-list":
-;
-mml.Nop();
-return _assigns.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "ret":
-;
-mml.Nop();
-return _ret.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "control-statement":
-;
-mml.Nop();
-return _control.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "use":
-;
-mml.Nop();
-return _compileUse.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-case "use-list":
-;
-mml.Nop();
-return _useList.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, _code)}).Values)
-default:
-;
-mml.Nop();
-return _statements.(*mml.Function).Call((&mml.List{Values: append([]interface{}{}, mml.Ref(_code, "statements"))}).Values)
-};
-				return nil
-			},
-			FixedArgs: 1,
-		}; exports["do"] = _do
+			case (mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 149, Column: 7}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 149, Column: 7}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 149, Column: 15}, _code, "eq")).(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 149, Column: 26}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 149, Column: 26}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 149, Column: 34}, _code, "notEq")).(bool)):
+//line types.mml:150:3
+				return "bool"
+			case (_contains.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 151, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 151, Column: 16}, _b, "op"), _ordering}).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 151, Column: 35}, 12, _t, "bool").(bool)):
+//line types.mml:152:3
+				return "bool"
+			case (mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 153, Column: 7}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 153, Column: 7}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 153, Column: 15}, _code, "add")).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 153, Column: 27}, 12, _t, "bool").(bool)):
+//line types.mml:154:3
+				return _t
+			case ((mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 155, Column: 8}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 155, Column: 8}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 155, Column: 16}, _code, "sub")).(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 155, Column: 28}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 155, Column: 28}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 155, Column: 36}, _code, "mul")).(bool)) && (mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 155, Column: 50}, 11, _t, "int").(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 155, Column: 64}, 11, _t, "float64").(bool))):
+//line types.mml:156:3
+				return _t
+			case ((mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 157, Column: 7}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 157, Column: 7}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 157, Column: 15}, _code, "div")).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 157, Column: 27}, 11, _t, "float64").(bool)) && !_isZero.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 157, Column: 46}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 157, Column: 53}, _b, "right")}).(bool)):
+//line types.mml:158:3
+				return _t
+			case ((((mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 159, Column: 8}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 159, Column: 8}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 159, Column: 16}, _code, "div")).(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 159, Column: 28}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 159, Column: 28}, _b, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 159, Column: 36}, _code, "mod")).(bool)) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 159, Column: 49}, 11, _t, "int").(bool)) && _isInt.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 159, Column: 63}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 159, Column: 69}, _b, "right")}).(bool)) && !_isZero.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 159, Column: 82}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 159, Column: 89}, _b, "right")}).(bool)):
+//line types.mml:160:3
+				return _t
+			case (_contains.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 161, Column: 7}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 161, Column: 16}, _b, "op"), _bitwise}).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 161, Column: 34}, 11, _t, "int").(bool)):
+//line types.mml:162:3
+				return _t
+			default:
+//line types.mml:164:3
+				return ""
+			}
+			return nil
+		}, FixedArgs: 1}
+//line types.mml:168:1
+		_binary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _b = a[0]
+			var _left = a[1]
+			var _right = a[2]
+			var _r interface{}
+			var _t interface{}
+//line types.mml:169:2
+			_r = mml.NewStruct(nil).Merge(_b.(*mml.Struct)).With("left", _left).With("right", _right)
+//line types.mml:170:2
+			_t = _binaryType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 170, Column: 8}, []interface{}{_r})
+//line types.mml:171:2
+			return _withType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 171, Column: 9}, []interface{}{_r, _t, true})
+		}, FixedArgs: 3}
+//line types.mml:175:1
+		_ternary = &mml.Function{F: func(a []interface{}) interface{} {
+			var _c = a[0]
+			var _condition = a[1]
+			var _consequent = a[2]
+			var _alternative = a[3]
+			var _r interface{}
+//line types.mml:176:2
+			_r = mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("condition", _condition).With("consequent", _consequent).With("alternative", _alternative)
+//line types.mml:177:2
+			return func() interface{} {
+				if mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 177, Column: 9}, 11, _goType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 177, Column: 9}, []interface{}{_consequent}), _goType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 177, Column: 31}, []interface{}{_alternative})).(bool) {
+					return _withType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 177, Column: 53}, []interface{}{_r, _goType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 177, Column: 65}, []interface{}{_consequent}), false})
+				}
+				return _r
+			}()
+		}, FixedArgs: 4}
+//line types.mml:180:1
+		_application = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _a = a[1]
+			var _f = a[2]
+			var _args = a[3]
+			var _r interface{}
+//line types.mml:181:2
+			_r = mml.NewStruct(nil).Merge(_a.(*mml.Struct)).With("function", _f).With("args", _args)
+//line types.mml:182:2
+			return func() interface{} {
+				if _isBuiltinCall.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 182, Column: 9}, []interface{}{_env, _a, _builtinTypes}).(bool) {
+					return _withType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 182, Column: 47}, []interface{}{_r, mml.Ref(mml.Pos{Path: "types.mml", Line: 182, Column: 59}, mml.Ref(mml.Pos{}, _builtinTypes, mml.Ref(mml.Pos{Path: "types.mml", Line: 182, Column: 71}, mml.Ref(mml.Pos{}, _a, "function"), "name")), "goType"), false})
+				}
+				return _r
+			}()
+		}, FixedArgs: 4}
+//line types.mml:187:1
+		_shallow = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _c = a[1]
+//line types.mml:188:2
+			if !_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 188, Column: 6}, []interface{}{"type", _c}).(bool) {
+//line types.mml:189:3
+				return _c
+			}
+//line types.mml:192:2
+			switch mml.Ref(mml.Pos{Path: "types.mml", Line: 192, Column: 9}, _c, "type") {
+			case "symbol":
+//line types.mml:194:3
+				return _symbol.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 194, Column: 10}, []interface{}{_env, _c})
+			case "unary":
+//line types.mml:196:3
+				return _unary.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 196, Column: 10}, []interface{}{_c, _shallow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 196, Column: 19}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 196, Column: 32}, _c, "arg")})})
+			case "binary":
+				var _right interface{}
+//line types.mml:198:3
+				_right = _shallow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 198, Column: 13}, []interface{}{func() interface{} {
+					if mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 198, Column: 21}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 198, Column: 21}, _c, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 198, Column: 29}, _code, "logicalAnd")).(bool) {
+						return _narrow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 198, Column: 47}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 198, Column: 59}, _c, "left")})
+					}
+					return _env
+				}(), mml.Ref(mml.Pos{Path: "types.mml", Line: 198, Column: 74}, _c, "right")})
+//line types.mml:199:3
+				return _binary.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 199, Column: 10}, []interface{}{_c, _shallow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 199, Column: 20}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 199, Column: 33}, _c, "left")}), _right})
+			case "cond":
+//line types.mml:201:3
+				return func() interface{} {
+					if mml.Ref(mml.Pos{Path: "types.mml", Line: 201, Column: 10}, _c, "ternary").(bool) {
+						return _ternary.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 202, Column: 4}, []interface{}{_c, _shallow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 204, Column: 5}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 204, Column: 18}, _c, "condition")}), _shallow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 205, Column: 5}, []interface{}{_narrow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 205, Column: 13}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 205, Column: 25}, _c, "condition")}), mml.Ref(mml.Pos{Path: "types.mml", Line: 205, Column: 39}, _c, "consequent")}), _shallow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 206, Column: 5}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 206, Column: 18}, _c, "alternative")})})
+					}
+					return _c
+				}()
+			case "function-application":
+//line types.mml:210:3
+				return _application.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 210, Column: 10}, []interface{}{_env, _c, mml.Ref(mml.Pos{Path: "types.mml", Line: 210, Column: 30}, _c, "function"), mml.Ref(mml.Pos{Path: "types.mml", Line: 210, Column: 42}, _c, "args")})
+			default:
+//line types.mml:212:3
+				return _c
+			}
+			return nil
+		}, FixedArgs: 2}
+//line types.mml:216:1
+		_inferFields = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _c = a[1]
+			var _fields = a[2]
+//line types.mml:216:33
+			return _fold.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 216, Column: 33}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _f = a[0]
+				var _r = a[1]
+//line types.mml:216:49
+				return func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 216, Column: 49}, []interface{}{_f, _r}).(bool) {
+						return mml.NewStruct(nil).Merge(_r.(*mml.Struct)).With(_f.(string), _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 216, Column: 73}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 216, Column: 84}, _r, _f)}))
+					}
+					return _r
+				}()
+			}, FixedArgs: 2}, _c, _fields})
+		}, FixedArgs: 3}
+//line types.mml:218:1
+		_function = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _f = a[1]
+			var _params interface{}
+			var _functionEnv interface{}
+//line types.mml:219:2
+			_params = func() interface{} {
+				if mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 219, Column: 13}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 219, Column: 13}, _f, "collectParam"), "").(bool) {
+					return mml.Ref(mml.Pos{Path: "types.mml", Line: 219, Column: 36}, _f, "params")
+				}
+				return mml.NewList().Concat(mml.Ref(mml.Pos{Path: "types.mml", Line: 219, Column: 48}, _f, "params").(*mml.List)).Append(mml.Ref(mml.Pos{Path: "types.mml", Line: 219, Column: 61}, _f, "collectParam"))
+			}()
+//line types.mml:220:2
+			_functionEnv = _fold.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 220, Column: 18}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _p = a[0]
+				var _e = a[1]
+//line types.mml:220:33
+				return mml.NewStruct(nil).Merge(_e.(*mml.Struct)).With(_p.(string), _unknown.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 220, Column: 45}, []interface{}{false}))
+			}, FixedArgs: 2}, _env, _params})
+//line types.mml:221:2
+			return mml.NewStruct(nil).Merge(_f.(*mml.Struct)).With("statement", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 221, Column: 27}, []interface{}{_functionEnv, mml.Ref(mml.Pos{Path: "types.mml", Line: 221, Column: 46}, _f, "statement")}))
+		}, FixedArgs: 2}
+//line types.mml:224:1
+		_cond = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _c = a[1]
+			var _condition interface{}
+			var _consequent interface{}
+			var _r interface{}
+//line types.mml:225:2
+			_condition = _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 226, Column: 14}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 226, Column: 25}, _c, "condition")})
+			_consequent = _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 227, Column: 14}, []interface{}{_narrow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 227, Column: 20}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 227, Column: 32}, _c, "condition")}), mml.Ref(mml.Pos{Path: "types.mml", Line: 227, Column: 46}, _c, "consequent")})
+//line types.mml:230:2
+			if mml.Ref(mml.Pos{Path: "types.mml", Line: 230, Column: 5}, _c, "ternary").(bool) {
+//line types.mml:231:3
+				return _ternary.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 231, Column: 10}, []interface{}{_c, _condition, _consequent, _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 231, Column: 44}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 231, Column: 55}, _c, "alternative")})})
+			}
+//line types.mml:234:2
+			_r = mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("condition", _condition).With("consequent", _consequent)
+//line types.mml:235:2
+			return func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 235, Column: 9}, []interface{}{"alternative", _c}).(bool) {
+					return mml.NewStruct(nil).Merge(_r.(*mml.Struct)).With("alternative", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 235, Column: 53}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 235, Column: 64}, _c, "alternative")}))
+				}
+				return _r
+			}()
+		}, FixedArgs: 2}
+//line types.mml:238:1
+		_selectCase = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _c = a[1]
+			var _d interface{}
+//line types.mml:239:2
+			_d = mml.Ref(mml.Pos{Path: "types.mml", Line: 239, Column: 8}, _c, "expression")
+//line types.mml:240:2
+			if (!_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 240, Column: 6}, []interface{}{"type", _d}).(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 240, Column: 24}, 12, mml.Ref(mml.Pos{Path: "types.mml", Line: 240, Column: 24}, _d, "type"), "definition").(bool)) {
+//line types.mml:241:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 241, Column: 10}, []interface{}{_env, _c, mml.NewList("expression", "body")})
+			}
+//line types.mml:244:2
+			return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("expression", mml.NewStruct(nil).Merge(_d.(*mml.Struct)).With("expression", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 246, Column: 34}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 246, Column: 45}, _d, "expression")}))).With("body", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 247, Column: 15}, []interface{}{mml.NewStruct(nil).Merge(_env.(*mml.Struct)).With(mml.Ref(mml.Pos{Path: "types.mml", Line: 247, Column: 31}, _d, "symbol").(string), _unknown.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 247, Column: 42}, []interface{}{false})), mml.Ref(mml.Pos{Path: "types.mml", Line: 247, Column: 59}, _c, "body")}))
+		}, FixedArgs: 2}
+//line types.mml:251:1
+		_loop = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _l = a[1]
+			var _e interface{}
+			var _isCounter bool
+			var _variable interface{}
+			var _bodyEnv interface{}
+//line types.mml:252:2
+			if !_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 252, Column: 6}, []interface{}{"expression", _l}).(bool) {
+//line types.mml:253:3
+				return mml.NewStruct(nil).Merge(_l.(*mml.Struct)).With("body", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 253, Column: 23}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 253, Column: 34}, _l, "body")}))
+			}
+//line types.mml:256:2
+			_e = mml.Ref(mml.Pos{Path: "types.mml", Line: 256, Column: 8}, _l, "expression")
+//line types.mml:257:2
+			if (!_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 257, Column: 6}, []interface{}{"type", _e}).(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 257, Column: 24}, 12, mml.Ref(mml.Pos{Path: "types.mml", Line: 257, Column: 24}, _e, "type"), "range-over").(bool)) {
+//line types.mml:258:3
+				return mml.NewStruct(nil).Merge(_l.(*mml.Struct)).With("expression", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 258, Column: 29}, []interface{}{_env, _e})).With("body", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 258, Column: 50}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 258, Column: 61}, _l, "body")}))
+			}
+//line types.mml:261:2
+			_isCounter = (!_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 261, Column: 17}, []interface{}{"expression", _e}).(bool) || (_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 261, Column: 41}, []interface{}{"type", mml.Ref(mml.Pos{Path: "types.mml", Line: 261, Column: 53}, _e, "expression")}).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 261, Column: 70}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 261, Column: 70}, mml.Ref(mml.Pos{}, _e, "expression"), "type"), "range-expression").(bool)))
+//line types.mml:262:2
+			_variable = func() interface{} {
+				if _isCounter {
+					return _counter.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 262, Column: 27}, []interface{}{})
+				}
+				return _unknown.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 262, Column: 39}, []interface{}{false})
+			}()
+//line types.mml:263:2
+			_bodyEnv = _fold.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 265, Column: 5}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _n = a[0]
+				var _be = a[1]
+//line types.mml:265:21
+				return mml.NewStruct(nil).Merge(_be.(*mml.Struct)).With(_n.(string), _variable)
+			}, FixedArgs: 2}, _env}).(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 263, Column: 14}, []interface{}{_flat.(*mml.Function).CallAt(mml.Pos{}, []interface{}{mml.NewList(func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 263, Column: 15}, []interface{}{"key", _e}).(bool) {
+					return mml.NewList(mml.Ref(mml.Pos{Path: "types.mml", Line: 263, Column: 32}, _e, "key"))
+				}
+				return mml.NewList()
+			}(), func() interface{} {
+				if _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 263, Column: 45}, []interface{}{"symbol", _e}).(bool) {
+					return mml.NewList(mml.Ref(mml.Pos{Path: "types.mml", Line: 263, Column: 65}, _e, "symbol"))
+				}
+				return mml.NewList()
+			}())})})
+//line types.mml:267:2
+			return mml.NewStruct(nil).Merge(_l.(*mml.Struct)).With("expression", _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 267, Column: 28}, []interface{}{_env, _e, mml.NewList("expression")})).With("body", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 267, Column: 71}, []interface{}{_bodyEnv, mml.Ref(mml.Pos{Path: "types.mml", Line: 267, Column: 86}, _l, "body")}))
+		}, FixedArgs: 2}
+//line types.mml:270:1
+		_definition = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _d = a[1]
+			var _r interface{}
+//line types.mml:271:2
+			_r = mml.NewStruct(nil).Merge(_d.(*mml.Struct)).With("expression", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 271, Column: 27}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 271, Column: 38}, _d, "expression")}))
+//line types.mml:272:2
+			return func() interface{} {
+				if _isTyped.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 272, Column: 9}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 272, Column: 22}, _d, "symbol")}).(bool) {
+					return mml.NewStruct(nil).Merge(_r.(*mml.Struct)).With("goType", mml.Ref(mml.Pos{Path: "types.mml", Line: 272, Column: 49}, mml.Ref(mml.Pos{}, _env, mml.Ref(mml.Pos{Path: "types.mml", Line: 272, Column: 52}, _d, "symbol")), "goType"))
+				}
+				return _r
+			}()
+		}, FixedArgs: 2}
+//line types.mml:276:1
+		_assign = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _a = a[1]
+			var _r interface{}
+			var _v interface{}
+//line types.mml:277:2
+			_r = _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 277, Column: 8}, []interface{}{_env, _a, mml.NewList("capture", "value")})
+//line types.mml:278:2
+			if ((!_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 278, Column: 6}, []interface{}{"type", mml.Ref(mml.Pos{Path: "types.mml", Line: 278, Column: 18}, _a, "capture")}).(bool) || mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 278, Column: 32}, 12, mml.Ref(mml.Pos{Path: "types.mml", Line: 278, Column: 32}, mml.Ref(mml.Pos{}, _a, "capture"), "type"), "symbol").(bool)) || !_isTyped.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 278, Column: 63}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 278, Column: 76}, mml.Ref(mml.Pos{}, _a, "capture"), "name")}).(bool)) {
+//line types.mml:279:3
+				return _r
+			}
+//line types.mml:282:2
+			_v = mml.Ref(mml.Pos{Path: "types.mml", Line: 282, Column: 8}, _env, mml.Ref(mml.Pos{Path: "types.mml", Line: 282, Column: 11}, mml.Ref(mml.Pos{}, _a, "capture"), "name"))
+//line types.mml:283:2
+			if mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 283, Column: 5}, 12, _goType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 283, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 283, Column: 12}, _r, "value")}), mml.Ref(mml.Pos{Path: "types.mml", Line: 283, Column: 24}, _v, "goType")).(bool) {
+//line types.mml:284:3
+				mml.SetRef(mml.Pos{Path: "types.mml", Line: 284, Column: 3}, mml.Ref(mml.Pos{}, _v, "state"), "conflict", true)
+			}
+//line types.mml:287:2
+			return mml.NewStruct(nil).Merge(_r.(*mml.Struct)).With("goType", mml.Ref(mml.Pos{Path: "types.mml", Line: 287, Column: 24}, _v, "goType"))
+		}, FixedArgs: 2}
+//line types.mml:291:1
+		_declare = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _statements = a[1]
+			var _demoted = a[2]
+			var _scope interface{}
+//line types.mml:292:2
+			_scope = _fold.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 292, Column: 46}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+				var _n = a[0]
+				var _e = a[1]
+//line types.mml:292:61
+				return mml.NewStruct(nil).Merge(_e.(*mml.Struct)).With(_n.(string), _unknown.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 292, Column: 73}, []interface{}{false}))
+			}, FixedArgs: 2}, _env}).(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 292, Column: 14}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 292, Column: 14}, _code, "getScope").(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 292, Column: 14}, mml.NewList().Concat(_statements.(*mml.List)).Items())})
+//line types.mml:293:2
+			for __iter := mml.Iterate(mml.Pos{Path: "types.mml", Line: 293, Column: 6}, mml.Ref(mml.Pos{Path: "types.mml", Line: 293, Column: 11}, _code, "flattenedStatements").(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 293, Column: 11}, []interface{}{"definition", "definition-list", "definitions", _statements})); __iter.Next(); {
+				_d := __iter.Value()
+				var _t interface{}
+//line types.mml:294:3
+				_t = _goType.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 294, Column: 9}, []interface{}{_shallow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 294, Column: 16}, []interface{}{_scope, mml.Ref(mml.Pos{Path: "types.mml", Line: 294, Column: 31}, _d, "expression")})})
+//line types.mml:295:3
+				_scope = mml.NewStruct(nil).Merge(_scope.(*mml.Struct)).With(mml.Ref(mml.Pos{Path: "types.mml", Line: 297, Column: 5}, _d, "symbol").(string), func() interface{} {
+					if (_contains.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 297, Column: 16}, []interface{}{_t, _primitives}).(bool) && !_contains.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 297, Column: 44}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 297, Column: 53}, _d, "symbol"), _demoted}).(bool)) {
+						return _typedVariable.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 298, Column: 5}, []interface{}{_t, mml.Ref(mml.Pos{Path: "types.mml", Line: 298, Column: 22}, _d, "mutable")})
+					}
+					return _unknown.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 299, Column: 5}, []interface{}{mml.Ref(mml.Pos{Path: "types.mml", Line: 299, Column: 13}, _d, "mutable")})
+				}())
+			}
+//line types.mml:303:2
+			return _scope
+		}, FixedArgs: 3}
+//line types.mml:306:1
+		_statementList = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _l = a[1]
+			var _names interface{}
+			var _demoted interface{}
+//line types.mml:307:2
+			_names = mml.Ref(mml.Pos{Path: "types.mml", Line: 307, Column: 12}, _code, "getScope").(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 307, Column: 12}, mml.NewList().Concat(mml.Ref(mml.Pos{Path: "types.mml", Line: 307, Column: 26}, _l, "statements").(*mml.List)).Items())
+//line types.mml:308:2
+			_demoted = mml.NewList()
+//line types.mml:309:2
+			for {
+				var _scope interface{}
+				var _statements interface{}
+				var _conflicts interface{}
+//line types.mml:310:3
+				_scope = _declare.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 310, Column: 13}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 310, Column: 26}, _l, "statements"), _demoted})
+//line types.mml:311:3
+				_statements = _map.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 311, Column: 18}, []interface{}{_infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 311, Column: 22}, []interface{}{_scope}), mml.Ref(mml.Pos{Path: "types.mml", Line: 311, Column: 36}, _l, "statements")})
+//line types.mml:312:3
+				_conflicts = _filter.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 312, Column: 26}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+					var _n = a[0]
+//line types.mml:312:40
+					return (_isTyped.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 312, Column: 40}, []interface{}{_scope, _n}).(bool) && mml.Ref(mml.Pos{Path: "types.mml", Line: 312, Column: 61}, mml.Ref(mml.Pos{Path: "types.mml", Line: 312, Column: 61}, mml.Ref(mml.Pos{}, _scope, _n), "state"), "conflict").(bool))
+				}, FixedArgs: 1}}).(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 312, Column: 17}, []interface{}{_names})
+//line types.mml:313:3
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 313, Column: 6}, []interface{}{_conflicts}).(int) == 0) {
+					var _types interface{}
+//line types.mml:314:4
+					_types = _fold.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 316, Column: 7}, []interface{}{&mml.Function{F: func(a []interface{}) interface{} {
+						var _n = a[0]
+						var _t = a[1]
+//line types.mml:316:22
+						return mml.NewStruct(nil).Merge(_t.(*mml.Struct)).With(_n.(string), mml.Ref(mml.Pos{Path: "types.mml", Line: 316, Column: 34}, mml.Ref(mml.Pos{}, _scope, _n), "goType"))
+					}, FixedArgs: 2}, mml.NewStruct(nil)}).(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 314, Column: 14}, []interface{}{_filter.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 315, Column: 7}, []interface{}{_isTyped.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 315, Column: 14}, []interface{}{_scope})}).(*mml.Function).CallAt(mml.Pos{}, []interface{}{_names})})
+//line types.mml:318:4
+					return mml.NewStruct(nil).Merge(_l.(*mml.Struct)).With("statements", _statements).With("types", _types)
+				}
+//line types.mml:321:3
+				_demoted = mml.NewList().Concat(_demoted.(*mml.List)).Concat(_conflicts.(*mml.List))
+			}
+			return nil
+		}, FixedArgs: 2}
+//line types.mml:325:1
+		_infer = &mml.Function{F: func(a []interface{}) interface{} {
+			var _env = a[0]
+			var _c = a[1]
+//line types.mml:326:2
+			if !_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 326, Column: 6}, []interface{}{"type", _c}).(bool) {
+//line types.mml:327:3
+				return _c
+			}
+//line types.mml:330:2
+			switch mml.Ref(mml.Pos{Path: "types.mml", Line: 330, Column: 9}, _c, "type") {
+			case "symbol":
+//line types.mml:332:3
+				return _symbol.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 332, Column: 10}, []interface{}{_env, _c})
+			case "list":
+//line types.mml:334:3
+				return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("values", _map.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 334, Column: 25}, []interface{}{_infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 334, Column: 29}, []interface{}{_env}), mml.Ref(mml.Pos{Path: "types.mml", Line: 334, Column: 41}, _c, "values")}))
+			case "struct":
+//line types.mml:336:3
+				return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("entries", _map.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 336, Column: 26}, []interface{}{_infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 336, Column: 30}, []interface{}{_env}), mml.Ref(mml.Pos{Path: "types.mml", Line: 336, Column: 42}, _c, "entries")}))
+			case "entry":
+				var _key interface{}
+//line types.mml:338:3
+				_key = func() interface{} {
+					if (_has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 338, Column: 11}, []interface{}{"type", mml.Ref(mml.Pos{Path: "types.mml", Line: 338, Column: 23}, _c, "key")}).(bool) && mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 338, Column: 33}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 338, Column: 33}, mml.Ref(mml.Pos{}, _c, "key"), "type"), "expression-key").(bool)) {
+						return _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 338, Column: 66}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 338, Column: 77}, _c, "key")})
+					}
+					return mml.Ref(mml.Pos{Path: "types.mml", Line: 338, Column: 86}, _c, "key")
+				}()
+//line types.mml:339:3
+				return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("key", _key).With("value", _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 339, Column: 34}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 339, Column: 45}, _c, "value")}))
+			case "function":
+//line types.mml:341:3
+				return _function.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 341, Column: 10}, []interface{}{_env, _c})
+			case "unary":
+//line types.mml:343:3
+				return _unary.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 343, Column: 10}, []interface{}{_c, _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 343, Column: 19}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 343, Column: 30}, _c, "arg")})})
+			case "binary":
+				var _right interface{}
+//line types.mml:345:3
+				_right = _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 345, Column: 13}, []interface{}{func() interface{} {
+					if mml.BinaryOp(mml.Pos{Path: "types.mml", Line: 345, Column: 19}, 11, mml.Ref(mml.Pos{Path: "types.mml", Line: 345, Column: 19}, _c, "op"), mml.Ref(mml.Pos{Path: "types.mml", Line: 345, Column: 27}, _code, "logicalAnd")).(bool) {
+						return _narrow.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 345, Column: 45}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 345, Column: 57}, _c, "left")})
+					}
+					return _env
+				}(), mml.Ref(mml.Pos{Path: "types.mml", Line: 345, Column: 72}, _c, "right")})
+//line types.mml:346:3
+				return _binary.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 346, Column: 10}, []interface{}{_c, _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 346, Column: 20}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 346, Column: 31}, _c, "left")}), _right})
+			case "function-application":
+//line types.mml:348:3
+				return _application.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 348, Column: 10}, []interface{}{_env, _c, _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 348, Column: 30}, []interface{}{_env, mml.Ref(mml.Pos{Path: "types.mml", Line: 348, Column: 41}, _c, "function")}), _map.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 348, Column: 54}, []interface{}{_infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 348, Column: 58}, []interface{}{_env}), mml.Ref(mml.Pos{Path: "types.mml", Line: 348, Column: 70}, _c, "args")})})
+			case "cond":
+//line types.mml:350:3
+				return _cond.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 350, Column: 10}, []interface{}{_env, _c})
+			case "switch-statement":
+//line types.mml:352:3
+				return mml.NewStruct(nil).Merge(_inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 352, Column: 11}, []interface{}{_env, _c, mml.NewList("expression", "defaultStatements")}).(*mml.Struct)).With("cases", _map.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 352, Column: 79}, []interface{}{_infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 352, Column: 83}, []interface{}{_env}), mml.Ref(mml.Pos{Path: "types.mml", Line: 352, Column: 95}, _c, "cases")}))
+			case "select-case":
+//line types.mml:354:3
+				return _selectCase.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 354, Column: 10}, []interface{}{_env, _c})
+			case "select":
+//line types.mml:356:3
+				return mml.NewStruct(nil).Merge(_inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 356, Column: 11}, []interface{}{_env, _c, mml.NewList("defaultStatements")}).(*mml.Struct)).With("cases", _map.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 356, Column: 65}, []interface{}{_infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 356, Column: 69}, []interface{}{_env}), mml.Ref(mml.Pos{Path: "types.mml", Line: 356, Column: 81}, _c, "cases")}))
+			case "loop":
+//line types.mml:358:3
+				return _loop.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 358, Column: 10}, []interface{}{_env, _c})
+			case "definition":
+//line types.mml:360:3
+				return _definition.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 360, Column: 10}, []interface{}{_env, _c})
+			case "definition-list":
+//line types.mml:362:3
+				return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("definitions", _map.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 362, Column: 30}, []interface{}{_infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 362, Column: 34}, []interface{}{_env}), mml.Ref(mml.Pos{Path: "types.mml", Line: 362, Column: 46}, _c, "definitions")}))
+			case "assign":
+//line types.mml:364:3
+				return _assign.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 364, Column: 10}, []interface{}{_env, _c})
+			case "assign-list":
+//line types.mml:366:3
+				return mml.NewStruct(nil).Merge(_c.(*mml.Struct)).With("assignments", _map.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 366, Column: 30}, []interface{}{_infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 366, Column: 34}, []interface{}{_env}), mml.Ref(mml.Pos{Path: "types.mml", Line: 366, Column: 46}, _c, "assignments")}))
+			case "expression-key":
+//line types.mml:368:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 368, Column: 10}, []interface{}{_env, _c, mml.NewList("value")})
+			case "spread":
+//line types.mml:370:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 370, Column: 10}, []interface{}{_env, _c, mml.NewList("value")})
+			case "indexer":
+//line types.mml:372:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 372, Column: 10}, []interface{}{_env, _c, mml.NewList("expression", "index")})
+			case "range-expression":
+//line types.mml:374:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 374, Column: 10}, []interface{}{_env, _c, mml.NewList("from", "to")})
+			case "switch-case":
+//line types.mml:376:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 376, Column: 10}, []interface{}{_env, _c, mml.NewList("expression", "body")})
+			case "send":
+//line types.mml:378:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 378, Column: 10}, []interface{}{_env, _c, mml.NewList("channel", "value")})
+			case "receive":
+//line types.mml:380:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 380, Column: 10}, []interface{}{_env, _c, mml.NewList("channel")})
+			case "go":
+//line types.mml:382:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 382, Column: 10}, []interface{}{_env, _c, mml.NewList("application")})
+			case "defer":
+//line types.mml:384:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 384, Column: 10}, []interface{}{_env, _c, mml.NewList("application")})
+			case "ret":
+//line types.mml:386:3
+				return _inferFields.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 386, Column: 10}, []interface{}{_env, _c, mml.NewList("value")})
+			default:
+//line types.mml:388:3
+				return func() interface{} {
+					if _has.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 388, Column: 10}, []interface{}{"statements", _c}).(bool) {
+						return _statementList.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 388, Column: 33}, []interface{}{_env, _c})
+					}
+					return _c
+				}()
+			}
+			return nil
+		}, FixedArgs: 2}
+//line types.mml:393:1
+		_annotate = &mml.Function{F: func(a []interface{}) interface{} {
+			var _module = a[0]
+//line types.mml:393:28
+			return _infer.(*mml.Function).CallAt(mml.Pos{Path: "types.mml", Line: 393, Column: 28}, []interface{}{mml.NewStruct(nil), _module})
+		}, FixedArgs: 1}
+		exports.Set("annotate", _annotate)
+//line main.go:6203
 		return exports
 	})
-
 }
-
-func main() {
-	mml.Modules.Use("main.mml")
+func init() {
+	mml.Modules.Set("os.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _goMkdir interface{}
+		var _goTempDir interface{}
+		var _goRemove interface{}
+		var _goAbs interface{}
+		var _goRun interface{}
+		var _goRuntime interface{}
+		var _goExit interface{}
+		var _mkdir interface{}
+		var _tempDir interface{}
+		var _remove interface{}
+		var _abs interface{}
+		var _run interface{}
+		var _runtime interface{}
+		var _exit interface{}
+//line os.mml:4:1
+		_goMkdir = mml.Interop(mml.Pos{Path: "os.mml", Line: 5, Column: 12}, "github.com/aryszka/mml/os.Mkdir", __interop_github_46_com_47_aryszka_47_mml_47_os.Mkdir)
+		_goTempDir = mml.Interop(mml.Pos{Path: "os.mml", Line: 6, Column: 12}, "github.com/aryszka/mml/os.TempDir", __interop_github_46_com_47_aryszka_47_mml_47_os.TempDir)
+		_goRemove = mml.Interop(mml.Pos{Path: "os.mml", Line: 7, Column: 12}, "github.com/aryszka/mml/os.Remove", __interop_github_46_com_47_aryszka_47_mml_47_os.Remove)
+		_goAbs = mml.Interop(mml.Pos{Path: "os.mml", Line: 8, Column: 12}, "github.com/aryszka/mml/os.Abs", __interop_github_46_com_47_aryszka_47_mml_47_os.Abs)
+		_goRun = mml.Interop(mml.Pos{Path: "os.mml", Line: 9, Column: 12}, "github.com/aryszka/mml/os.Run", __interop_github_46_com_47_aryszka_47_mml_47_os.Run)
+		_goRuntime = mml.Interop(mml.Pos{Path: "os.mml", Line: 10, Column: 12}, "github.com/aryszka/mml/os.Runtime", __interop_github_46_com_47_aryszka_47_mml_47_os.Runtime)
+		_goExit = mml.Interop(mml.Pos{Path: "os.mml", Line: 11, Column: 12}, "github.com/aryszka/mml/os.Exit", __interop_github_46_com_47_aryszka_47_mml_47_os.Exit)
+//line os.mml:14:1
+		_mkdir = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+//line os.mml:15:15
+			return _goMkdir.(*mml.Function).CallAt(mml.Pos{Path: "os.mml", Line: 15, Column: 15}, []interface{}{_path})
+		}, FixedArgs: 1}
+		exports.Set("mkdir", _mkdir)
+		_tempDir = &mml.Function{F: func(a []interface{}) interface{} {
+//line os.mml:16:15
+			return _goTempDir.(*mml.Function).CallAt(mml.Pos{Path: "os.mml", Line: 16, Column: 15}, []interface{}{})
+		}, FixedArgs: 0}
+		exports.Set("tempDir", _tempDir)
+		_remove = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+//line os.mml:17:15
+			return _goRemove.(*mml.Function).CallAt(mml.Pos{Path: "os.mml", Line: 17, Column: 15}, []interface{}{_path})
+		}, FixedArgs: 1}
+		exports.Set("remove", _remove)
+		_abs = &mml.Function{F: func(a []interface{}) interface{} {
+			var _path = a[0]
+//line os.mml:18:15
+			return _goAbs.(*mml.Function).CallAt(mml.Pos{Path: "os.mml", Line: 18, Column: 15}, []interface{}{_path})
+		}, FixedArgs: 1}
+		exports.Set("abs", _abs)
+		_run = &mml.Function{F: func(a []interface{}) interface{} {
+			var _command = a[0]
+//line os.mml:19:15
+			return _goRun.(*mml.Function).CallAt(mml.Pos{Path: "os.mml", Line: 19, Column: 15}, []interface{}{_command})
+		}, FixedArgs: 1}
+		exports.Set("run", _run)
+		_runtime = &mml.Function{F: func(a []interface{}) interface{} {
+//line os.mml:20:15
+			return _goRuntime.(*mml.Function).CallAt(mml.Pos{Path: "os.mml", Line: 20, Column: 15}, []interface{}{})
+		}, FixedArgs: 0}
+		exports.Set("runtime", _runtime)
+		_exit = &mml.Function{F: func(a []interface{}) interface{} {
+			var _status = a[0]
+//line os.mml:21:15
+			return _goExit.(*mml.Function).CallAt(mml.Pos{Path: "os.mml", Line: 21, Column: 15}, []interface{}{_status})
+		}, FixedArgs: 1}
+		exports.Set("exit", _exit)
+//line main.go:6273
+		return exports
+	})
+}
+func init() {
+	mml.Modules.Set("interpret.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _goRun interface{}
+		var _goNewSession interface{}
+		var _goEval interface{}
+		var _run interface{}
+		var _newSession interface{}
+		var _eval interface{}
+//line interpret.mml:3:1
+		_goRun = mml.Interop(mml.Pos{Path: "interpret.mml", Line: 4, Column: 15}, "github.com/aryszka/mml/interpret.Run", __interop_github_46_com_47_aryszka_47_mml_47_interpret.Run)
+		_goNewSession = mml.Interop(mml.Pos{Path: "interpret.mml", Line: 5, Column: 15}, "github.com/aryszka/mml/interpret.NewSession", __interop_github_46_com_47_aryszka_47_mml_47_interpret.NewSession)
+		_goEval = mml.Interop(mml.Pos{Path: "interpret.mml", Line: 6, Column: 15}, "github.com/aryszka/mml/interpret.Eval", __interop_github_46_com_47_aryszka_47_mml_47_interpret.Eval)
+//line interpret.mml:10:1
+		_run = &mml.Function{F: func(a []interface{}) interface{} {
+			var _modules = a[0]
+			var _mainPath = a[1]
+			var _args = a[2]
+//line interpret.mml:10:41
+			return _goRun.(*mml.Function).CallAt(mml.Pos{Path: "interpret.mml", Line: 10, Column: 41}, []interface{}{_modules, _mainPath, _args})
+		}, FixedArgs: 3}
+		exports.Set("run", _run)
+//line interpret.mml:13:1
+		_newSession = &mml.Function{F: func(a []interface{}) interface{} {
+			var _args = a[0]
+//line interpret.mml:14:31
+			return _goNewSession.(*mml.Function).CallAt(mml.Pos{Path: "interpret.mml", Line: 14, Column: 31}, []interface{}{_args})
+		}, FixedArgs: 1}
+		exports.Set("newSession", _newSession)
+		_eval = &mml.Function{F: func(a []interface{}) interface{} {
+			var _session = a[0]
+			var _modules = a[1]
+			var _code = a[2]
+//line interpret.mml:15:31
+			return _goEval.(*mml.Function).CallAt(mml.Pos{Path: "interpret.mml", Line: 15, Column: 31}, []interface{}{_session, _modules, _code})
+		}, FixedArgs: 3}
+		exports.Set("eval", _eval)
+//line main.go:6314
+		return exports
+	})
+}
+func init() {
+	mml.Modules.Set("repl.mml", func(loader *mml.Loader) *mml.Struct {
+		exports := mml.NewStruct(nil)
+		var _path string
+		var _isDelete interface{}
+		var _deleteDefinitions interface{}
+		var _readInput interface{}
+		var _evalStatement interface{}
+		var _evalInput interface{}
+		var _run interface{}
+		var _code interface{}
+		var _parse interface{}
+		var _definitions interface{}
+		var _interpret interface{}
+		var _formats interface{}
+		var _log interface{}
+		var _passErr interface{}
+		var __lang = loader.Use("lang.mml")
+		_formats = mml.Ref(mml.Pos{Path: "repl.mml", Line: 6, Column: 2}, __lang, "formats")
+		_log = mml.Ref(mml.Pos{Path: "repl.mml", Line: 6, Column: 2}, __lang, "log")
+		_passErr = mml.Ref(mml.Pos{Path: "repl.mml", Line: 6, Column: 2}, __lang, "passErr")
+		_code = loader.Use("code.mml")
+		_parse = loader.Use("parse.mml")
+		_definitions = loader.Use("definitions.mml")
+		_interpret = loader.Use("interpret.mml")
+//line repl.mml:13:1
+		_path = "repl"
+//line repl.mml:15:1
+		_isDelete = &mml.Function{F: func(a []interface{}) interface{} {
+			var _s = a[0]
+//line repl.mml:16:2
+			return ((((_has.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 16, Column: 2}, []interface{}{"type", _s}).(bool) && mml.BinaryOp(mml.Pos{Path: "repl.mml", Line: 17, Column: 2}, 11, mml.Ref(mml.Pos{Path: "repl.mml", Line: 17, Column: 2}, _s, "type"), "function-application").(bool)) && _has.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 18, Column: 2}, []interface{}{"type", mml.Ref(mml.Pos{Path: "repl.mml", Line: 18, Column: 14}, _s, "function")}).(bool)) && mml.BinaryOp(mml.Pos{Path: "repl.mml", Line: 19, Column: 2}, 11, mml.Ref(mml.Pos{Path: "repl.mml", Line: 19, Column: 2}, mml.Ref(mml.Pos{}, _s, "function"), "type"), "symbol").(bool)) && mml.BinaryOp(mml.Pos{Path: "repl.mml", Line: 20, Column: 2}, 11, mml.Ref(mml.Pos{Path: "repl.mml", Line: 20, Column: 2}, mml.Ref(mml.Pos{}, _s, "function"), "name"), "delete").(bool))
+		}, FixedArgs: 1}
+//line repl.mml:22:1
+		_deleteDefinitions = &mml.Function{F: func(a []interface{}) interface{} {
+			var _context = a[0]
+			var _d = a[1]
+//line repl.mml:23:2
+			for __iter := mml.Iterate(mml.Pos{Path: "repl.mml", Line: 23, Column: 6}, mml.Ref(mml.Pos{Path: "repl.mml", Line: 23, Column: 11}, _d, "args")); __iter.Next(); {
+				_a := __iter.Value()
+//line repl.mml:24:3
+				switch {
+				case (!_has.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 25, Column: 9}, []interface{}{"type", _a}).(bool) || mml.BinaryOp(mml.Pos{Path: "repl.mml", Line: 25, Column: 27}, 12, mml.Ref(mml.Pos{Path: "repl.mml", Line: 25, Column: 27}, _a, "type"), "symbol").(bool)):
+//line repl.mml:26:4
+					return _error.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 26, Column: 11}, []interface{}{"delete: expected symbols"})
+				case _has.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 27, Column: 8}, []interface{}{mml.Ref(mml.Pos{Path: "repl.mml", Line: 27, Column: 12}, _a, "name"), mml.Ref(mml.Pos{Path: "repl.mml", Line: 27, Column: 20}, _code, "builtin")}):
+//line repl.mml:28:4
+					return _error.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 28, Column: 11}, []interface{}{_formats.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 28, Column: 17}, []interface{}{"delete: cannot delete a built-in: %s", mml.Ref(mml.Pos{Path: "repl.mml", Line: 28, Column: 65}, _a, "name")})})
+				case !mml.Ref(mml.Pos{Path: "repl.mml", Line: 29, Column: 9}, _definitions, "isDefined").(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 29, Column: 9}, []interface{}{_context, mml.Ref(mml.Pos{Path: "repl.mml", Line: 29, Column: 40}, _a, "name")}).(bool):
+//line repl.mml:30:4
+					return _error.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 30, Column: 11}, []interface{}{_formats.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 30, Column: 17}, []interface{}{"delete: undefined: %s", mml.Ref(mml.Pos{Path: "repl.mml", Line: 30, Column: 50}, _a, "name")})})
+				}
+			}
+//line repl.mml:34:2
+			for __iter := mml.Iterate(mml.Pos{Path: "repl.mml", Line: 34, Column: 6}, mml.Ref(mml.Pos{Path: "repl.mml", Line: 34, Column: 11}, _d, "args")); __iter.Next(); {
+				_a := __iter.Value()
+//line repl.mml:35:3
+				mml.Ref(mml.Pos{Path: "repl.mml", Line: 35, Column: 3}, _definitions, "undefine").(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 35, Column: 3}, []interface{}{_context, mml.Ref(mml.Pos{Path: "repl.mml", Line: 35, Column: 33}, _a, "name")})
+			}
+			return nil
+		}, FixedArgs: 2}
+//line repl.mml:40:1
+		_readInput = &mml.Function{F: func(a []interface{}) interface{} {
+			var _text interface{}
+//line repl.mml:41:2
+			_stdout.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 41, Column: 2}, []interface{}{"> "})
+//line repl.mml:42:2
+			_text = ""
+//line repl.mml:43:2
+			for {
+				var _line interface{}
+				var _ast interface{}
+//line repl.mml:44:3
+				_line = _readLine.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 44, Column: 12}, []interface{}{_stdin})
+//line repl.mml:45:3
+				if _isError.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 45, Column: 6}, []interface{}{_line}).(bool) {
+//line repl.mml:46:4
+					return func() interface{} {
+						if mml.BinaryOp(mml.Pos{Path: "repl.mml", Line: 46, Column: 11}, 11, _text, "").(bool) {
+							return _line
+						}
+						return _text
+					}()
+				}
+//line repl.mml:49:3
+				_text = mml.BinaryOp(mml.Pos{Path: "repl.mml", Line: 49, Column: 10}, 9, mml.BinaryOp(mml.Pos{Path: "repl.mml", Line: 49, Column: 10}, 9, _text, _line), "\n")
+//line repl.mml:50:3
+				_ast = _parseAST.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 50, Column: 11}, []interface{}{_text})
+//line repl.mml:51:3
+				if ((!_isError.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 51, Column: 7}, []interface{}{_ast}).(bool) || !_has.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 51, Column: 24}, []interface{}{"data", _ast}).(bool)) || !mml.Ref(mml.Pos{Path: "repl.mml", Line: 51, Column: 45}, mml.Ref(mml.Pos{}, _ast, "data"), "incomplete").(bool)) {
+//line repl.mml:52:4
+					return _text
+				}
+//line repl.mml:55:3
+				_stdout.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 55, Column: 3}, []interface{}{". "})
+			}
+			return nil
+		}, FixedArgs: 0}
+//line repl.mml:60:1
+		_evalStatement = &mml.Function{F: func(a []interface{}) interface{} {
+			var _session = a[0]
+			var _modules = a[1]
+			var _s = a[2]
+			var _errors interface{}
+			var _result interface{}
+//line repl.mml:61:2
+			if _isDelete.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 61, Column: 5}, []interface{}{_s}).(bool) {
+				var _deleted interface{}
+//line repl.mml:62:3
+				_deleted = _deleteDefinitions.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 62, Column: 15}, []interface{}{mml.Ref(mml.Pos{Path: "repl.mml", Line: 62, Column: 33}, _session, "definitions"), _s})
+//line repl.mml:63:3
+				return func() interface{} {
+					if _isError.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 63, Column: 10}, []interface{}{_deleted}).(bool) {
+						return mml.NewList(_deleted)
+					}
+					return mml.NewList()
+				}()
+			}
+//line repl.mml:66:2
+			_errors = mml.Ref(mml.Pos{Path: "repl.mml", Line: 66, Column: 13}, _definitions, "validateLine").(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 66, Column: 13}, []interface{}{mml.Ref(mml.Pos{Path: "repl.mml", Line: 66, Column: 38}, _session, "definitions"), mml.NewStruct(nil).With("type", "statement-list").With("statements", mml.NewList(_s))})
+//line repl.mml:67:2
+			if (_len.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 67, Column: 5}, []interface{}{_errors}).(int) > 0) {
+//line repl.mml:68:3
+				return _errors
+			}
+//line repl.mml:71:2
+			_result = mml.Ref(mml.Pos{Path: "repl.mml", Line: 71, Column: 13}, _interpret, "eval").(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 71, Column: 13}, []interface{}{mml.Ref(mml.Pos{Path: "repl.mml", Line: 71, Column: 28}, _session, "interpreter"), _modules, _s})
+//line repl.mml:72:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 72, Column: 5}, []interface{}{_result}).(bool) {
+//line repl.mml:73:3
+				return mml.NewList(_result)
+			}
+//line repl.mml:76:2
+			if _has.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 76, Column: 5}, []interface{}{"value", _result}).(bool) {
+//line repl.mml:77:3
+				_stdout.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 77, Column: 3}, []interface{}{_formats.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 77, Column: 10}, []interface{}{"%m\n", mml.Ref(mml.Pos{Path: "repl.mml", Line: 77, Column: 26}, _result, "value")})})
+			}
+//line repl.mml:80:2
+			return mml.NewList()
+		}, FixedArgs: 3}
+//line repl.mml:84:1
+		_evalInput = &mml.Function{F: func(a []interface{}) interface{} {
+			var _session = a[0]
+			var _text = a[1]
+			var _modules interface{}
+//line repl.mml:85:2
+			_modules = _passErr.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 85, Column: 34}, []interface{}{mml.Ref(mml.Pos{Path: "repl.mml", Line: 85, Column: 42}, _parse, "input").(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 85, Column: 42}, []interface{}{mml.Ref(mml.Pos{Path: "repl.mml", Line: 85, Column: 54}, _session, "parse"), _path})}).(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 85, Column: 14}, []interface{}{_parseAST.(*mml.Function).CallAt(mml.Pos{}, []interface{}{_text})})
+//line repl.mml:86:2
+			if _isError.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 86, Column: 5}, []interface{}{_modules}).(bool) {
+//line repl.mml:87:3
+				return mml.NewList(_modules)
+			}
+//line repl.mml:90:2
+			for __iter := mml.Iterate(mml.Pos{Path: "repl.mml", Line: 90, Column: 6}, mml.Ref(mml.Pos{Path: "repl.mml", Line: 90, Column: 11}, mml.Ref(mml.Pos{}, _modules, 0), "statements")); __iter.Next(); {
+				_s := __iter.Value()
+				var _errors interface{}
+//line repl.mml:91:3
+				_errors = _evalStatement.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 91, Column: 14}, []interface{}{_session, mml.RefRange(mml.Pos{Path: "repl.mml", Line: 91, Column: 37}, _modules, 1, nil), _s})
+//line repl.mml:92:3
+				if (_len.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 92, Column: 6}, []interface{}{_errors}).(int) > 0) {
+//line repl.mml:93:4
+					return _errors
+				}
+			}
+//line repl.mml:97:2
+			return mml.NewList()
+		}, FixedArgs: 2}
+//line repl.mml:101:1
+		_run = &mml.Function{F: func(a []interface{}) interface{} {
+			var _args = a[0]
+			var _session interface{}
+			var _text interface{}
+//line repl.mml:102:2
+			_session = mml.NewStruct(nil).With("parse", mml.Ref(mml.Pos{Path: "repl.mml", Line: 103, Column: 16}, _parse, "newContext").(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 103, Column: 16}, []interface{}{})).With("definitions", mml.Ref(mml.Pos{Path: "repl.mml", Line: 104, Column: 16}, _definitions, "topLevel").(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 104, Column: 16}, []interface{}{})).With("interpreter", mml.Ref(mml.Pos{Path: "repl.mml", Line: 105, Column: 16}, _interpret, "newSession").(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 105, Column: 16}, []interface{}{_args}))
+//line repl.mml:108:2
+			_text = _readInput.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 108, Column: 13}, []interface{}{})
+//line repl.mml:109:2
+			for !_isError.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 109, Column: 7}, []interface{}{_text}).(bool) {
+//line repl.mml:110:3
+				for __iter := mml.Iterate(mml.Pos{Path: "repl.mml", Line: 110, Column: 7}, _evalInput.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 110, Column: 12}, []interface{}{_session, _text})); __iter.Next(); {
+					_e := __iter.Value()
+//line repl.mml:111:4
+					_log.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 111, Column: 4}, []interface{}{_e})
+				}
+//line repl.mml:114:3
+				_text = _readInput.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 114, Column: 10}, []interface{}{})
+			}
+//line repl.mml:117:2
+			if mml.BinaryOp(mml.Pos{Path: "repl.mml", Line: 117, Column: 5}, 12, _text, _eof).(bool) {
+//line repl.mml:118:3
+				return _text
+			}
+//line repl.mml:121:2
+			_stdout.(*mml.Function).CallAt(mml.Pos{Path: "repl.mml", Line: 121, Column: 2}, []interface{}{"\n"})
+			return nil
+		}, FixedArgs: 1}
+		exports.Set("run", _run)
+//line main.go:6515
+		return exports
+	})
 }
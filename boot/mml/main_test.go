@@ -0,0 +1,32 @@
+package main
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestScriptOutsideItsDirectory(t *testing.T) {
+	dir := t.TempDir()
+	mml := filepath.Join(dir, "mml")
+	if out, err := exec.Command("go", "build", "-o", mml, ".").CombinedOutput(); err != nil {
+		t.Fatalf("failed to build mml: %v\n%s", err, out)
+	}
+
+	script, err := filepath.Abs("testdata/script.mml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the used modules are found relative to the script, not to the working directory
+	cmd := exec.Command(mml, script, "world")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("failed to run the script: %v\n%s", err, out)
+	}
+
+	if string(out) != "hello, world!\n" {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
@@ -2,14 +2,14 @@ package mml
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"unicode/utf8"
 
 	"github.com/aryszka/mml/parser"
 )
@@ -28,6 +28,13 @@ type unary struct {
 	arg interface{}
 }
 
+var unaryOperators = []struct{ name, symbol string }{
+	{"binary-not", "^"},
+	{"plus", "+"},
+	{"minus", "-"},
+	{"logical-not", "!"},
+}
+
 type binaryOperator int
 
 const (
@@ -52,31 +59,242 @@ const (
 	logicalOr
 )
 
-type List struct {
-	Values []interface{}
+var binaryOperators = []struct{ name, symbol string }{
+	{"binary-and", "&"},
+	{"binary-or", "|"},
+	{"xor", "^"},
+	{"and-not", "&^"},
+	{"lshift", "<<"},
+	{"rshift", ">>"},
+	{"mul", "*"},
+	{"div", "/"},
+	{"mod", "%"},
+	{"add", "+"},
+	{"sub", "-"},
+	{"eq", "=="},
+	{"not-eq", "!="},
+	{"less", "<"},
+	{"less-or-eq", "<="},
+	{"greater", ">"},
+	{"greater-or-eq", ">="},
+	{"logical-and", "&&"},
+	{"logical-or", "||"},
+}
+
+// Pos is the position of an operation in the mml source. Line and Column start from 1, the zero value means
+// unknown position.
+type Pos struct {
+	Path   string
+	Line   int
+	Column int
+}
+
+// RuntimeError is the value of the panics caused by invalid operations in the compiled code.
+type RuntimeError struct {
+	Pos     Pos
+	Message string
+}
+
+type Channel struct {
+	C chan interface{}
 }
 
-type Struct struct {
-	Values map[string]interface{}
+// Iterator steps over the items of a list, the fields of a structure, the runes of a string or the messages
+// received from a channel.
+// It is used by the compiled for..in loops.
+type Iterator struct {
+	next       func() (interface{}, interface{}, bool)
+	key, value interface{}
 }
 
 type Function struct {
 	F         func([]interface{}) interface{}
 	FixedArgs int
 	args      []interface{}
+	stream    *Stream
+}
+
+// Type is the type of a value passed between mml and Go functions.
+type Type int
+
+const (
+	AnyType Type = iota
+	NilType
+	BoolType
+	IntType
+	FloatType
+	StringType
+	ListType
+	StructType
+	FunctionType
+	ChannelType
+	ErrorType
+	StreamType
+)
+
+// FunctionSignature declares the parameter types and the return type of a Go function called from mml. When
+// Variadic is true, the arguments after the fixed parameters are collected, and they need to be of the Collect
+// type.
+type FunctionSignature struct {
+	Params   []Type
+	Variadic bool
+	Collect  Type
+	Returns  Type
+}
+
+// Signature returns the signature of a Go function with fixed parameters only.
+func Signature(returns Type, params ...Type) FunctionSignature {
+	return FunctionSignature{Params: params, Returns: returns}
+}
+
+// GoFunction is a Go function that can be called from mml via interop.use. It receives the fixed arguments and
+// the collected arguments separately.
+type GoFunction struct {
+	Signature FunctionSignature
+	F         func(args []interface{}, collectArg []interface{}) interface{}
 }
 
 type ModuleContext struct {
 	lock         sync.Mutex
 	moduleLocks  map[string]*sync.Mutex
-	initializers map[string]func() map[string]interface{}
-	cache        map[string]map[string]interface{}
+	initializers map[string]func(*Loader) *Struct
+	cache        map[string]*Struct
+	errors       map[string]error
+}
+
+// Loader is passed to the module initializers, and it is used to access the modules that a module depends on.
+// It knows the chain of the modules being initialized, in order to detect the init cycles.
+type Loader struct {
+	context   *ModuleContext
+	chain     []string
+	propagate bool
+}
+
+// ModuleError is returned when a module cannot be initialized. Once a module failed, every later attempt to
+// use it returns the same error. When the initialization panicked, Value holds the original panic value.
+type ModuleError struct {
+	Path    string
+	Message string
+	Value   interface{}
+}
+
+// Modules holds the modules of the compiled program.
+var Modules = NewModuleContext()
+
+func (p Pos) String() string {
+	switch {
+	case p.Line == 0:
+		return p.Path
+	case p.Column == 0:
+		return fmt.Sprintf("%s:%d", p.Path, p.Line)
+	default:
+		return fmt.Sprintf("%s:%d:%d", p.Path, p.Line, p.Column)
+	}
+}
+
+func (e *ModuleError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// Unwrap returns the panic value of a failed initialization when it is an error.
+func (e *ModuleError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// the modules using a module that failed earlier see its original panic value, while the Go code loading the
+// modules gets the wrapped error
+func usePanic(err error) {
+	if me, ok := err.(*ModuleError); ok && me.Value != nil {
+		panic(me.Value)
+	}
+
+	panic(err)
+}
+
+func (e *RuntimeError) Error() string {
+	if e.Pos == (Pos{}) {
+		return e.Message
+	}
+
+	return e.Pos.String() + ": " + e.Message
+}
+
+func runtimeError(p Pos, format string, args ...interface{}) *RuntimeError {
+	return &RuntimeError{Pos: p, Message: fmt.Sprintf(format, args...)}
+}
+
+// TypeName returns the name of the mml type of a value, as it appears in the error messages.
+func TypeName(v interface{}) string {
+	switch vt := v.(type) {
+	case nil:
+		return "nil"
+	case bool:
+		return "bool"
+	case int:
+		return "int"
+	case float64:
+		return "float"
+	case string:
+		return "string"
+	case *List:
+		return "list"
+	case *Struct:
+		return "struct"
+	case *Function:
+		if vt.stream != nil {
+			return "stream"
+		}
+
+		return "function"
+	case *Channel:
+		return "channel"
+	case error:
+		return "error"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func indexError(p Pos, op string, v, k interface{}) *RuntimeError {
+	if err, ok := v.(error); ok {
+		return runtimeError(p, "%s: unsupported code: %s[%s]: %v", op, TypeName(v), TypeName(k), err)
+	}
+
+	return runtimeError(p, "%s: unsupported code: %s[%s]", op, TypeName(v), TypeName(k))
 }
 
-var Modules = &ModuleContext{
-	moduleLocks:  make(map[string]*sync.Mutex),
-	initializers: make(map[string]func() map[string]interface{}),
-	cache:        make(map[string]map[string]interface{}),
+func checkIndex(p Pos, op string, i, length int) {
+	if i < 0 || i >= length {
+		panic(runtimeError(p, "%s: index out of range: %d, length: %d", op, i, length))
+	}
+}
+
+func checkRange(p Pos, from, to interface{}, length int) (int, int) {
+	f, t := 0, length
+	if from != nil {
+		fi, ok := from.(int)
+		if !ok {
+			panic(runtimeError(p, "ref range: unsupported code: from: %s", TypeName(from)))
+		}
+
+		f = fi
+	}
+
+	if to != nil {
+		ti, ok := to.(int)
+		if !ok {
+			panic(runtimeError(p, "ref range: unsupported code: to: %s", TypeName(to)))
+		}
+
+		t = ti
+	}
+
+	if f < 0 || t > length || f > t {
+		panic(runtimeError(p, "ref range: out of range: [%d:%d], length: %d", f, t, length))
+	}
+
+	return f, t
 }
 
 func (f *Function) Bind(a []interface{}) *Function {
@@ -94,111 +312,256 @@ func (f *Function) Call(a []interface{}) interface{} {
 	return f.F(a)
 }
 
-func (c *ModuleContext) Set(path string, i func() map[string]interface{}) {
+// CallAt calls the function like Call. When the function returns an error value without a position, it
+// returns a copy of it with the position of the application, this way the errors record where the mml code
+// created them, while the error values shared by the functions stay unchanged.
+func (f *Function) CallAt(p Pos, a []interface{}) interface{} {
+	v := f.Call(a)
+	if e, ok := v.(*ErrorValue); ok && e.Pos == (Pos{}) {
+		c := *e
+		c.Pos = p
+		return &c
+	}
+
+	return v
+}
+
+// NewModuleContext creates an empty set of modules, e.g. for the modules of an interpreted program.
+func NewModuleContext() *ModuleContext {
+	return &ModuleContext{
+		moduleLocks:  make(map[string]*sync.Mutex),
+		initializers: make(map[string]func(*Loader) *Struct),
+		cache:        make(map[string]*Struct),
+		errors:       make(map[string]error),
+	}
+}
+
+func (c *ModuleContext) Set(path string, i func(*Loader) *Struct) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
 	c.initializers[path] = i
 }
 
-func (c *ModuleContext) Use(path string) *Struct {
+func (c *ModuleContext) loaded(path string) (*Struct, bool, error) {
 	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err, ok := c.errors[path]; ok {
+		return nil, true, err
+	}
+
 	m, ok := c.cache[path]
-	if ok {
-		c.lock.Unlock()
-		return &Struct{m}
+	return m, ok, nil
+}
+
+func (c *ModuleContext) moduleLock(path string) (*sync.Mutex, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.initializers[path]; !ok {
+		return nil, false
 	}
 
-	init := c.initializers[path]
 	ml, ok := c.moduleLocks[path]
 	if !ok {
 		ml = &sync.Mutex{}
 		c.moduleLocks[path] = ml
 	}
 
-	ml.Lock()
-	c.lock.Unlock()
+	return ml, true
+}
+
+func (c *ModuleContext) done(path string, m *Struct, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err != nil {
+		c.errors[path] = err
+	} else {
+		c.cache[path] = m
+	}
+}
 
-	m = init()
+// when the module is used by the compiled code, the failure is recorded and the original panic continues from
+// the deferred function, keeping the stack of the failing code
+func (c *ModuleContext) initialize(chain []string, path string, propagate bool) (m *Struct, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		if e, ok := r.(*ModuleError); ok {
+			err = e
+		} else {
+			err = &ModuleError{Path: path, Message: fmt.Sprintf("initialization failed: %v", r), Value: r}
+		}
+
+		if propagate {
+			c.done(path, nil, err)
+			panic(r)
+		}
+	}()
 
 	c.lock.Lock()
-	c.cache[path] = m
+	init := c.initializers[path]
 	c.lock.Unlock()
-	ml.Unlock()
 
-	return &Struct{m}
+	chain = append(chain[:len(chain):len(chain)], path)
+	return init(&Loader{context: c, chain: chain, propagate: propagate}), nil
+}
+
+func (c *ModuleContext) load(chain []string, path string, propagate bool) (*Struct, error) {
+	for i, p := range chain {
+		if p == path {
+			return nil, &ModuleError{
+				Path:    path,
+				Message: "init cycle: " + strings.Join(append(chain[i:], path), " -> "),
+			}
+		}
+	}
+
+	if m, ok, err := c.loaded(path); ok {
+		return m, err
+	}
+
+	ml, ok := c.moduleLock(path)
+	if !ok {
+		return nil, &ModuleError{Path: path, Message: "module not found"}
+	}
+
+	ml.Lock()
+	defer ml.Unlock()
+
+	// the module may have been initialized while waiting for the lock:
+	if m, ok, err := c.loaded(path); ok {
+		return m, err
+	}
+
+	m, err := c.initialize(chain, path, propagate)
+	c.done(path, m, err)
+	return m, err
+}
+
+// Load returns the exports of a module, initializing it when it was not used before. It returns an error when
+// the module doesn't exist, or its initialization failed.
+func (c *ModuleContext) Load(path string) (*Struct, error) {
+	return c.load(nil, path, false)
 }
 
-func Ref(v, k interface{}) interface{} {
+// Use returns the exports of a module, like Load, but it panics on failure. When the initialization panics,
+// the panic continues with the original value.
+func (c *ModuleContext) Use(path string) *Struct {
+	m, err := c.load(nil, path, true)
+	if err != nil {
+		usePanic(err)
+	}
+
+	return m
+}
+
+// Use returns the exports of a module used by the module being initialized. It panics when the module cannot be
+// initialized, including when it is already being initialized in the current chain. When the initialization
+// panics, the panic continues with the original value.
+func (l *Loader) Use(path string) *Struct {
+	m, err := l.context.load(l.chain, path, l.propagate)
+	if err != nil {
+		usePanic(err)
+	}
+
+	return m
+}
+
+func Ref(p Pos, v, k interface{}) interface{} {
 	switch vt := v.(type) {
 	case string:
-		return string(vt[k.(int)])
+		i, ok := k.(int)
+		if !ok {
+			panic(indexError(p, "ref", v, k))
+		}
+
+		checkIndex(p, "ref", i, len(vt))
+		return string(vt[i])
 	case *List:
-		return vt.Values[k.(int)]
+		i, ok := k.(int)
+		if !ok {
+			panic(indexError(p, "ref", v, k))
+		}
+
+		checkIndex(p, "ref", i, vt.Len())
+		return vt.Get(i)
 	case *Struct:
-		ret := vt.Values[k.(string)]
+		key, ok := k.(string)
+		if !ok {
+			panic(indexError(p, "ref", v, k))
+		}
+
+		ret, _ := vt.Get(key)
 		if ret == nil {
-			panic("ref: undefined key: " + k.(string))
+			panic(runtimeError(p, "ref: undefined key: %s", key))
 		}
 
 		return ret
-	default:
-		// TMP:
-		if err, ok := v.(error); ok {
-			println("ref failed", err.Error())
+	case error:
+		if _, ok := k.(string); !ok {
+			panic(indexError(p, "ref", v, k))
 		}
 
-		panic(fmt.Sprintf("ref: unsupported code: %v: %v", k, v))
+		return Ref(p, errorFields(vt), k)
+	default:
+		panic(indexError(p, "ref", v, k))
 	}
 }
 
-func RefRange(v, from, to interface{}) interface{} {
+func RefRange(p Pos, v, from, to interface{}) interface{} {
 	switch vt := v.(type) {
 	case string:
-		switch {
-		case from == nil && to == nil:
-			return vt[:]
-		case from == nil:
-			return vt[:to.(int)]
-		case to == nil:
-			return vt[from.(int):]
-		default:
-			return vt[from.(int):to.(int)]
-		}
+		f, t := checkRange(p, from, to, len(vt))
+		return vt[f:t]
 	case *List:
-		switch {
-		case from == nil && to == nil:
-			return &List{vt.Values[:]}
-		case from == nil:
-			return &List{vt.Values[:to.(int)]}
-		case to == nil:
-			return &List{vt.Values[from.(int):]}
-		default:
-			return &List{vt.Values[from.(int):to.(int)]}
-		}
+		f, t := checkRange(p, from, to, vt.Len())
+		return vt.Slice(f, t)
 	default:
-		panic("ref range: unsupported code")
+		panic(runtimeError(p, "ref range: unsupported code: %s", TypeName(v)))
 	}
 }
 
-func SetRef(e, k, v interface{}) interface{} {
+func SetRef(p Pos, e, k, v interface{}) interface{} {
 	switch et := e.(type) {
 	case *List:
-		et.Values[k.(int)] = v
+		i, ok := k.(int)
+		if !ok {
+			panic(indexError(p, "set-ref", e, k))
+		}
+
+		checkIndex(p, "set-ref", i, et.Len())
+		et.Set(i, v)
 	case *Struct:
-		et.Values[k.(string)] = v
+		key, ok := k.(string)
+		if !ok {
+			panic(indexError(p, "set-ref", e, k))
+		}
+
+		et.Set(key, v)
 	default:
-		panic("set-ref: unsupported code")
+		panic(indexError(p, "set-ref", e, k))
 	}
 
 	return nil
 }
 
-func UnaryOp(op int, arg interface{}) interface{} {
+func unaryError(p Pos, op unaryOperator, arg interface{}) *RuntimeError {
+	if int(op) >= len(unaryOperators) {
+		return runtimeError(p, "unary: unsupported code")
+	}
+
+	o := unaryOperators[op]
+	return runtimeError(p, "unary %s: %s%s", o.name, o.symbol, TypeName(arg))
+}
+
+func UnaryOp(p Pos, op int, arg interface{}) interface{} {
 	switch unaryOperator(op) {
 	case binaryNot:
 		switch at := arg.(type) {
 		case int:
-			return +at
-		default:
-			panic("unary: unsupported code")
+			return ^at
 		}
 	case plus:
 		switch at := arg.(type) {
@@ -206,8 +569,6 @@ func UnaryOp(op int, arg interface{}) interface{} {
 			return +at
 		case float64:
 			return +at
-		default:
-			panic("unary: unsupported code")
 		}
 	case minus:
 		switch at := arg.(type) {
@@ -215,57 +576,124 @@ func UnaryOp(op int, arg interface{}) interface{} {
 			return -at
 		case float64:
 			return -at
-		default:
-			panic("unary: unsupported code")
 		}
+	case logicalNot:
+		switch at := arg.(type) {
+		case bool:
+			return !at
+		}
+	}
+
+	panic(unaryError(p, unaryOperator(op), arg))
+}
+
+// equal compares lists and structures by their values, errors by their messages, and everything else, including
+// functions and channels, by identity.
+func equal(left, right interface{}) bool {
+	switch lt := left.(type) {
+	case *List:
+		rt, ok := right.(*List)
+		if !ok {
+			return false
+		}
+
+		if lt == rt {
+			return true
+		}
+
+		if lt.Len() != rt.Len() {
+			return false
+		}
+
+		for i := 0; i < lt.Len(); i++ {
+			if !equal(lt.Get(i), rt.Get(i)) {
+				return false
+			}
+		}
+
+		return true
+	case *Struct:
+		rt, ok := right.(*Struct)
+		if !ok {
+			return false
+		}
+
+		if lt == rt {
+			return true
+		}
+
+		if lt.Len() != rt.Len() {
+			return false
+		}
+
+		eq := true
+		lt.each(func(k string, v interface{}) {
+			if !eq {
+				return
+			}
+
+			rv, ok := rt.Get(k)
+			eq = ok && equal(v, rv)
+		})
+
+		return eq
+	case error:
+		rt, ok := right.(error)
+		return ok && equalErrors(lt, rt)
 	default:
-		panic("unary: unsupported code")
+		return left == right
 	}
 }
 
-func BinaryOp(op int, left, right interface{}) interface{} {
-	switch binaryOperator(op) {
+func binaryError(p Pos, op binaryOperator, left, right interface{}) *RuntimeError {
+	if int(op) >= len(binaryOperators) {
+		return runtimeError(p, "binary: unsupported code")
+	}
+
+	o := binaryOperators[op]
+	return runtimeError(p, "binary %s: %s %s %s", o.name, TypeName(left), o.symbol, TypeName(right))
+}
+
+func BinaryOp(p Pos, op int, left, right interface{}) interface{} {
+	bop := binaryOperator(op)
+	if bop != eq && bop != notEq && TypeName(left) != TypeName(right) {
+		panic(binaryError(p, bop, left, right))
+	}
+
+	switch bop {
 	case binaryAnd:
 		switch lt := left.(type) {
 		case int:
 			return lt & right.(int)
-		default:
-			panic("binary: unsupported code")
 		}
 	case binaryOr:
 		switch lt := left.(type) {
 		case int:
 			return lt | right.(int)
-		default:
-			panic("binary: unsupported code")
 		}
 	case xor:
 		switch lt := left.(type) {
 		case int:
 			return lt ^ right.(int)
-		default:
-			panic("binary: unsupported code")
 		}
 	case andNot:
 		switch lt := left.(type) {
 		case int:
 			return lt &^ right.(int)
-		default:
-			panic("binary: unsupported code")
 		}
 	case lshift:
 		switch lt := left.(type) {
 		case int:
-			return lt << right.(uint)
-		default:
-			panic("binary: unsupported code")
+			if right.(int) >= 0 {
+				return lt << uint(right.(int))
+			}
 		}
 	case rshift:
 		switch lt := left.(type) {
 		case int:
-			return lt >> right.(uint)
-		default:
-			panic("binary: unsupported code")
+			if right.(int) >= 0 {
+				return lt >> uint(right.(int))
+			}
 		}
 	case mul:
 		switch lt := left.(type) {
@@ -273,24 +701,26 @@ func BinaryOp(op int, left, right interface{}) interface{} {
 			return lt * right.(int)
 		case float64:
 			return lt * right.(float64)
-		default:
-			panic("binary: unsupported code")
 		}
 	case div:
 		switch lt := left.(type) {
 		case int:
+			if right.(int) == 0 {
+				panic(runtimeError(p, "binary div: division by zero"))
+			}
+
 			return lt / right.(int)
 		case float64:
 			return lt / right.(float64)
-		default:
-			panic("binary: unsupported code")
 		}
 	case mod:
 		switch lt := left.(type) {
 		case int:
+			if right.(int) == 0 {
+				panic(runtimeError(p, "binary mod: division by zero"))
+			}
+
 			return lt % right.(int)
-		default:
-			panic("binary: unsupported code")
 		}
 	case add:
 		switch lt := left.(type) {
@@ -300,8 +730,6 @@ func BinaryOp(op int, left, right interface{}) interface{} {
 			return lt + right.(float64)
 		case string:
 			return lt + right.(string)
-		default:
-			panic("binary: add: unsupported code")
 		}
 	case sub:
 		switch lt := left.(type) {
@@ -309,13 +737,11 @@ func BinaryOp(op int, left, right interface{}) interface{} {
 			return lt - right.(int)
 		case float64:
 			return lt - right.(float64)
-		default:
-			panic("binary: sub: unsupported code")
 		}
 	case eq:
-		return left == right
+		return equal(left, right)
 	case notEq:
-		return left != right
+		return !equal(left, right)
 	case less:
 		switch lt := left.(type) {
 		case int:
@@ -324,8 +750,6 @@ func BinaryOp(op int, left, right interface{}) interface{} {
 			return lt < right.(float64)
 		case string:
 			return lt < right.(string)
-		default:
-			panic("binary: less: unsupported code")
 		}
 	case lessOrEq:
 		switch lt := left.(type) {
@@ -335,8 +759,6 @@ func BinaryOp(op int, left, right interface{}) interface{} {
 			return lt <= right.(float64)
 		case string:
 			return lt <= right.(string)
-		default:
-			panic("binary: less-or-eq: unsupported code")
 		}
 	case greater:
 		switch lt := left.(type) {
@@ -346,8 +768,6 @@ func BinaryOp(op int, left, right interface{}) interface{} {
 			return lt > right.(float64)
 		case string:
 			return lt > right.(string)
-		default:
-			panic("binary: greater: unsupported code")
 		}
 	case greaterOrEq:
 		switch lt := left.(type) {
@@ -357,12 +777,20 @@ func BinaryOp(op int, left, right interface{}) interface{} {
 			return lt >= right.(float64)
 		case string:
 			return lt >= right.(string)
-		default:
-			panic("binary: greater-or-eq: unsupported code")
 		}
-	default:
-		panic("binary: unsupported code")
+	case logicalAnd:
+		switch lt := left.(type) {
+		case bool:
+			return lt && right.(bool)
+		}
+	case logicalOr:
+		switch lt := left.(type) {
+		case bool:
+			return lt || right.(bool)
+		}
 	}
+
+	panic(binaryError(p, bop, left, right))
 }
 
 func Nop(...interface{}) {}
@@ -411,9 +839,11 @@ var Len = &Function{
 	F: func(a []interface{}) interface{} {
 		switch at := a[0].(type) {
 		case *List:
-			return len(at.Values)
+			return at.Len()
 		case *Struct:
-			return len(at.Values)
+			return at.Len()
+		case *Channel:
+			return len(at.C)
 		case string:
 			return len(at)
 		default:
@@ -423,91 +853,392 @@ var Len = &Function{
 	FixedArgs: 1,
 }
 
-var Keys = &Function{
-	F: func(a []interface{}) interface{} {
-		s, ok := a[0].(*Struct)
+func stringArg(name string, v interface{}) string {
+	s, ok := v.(string)
+	if !ok {
+		panic(runtimeError(Pos{}, "%s: unsupported code: %s", name, TypeName(v)))
+	}
+
+	return s
+}
+
+func intListArg(name string, v interface{}) []int {
+	l, ok := v.(*List)
+	if !ok {
+		panic(runtimeError(Pos{}, "%s: unsupported code: %s", name, TypeName(v)))
+	}
+
+	values := l.Items()
+	ints := make([]int, len(values))
+	for i, vi := range values {
+		n, ok := vi.(int)
 		if !ok {
-			panic("keys: unsupported code" + fmt.Sprint(a[0]))
+			panic(runtimeError(Pos{}, "%s: unsupported item: %s", name, TypeName(vi)))
 		}
 
-		var keys []interface{}
-		for k := range s.Values {
-			keys = append(keys, k)
+		ints[i] = n
+	}
+
+	return ints
+}
+
+var RuneLen = &Function{
+	F: func(a []interface{}) interface{} {
+		return utf8.RuneCountInString(stringArg("runeLen", a[0]))
+	},
+	FixedArgs: 1,
+}
+
+var Runes = &Function{
+	F: func(a []interface{}) interface{} {
+		var runes []interface{}
+		for _, r := range stringArg("runes", a[0]) {
+			runes = append(runes, string(r))
 		}
 
-		return &List{Values: keys}
+		return NewList(runes...)
 	},
 	FixedArgs: 1,
 }
 
-var Format = &Function{
+var RuneAt = &Function{
 	F: func(a []interface{}) interface{} {
-		f, ok := a[0].(string)
+		s := stringArg("runeAt", a[0])
+		i, ok := a[1].(int)
 		if !ok {
-			panic("format: unsupported code: " + fmt.Sprint(a[0]))
+			panic(runtimeError(Pos{}, "runeAt: unsupported index: %s", TypeName(a[1])))
 		}
 
-		args, ok := a[1].(*List)
-		if !ok {
-			panic("format: unsupported code: " + fmt.Sprint(a[1]))
+		if i >= 0 {
+			for _, r := range s {
+				if i == 0 {
+					return string(r)
+				}
+
+				i--
+			}
 		}
 
-		return fmt.Sprintf(f, args.Values...)
+		panic(runtimeError(Pos{}, "runeAt: index out of range: %v, length: %d", a[1], utf8.RuneCountInString(s)))
 	},
 	FixedArgs: 2,
 }
 
-var Stderr = &Function{
+var RuneSlice = &Function{
 	F: func(a []interface{}) interface{} {
-		s, ok := a[0].(string)
-		if !ok {
-			panic("stderr: unsupported code")
+		s := stringArg("runeSlice", a[0])
+		from, fok := a[1].(int)
+		to, tok := a[2].(int)
+		if !fok || !tok {
+			panic(runtimeError(Pos{}, "runeSlice: unsupported range: %s:%s", TypeName(a[1]), TypeName(a[2])))
+		}
+
+		runes := []rune(s)
+		if from < 0 || to > len(runes) || from > to {
+			panic(runtimeError(Pos{}, "runeSlice: invalid range: %d:%d, length: %d", from, to, len(runes)))
+		}
+
+		return string(runes[from:to])
+	},
+	FixedArgs: 3,
+}
+
+var CodePoints = &Function{
+	F: func(a []interface{}) interface{} {
+		var cp []interface{}
+		for _, r := range stringArg("codePoints", a[0]) {
+			cp = append(cp, int(r))
 		}
 
-		_, err := os.Stderr.Write([]byte(s))
-		return err
+		return NewList(cp...)
 	},
 	FixedArgs: 1,
 }
 
-var Stdout = &Function{
+var FromCodePoints = &Function{
 	F: func(a []interface{}) interface{} {
-		s, ok := a[0].(string)
-		if !ok {
-			panic("stdout: unsupported code")
+		cp := intListArg("fromCodePoints", a[0])
+		runes := make([]rune, len(cp))
+		for i := range cp {
+			runes[i] = rune(cp[i])
+		}
+
+		return string(runes)
+	},
+	FixedArgs: 1,
+}
+
+var Bytes = &Function{
+	F: func(a []interface{}) interface{} {
+		s := stringArg("bytes", a[0])
+		b := make([]interface{}, len(s))
+		for i := 0; i < len(s); i++ {
+			b[i] = int(s[i])
 		}
 
-		_, err := os.Stdout.Write([]byte(s))
-		return err
+		return NewList(b...)
 	},
 	FixedArgs: 1,
 }
 
-var Stdin = &Function{
+var FromBytes = &Function{
 	F: func(a []interface{}) interface{} {
-		if a[0].(int) < 0 {
-			b, err := ioutil.ReadAll(os.Stdin)
-			if err != nil {
-				return err
+		ints := intListArg("fromBytes", a[0])
+		b := make([]byte, len(ints))
+		for i, n := range ints {
+			if n < 0 || n > 255 {
+				panic(runtimeError(Pos{}, "fromBytes: invalid byte: %d", n))
 			}
 
-			return string(b)
+			b[i] = byte(n)
 		}
 
-		b := make([]byte, a[0].(int))
-		n, err := os.Stdin.Read(b)
-		if err != nil {
-			return err
+		return string(b)
+	},
+	FixedArgs: 1,
+}
+
+var Keys = &Function{
+	F: func(a []interface{}) interface{} {
+		s, ok := a[0].(*Struct)
+		if !ok {
+			panic("keys: unsupported code" + fmt.Sprint(a[0]))
 		}
 
-		return string(b[:n])
+		var keys []interface{}
+		for _, k := range s.Keys() {
+			keys = append(keys, k)
+		}
+
+		return NewList(keys...)
 	},
 	FixedArgs: 1,
 }
 
+var Chan = &Function{
+	F: func(a []interface{}) interface{} {
+		return &Channel{C: make(chan interface{})}
+	},
+	FixedArgs: 0,
+}
+
+var BufChan = &Function{
+	F: func(a []interface{}) interface{} {
+		n, ok := a[0].(int)
+		if !ok {
+			panic("bufchan: unsupported code: " + fmt.Sprint(a[0]))
+		}
+
+		return &Channel{C: make(chan interface{}, n)}
+	},
+	FixedArgs: 1,
+}
+
+var typeNames = []string{
+	AnyType:      "any",
+	NilType:      "nil",
+	BoolType:     "bool",
+	IntType:      "int",
+	FloatType:    "float",
+	StringType:   "string",
+	ListType:     "list",
+	StructType:   "struct",
+	FunctionType: "function",
+	ChannelType:  "channel",
+	ErrorType:    "error",
+	StreamType:   "stream",
+}
+
+func (t Type) String() string {
+	if t < 0 || int(t) >= len(typeNames) {
+		return "unknown"
+	}
+
+	return typeNames[t]
+}
+
+func (t Type) accepts(v interface{}) bool {
+	return t == AnyType || t.String() == TypeName(v)
+}
+
+// NewGoFunction creates a Go function that can be called from mml via interop.use.
+func NewGoFunction(s FunctionSignature, f func(args []interface{}, collectArg []interface{}) interface{}) *GoFunction {
+	return &GoFunction{Signature: s, F: f}
+}
+
+// the runtime errors raised by the Go functions without a position get the position of the interop.use. The
+// panic continues from the deferred function, keeping the stack of the Go function.
+func positionPanic(p Pos) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if re, ok := r.(*RuntimeError); ok && re.Pos == (Pos{}) {
+		r = &RuntimeError{Pos: p, Message: re.Message}
+	}
+
+	panic(r)
+}
+
+// Interop wraps a Go function declared with interop.use, so that it can be called from mml. The arguments and
+// the return value are checked against the signature of the Go function. A function returning an error can
+// also return nil.
+func Interop(p Pos, name string, g *GoFunction) *Function {
+	s := g.Signature
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			defer positionPanic(p)
+			if len(a) > len(s.Params) && !s.Variadic {
+				panic(runtimeError(p, "%s: too many arguments: %d, expected: %d", name, len(a), len(s.Params)))
+			}
+
+			for i, ai := range a {
+				t := s.Collect
+				if i < len(s.Params) {
+					t = s.Params[i]
+				}
+
+				if !t.accepts(ai) {
+					panic(runtimeError(p, "%s: argument %d: expected %s, got %s", name, i+1, t, TypeName(ai)))
+				}
+			}
+
+			r := g.F(a[:len(s.Params)], a[len(s.Params):])
+			if !s.Returns.accepts(r) && (s.Returns != ErrorType || r != nil) {
+				panic(runtimeError(p, "%s: invalid return value: expected %s, got %s", name, s.Returns, TypeName(r)))
+			}
+
+			return r
+		},
+		FixedArgs: len(s.Params),
+	}
+}
+
+// Iterate creates an iterator over a list, a structure, a string or a channel. For lists, the keys are the
+// indexes, for structures the field names in their order, and for channels the count of the messages received
+// before. Strings are iterated by runes, and the keys are the byte offsets of the runes. Iterating over a channel
+// ends when the channel is closed.
+func Iterate(p Pos, v interface{}) *Iterator {
+	var next func() (interface{}, interface{}, bool)
+	switch vt := v.(type) {
+	case *List:
+		items, i := vt.Items(), -1
+		next = func() (interface{}, interface{}, bool) {
+			i++
+			if i >= len(items) {
+				return nil, nil, false
+			}
+
+			return i, items[i], true
+		}
+	case *Struct:
+		keys, i := vt.Keys(), -1
+		next = func() (interface{}, interface{}, bool) {
+			i++
+			if i >= len(keys) {
+				return nil, nil, false
+			}
+
+			value, _ := vt.Get(keys[i])
+			return keys[i], value, true
+		}
+	case string:
+		i := 0
+		next = func() (interface{}, interface{}, bool) {
+			if i >= len(vt) {
+				return nil, nil, false
+			}
+
+			r, size := utf8.DecodeRuneInString(vt[i:])
+			key := i
+			i += size
+			return key, string(r), true
+		}
+	case *Function:
+		if vt.stream == nil {
+			panic(runtimeError(p, "range over %s", TypeName(v)))
+		}
+
+		// the loop ends at the end of the input, while the read errors fail it
+		i := -1
+		next = func() (interface{}, interface{}, bool) {
+			line := vt.stream.readLine()
+			if line == io.EOF {
+				return nil, nil, false
+			}
+
+			if err, ok := line.(error); ok {
+				panic(runtimeError(p, "range over stream: %v", err))
+			}
+
+			i++
+			return i, line, true
+		}
+	case *Channel:
+		i := -1
+		next = func() (interface{}, interface{}, bool) {
+			m, ok := <-vt.C
+			if !ok {
+				return nil, nil, false
+			}
+
+			i++
+			return i, m, true
+		}
+	default:
+		panic(runtimeError(p, "range over %s", TypeName(v)))
+	}
+
+	return &Iterator{next: next}
+}
+
+// Next steps the iterator, and returns false when there are no more items.
+func (it *Iterator) Next() bool {
+	var ok bool
+	it.key, it.value, ok = it.next()
+	return ok
+}
+
+// Key returns the index or the field name of the current item.
+func (it *Iterator) Key() interface{} {
+	return it.key
+}
+
+// Value returns the current item.
+func (it *Iterator) Value() interface{} {
+	return it.value
+}
+
+var Format = &Function{
+	F: func(a []interface{}) interface{} {
+		f, ok := a[0].(string)
+		if !ok {
+			panic("format: unsupported code: " + fmt.Sprint(a[0]))
+		}
+
+		args, ok := a[1].(*List)
+		if !ok {
+			panic("format: unsupported code: " + fmt.Sprint(a[1]))
+		}
+
+		f, values := literalVerbs(f, args.Items())
+		return fmt.Sprintf(f, values...)
+	},
+	FixedArgs: 2,
+}
+
+// strings and errors are converted to their raw text, everything else to its mml notation
 var String = &Function{
 	F: func(a []interface{}) interface{} {
-		return fmt.Sprint(a[0])
+		switch at := a[0].(type) {
+		case string:
+			return at
+		case error:
+			return at.Error()
+		default:
+			return literal(at)
+		}
 	},
 	FixedArgs: 1,
 }
@@ -554,28 +1285,59 @@ var ParseFloat = &Function{
 	FixedArgs: 1,
 }
 
-func convertAST(goAST *parser.Node) *Struct {
-	ast := make(map[string]interface{})
-	ast["name"] = goAST.Name
-	ast["text"] = goAST.Text()
+// lineStarts returns the offsets of the first character of every line in the parsed tokens.
+func lineStarts(tokens []rune) []int {
+	starts := []int{0}
+	for i, t := range tokens {
+		if t == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+
+	return starts
+}
+
+func findLine(starts []int, offset int) (line, column int) {
+	line = sort.Search(len(starts), func(i int) bool { return starts[i] > offset }) - 1
+	column = offset - starts[line]
+	return line + 1, column + 1
+}
+
+func convertAST(goAST *parser.Node, starts []int) *Struct {
+	ast := &Struct{}
+	ast.Set("name", goAST.Name)
+	ast.Set("text", goAST.Text())
+	ast.Set("from", goAST.From)
+	ast.Set("to", goAST.To)
+
+	line, column := findLine(starts, goAST.From)
+	ast.Set("line", line)
+	ast.Set("column", column)
 
 	var nodes []interface{}
 	for i := range goAST.Nodes {
-		nodes = append(nodes, convertAST(goAST.Nodes[i]))
+		nodes = append(nodes, convertAST(goAST.Nodes[i], starts))
 	}
 
-	ast["nodes"] = &List{nodes}
-	return &Struct{ast}
+	ast.Set("nodes", NewList(nodes...))
+	return ast
 }
 
+// when the parser fails at the end of the document, the error data is marked as incomplete, e.g. the REPL can
+// read more input in this case
 func parseAST(doc string) (ast *Struct, err error) {
 	var goAST *parser.Node
 	goAST, err = parser.Parse(bytes.NewBufferString(doc))
+	if pe, ok := err.(*parser.ParseError); ok {
+		incomplete := pe.Offset >= utf8.RuneCountInString(doc)
+		return nil, &ErrorValue{Message: pe.Error(), Data: NewStruct(nil).With("incomplete", incomplete)}
+	}
+
 	if err != nil {
 		return
 	}
 
-	return convertAST(goAST), nil
+	return convertAST(goAST, lineStarts(goAST.Tokens())), nil
 }
 
 var ParseAST = &Function{
@@ -592,12 +1354,17 @@ var ParseAST = &Function{
 
 var Has = &Function{
 	F: func(a []interface{}) interface{} {
-		s, ok := a[1].(*Struct)
-		if !ok {
+		var s *Struct
+		switch at := a[1].(type) {
+		case *Struct:
+			s = at
+		case error:
+			s = errorFields(at)
+		default:
 			return false
 		}
 
-		_, ok = s.Values[a[0].(string)]
+		_, ok := s.Get(a[0].(string))
 		return ok
 	},
 	FixedArgs: 2,
@@ -605,7 +1372,7 @@ var Has = &Function{
 
 var Error = &Function{
 	F: func(a []interface{}) interface{} {
-		return errors.New(a[0].(string))
+		return NewError(a[0].(string), nil, nil)
 	},
 	FixedArgs: 1,
 }
@@ -614,52 +1381,15 @@ var Panic = &Function{
 	F: func(a []interface{}) interface{} {
 		err, ok := a[0].(error)
 		if !ok {
-			err = fmt.Errorf("%v", a[0])
-		}
-
-		panic(err)
-	},
-	FixedArgs: 1,
-}
+			m, ok := a[0].(string)
+			if !ok {
+				m = literal(a[0])
+			}
 
-var Open = &Function{
-	F: func(a []interface{}) interface{} {
-		f, err := os.Open(a[0].(string))
-		if err != nil {
-			return err
+			err = NewError(m, nil, nil)
 		}
 
-		return &Function{
-			F: func(a []interface{}) interface{} {
-				l, ok := a[0].(int)
-				if !ok {
-					f.Close()
-					return nil
-				}
-
-				if l < 0 {
-					b, err := ioutil.ReadAll(f)
-					if err != nil {
-						return err
-					}
-
-					return string(b)
-				}
-
-				b := make([]byte, l)
-				n, err := f.Read(b)
-				if err != nil && err != io.EOF {
-					return err
-				}
-
-				if err == io.EOF {
-					f.Close()
-				}
-
-				return string(b[:n])
-			},
-			FixedArgs: 1,
-		}
+		panic(err)
 	},
 	FixedArgs: 1,
 }
@@ -672,6 +1402,11 @@ var (
 func init() {
 	Close = &Function{
 		F: func(a []interface{}) interface{} {
+			if c, ok := a[0].(*Channel); ok {
+				close(c.C)
+				return nil
+			}
+
 			return a[0].(*Function).F([]interface{}{Close})
 		},
 		FixedArgs: 1,
@@ -682,5 +1417,5 @@ func init() {
 		args = append(args, os.Args[i])
 	}
 
-	Args = &List{args}
+	Args = NewList(args...)
 }
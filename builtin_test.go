@@ -0,0 +1,49 @@
+package mml
+
+import "testing"
+
+func TestCallAtKeepsSharedErrors(t *testing.T) {
+	shared := NewError("shared", nil, nil)
+	f := &Function{F: func([]interface{}) interface{} { return shared }}
+
+	p := Pos{Path: "foo.mml", Line: 3, Column: 9}
+	e, ok := f.CallAt(p, nil).(*ErrorValue)
+	if !ok || e == shared || e.Pos != p || e.Message != "shared" {
+		t.Fatalf("unexpected result: %v", e)
+	}
+
+	if shared.Pos != (Pos{}) {
+		t.Fatalf("shared error changed: %v", shared.Pos)
+	}
+
+	positioned := &ErrorValue{Message: "positioned", Pos: Pos{Path: "bar.mml", Line: 1}}
+	f = &Function{F: func([]interface{}) interface{} { return positioned }}
+	if v := f.CallAt(p, nil); v != positioned {
+		t.Fatalf("unexpected result: %v", v)
+	}
+}
+
+func TestStringBuiltinsPanicWithRuntimeErrors(t *testing.T) {
+	for _, test := range []struct {
+		f        *Function
+		args     []interface{}
+		expected string
+	}{
+		{RuneLen, []interface{}{42}, "runeLen: unsupported code: int"},
+		{RuneAt, []interface{}{"foo", "bar"}, "runeAt: unsupported index: string"},
+		{RuneAt, []interface{}{"foo", 3}, "runeAt: index out of range: 3, length: 3"},
+		{RuneSlice, []interface{}{"foo", 2, 1}, "runeSlice: invalid range: 2:1, length: 3"},
+		{FromCodePoints, []interface{}{NewList(1, "2")}, "fromCodePoints: unsupported item: string"},
+		{FromBytes, []interface{}{NewList(256)}, "fromBytes: invalid byte: 256"},
+	} {
+		func() {
+			defer func() {
+				if err, ok := recover().(*RuntimeError); !ok || err.Error() != test.expected {
+					t.Errorf("unexpected panic: %v, expected: %s", err, test.expected)
+				}
+			}()
+
+			test.f.Call(test.args)
+		}()
+	}
+}
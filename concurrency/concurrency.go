@@ -0,0 +1,176 @@
+// Package concurrency implements the Go side of the concurrency module of the mml standard library.
+package concurrency
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aryszka/mml"
+)
+
+// the values of the module are rendered in angle brackets, like the functions and the channels. The mutex
+// tracks whether it is locked, because unlocking an unlocked sync.Mutex is a fatal error that cannot be
+// recovered.
+type (
+	mutex struct {
+		sync.Mutex
+		locked int32
+	}
+
+	waitGroup struct{ sync.WaitGroup }
+	once      struct{ sync.Once }
+	atomicInt struct{ value int64 }
+)
+
+func (*mutex) String() string     { return "<mutex>" }
+func (*waitGroup) String() string { return "<wait group>" }
+func (*once) String() string      { return "<once>" }
+
+func (i *atomicInt) String() string {
+	return fmt.Sprintf("<atomic int: %d>", atomic.LoadInt64(&i.value))
+}
+
+func (m *mutex) lock() {
+	m.Lock()
+	atomic.StoreInt32(&m.locked, 1)
+}
+
+// only one of the concurrent unlocks can succeed, and the mutex cannot be locked again before it is released
+func (m *mutex) unlock() {
+	if !atomic.CompareAndSwapInt32(&m.locked, 1, 0) {
+		panic(&mml.RuntimeError{Message: "unlock: the mutex is not locked"})
+	}
+
+	m.Unlock()
+}
+
+// the position of the invalid use is set by mml.Interop
+func invalid(name, expected string, v interface{}) *mml.RuntimeError {
+	return &mml.RuntimeError{Message: fmt.Sprintf("%s: expected %s, got %s", name, expected, mml.TypeName(v))}
+}
+
+func toMutex(name string, v interface{}) *mutex {
+	m, ok := v.(*mutex)
+	if !ok {
+		panic(invalid(name, "mutex", v))
+	}
+
+	return m
+}
+
+func toWaitGroup(name string, v interface{}) *waitGroup {
+	wg, ok := v.(*waitGroup)
+	if !ok {
+		panic(invalid(name, "wait group", v))
+	}
+
+	return wg
+}
+
+func toOnce(name string, v interface{}) *once {
+	o, ok := v.(*once)
+	if !ok {
+		panic(invalid(name, "once", v))
+	}
+
+	return o
+}
+
+func toAtomicInt(name string, v interface{}) *int64 {
+	i, ok := v.(*atomicInt)
+	if !ok {
+		panic(invalid(name, "atomic int", v))
+	}
+
+	return &i.value
+}
+
+func call(f interface{}) interface{} {
+	return f.(*mml.Function).Call(nil)
+}
+
+var Mutex = mml.NewGoFunction(mml.Signature(mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &mutex{}
+})
+
+var Lock = mml.NewGoFunction(mml.Signature(mml.NilType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	toMutex("lock", a[0]).lock()
+	return nil
+})
+
+var Unlock = mml.NewGoFunction(mml.Signature(mml.NilType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	toMutex("unlock", a[0]).unlock()
+	return nil
+})
+
+var WithLock = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.FunctionType),
+	func(a, _ []interface{}) interface{} {
+		m := toMutex("withLock", a[0])
+		m.lock()
+		defer m.unlock()
+		return call(a[1])
+	},
+)
+
+var WaitGroup = mml.NewGoFunction(mml.Signature(mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &waitGroup{}
+})
+
+var Add = mml.NewGoFunction(mml.Signature(mml.NilType, mml.AnyType, mml.IntType), func(a, _ []interface{}) interface{} {
+	toWaitGroup("add", a[0]).Add(a[1].(int))
+	return nil
+})
+
+var Done = mml.NewGoFunction(mml.Signature(mml.NilType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	toWaitGroup("done", a[0]).Done()
+	return nil
+})
+
+var Wait = mml.NewGoFunction(mml.Signature(mml.NilType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	toWaitGroup("wait", a[0]).Wait()
+	return nil
+})
+
+var Once = mml.NewGoFunction(mml.Signature(mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &once{}
+})
+
+var DoOnce = mml.NewGoFunction(
+	mml.Signature(mml.NilType, mml.AnyType, mml.FunctionType),
+	func(a, _ []interface{}) interface{} {
+		toOnce("doOnce", a[0]).Do(func() { call(a[1]) })
+		return nil
+	},
+)
+
+var AtomicInt = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.IntType), func(a, _ []interface{}) interface{} {
+	return &atomicInt{value: int64(a[0].(int))}
+})
+
+var Load = mml.NewGoFunction(mml.Signature(mml.IntType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	return int(atomic.LoadInt64(toAtomicInt("load", a[0])))
+})
+
+var Store = mml.NewGoFunction(
+	mml.Signature(mml.NilType, mml.AnyType, mml.IntType),
+	func(a, _ []interface{}) interface{} {
+		atomic.StoreInt64(toAtomicInt("store", a[0]), int64(a[1].(int)))
+		return nil
+	},
+)
+
+var Increment = mml.NewGoFunction(
+	mml.Signature(mml.IntType, mml.AnyType, mml.IntType),
+	func(a, _ []interface{}) interface{} {
+		return int(atomic.AddInt64(toAtomicInt("increment", a[0]), int64(a[1].(int))))
+	},
+)
+
+var CompareAndSwap = mml.NewGoFunction(
+	mml.Signature(mml.BoolType, mml.AnyType, mml.IntType, mml.IntType),
+	func(a, _ []interface{}) interface{} {
+		return atomic.CompareAndSwapInt64(toAtomicInt("compareAndSwap", a[0]), int64(a[1].(int)), int64(a[2].(int)))
+	},
+)
@@ -0,0 +1,320 @@
+package mml
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ToGo converts an mml value to a Go value. Lists are converted to []interface{}, structures to
+// map[string]interface{}, and functions to func(...interface{}) (interface{}, error), recursively. Other values
+// are returned unchanged.
+func ToGo(v interface{}) interface{} {
+	switch vt := v.(type) {
+	case *List:
+		values := vt.Items()
+		for i := range values {
+			values[i] = ToGo(values[i])
+		}
+
+		return values
+	case *Struct:
+		values := vt.Map()
+		for k := range values {
+			values[k] = ToGo(values[k])
+		}
+
+		return values
+	case *Function:
+		return func(a ...interface{}) (interface{}, error) {
+			return CallFunction(vt, a...)
+		}
+	default:
+		return v
+	}
+}
+
+// FromGo converts a Go value to an mml value. Integers and floating point numbers of any size are converted to
+// int and float64, slices and arrays to lists, maps with string keys to structures, and functions to mml
+// functions, recursively. Values that are already mml values are returned unchanged. It returns an error when a
+// value has no mml equivalent, or when an unsigned integer doesn't fit in an int.
+func FromGo(v interface{}) (interface{}, error) {
+	switch v.(type) {
+	case nil, bool, string, int, float64, error, *List, *Struct, *Function, *Channel:
+		return v, nil
+	}
+
+	return fromReflect(reflect.ValueOf(v))
+}
+
+func fromReflect(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt {
+			return nil, fmt.Errorf("cannot use %v as int: the value does not fit", v.Interface())
+		}
+
+		return int(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return NewList(), nil
+		}
+
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			vi, err := FromGo(v.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+
+			values[i] = vi
+		}
+
+		return NewList(values...), nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type: %v", v.Type().Key())
+		}
+
+		values := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			vk, err := FromGo(v.MapIndex(k).Interface())
+			if err != nil {
+				return nil, err
+			}
+
+			values[k.String()] = vk
+		}
+
+		return NewStruct(values), nil
+	case reflect.Func:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return fromFunc(v), nil
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		return FromGo(v.Elem().Interface())
+	default:
+		return nil, fmt.Errorf("unsupported type: %v", v.Type())
+	}
+}
+
+// the arguments are converted to the parameter types of the Go function, and a non-nil error as the last
+// return value is returned as an mml error
+func fromFunc(f reflect.Value) *Function {
+	t := f.Type()
+	fixed := t.NumIn()
+	if t.IsVariadic() {
+		fixed--
+	}
+
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			if len(a) > fixed && !t.IsVariadic() {
+				return fmt.Errorf("too many arguments: %d, expected: %d", len(a), fixed)
+			}
+
+			in := make([]reflect.Value, len(a))
+			for i := range a {
+				var it reflect.Type
+				if i < fixed {
+					it = t.In(i)
+				} else {
+					it = t.In(fixed).Elem()
+				}
+
+				ai, err := toType(a[i], it)
+				if err != nil {
+					return fmt.Errorf("argument %d: %v", i+1, err)
+				}
+
+				in[i] = ai
+			}
+
+			out := f.Call(in)
+			if len(out) > 0 && t.Out(len(out)-1) == errorType {
+				if err := out[len(out)-1]; !err.IsNil() {
+					return err.Interface()
+				}
+
+				out = out[:len(out)-1]
+			}
+
+			if len(out) == 0 {
+				return nil
+			}
+
+			r, err := FromGo(out[0].Interface())
+			if err != nil {
+				return err
+			}
+
+			return r
+		},
+		FixedArgs: fixed,
+	}
+}
+
+func toType(v interface{}, t reflect.Type) (reflect.Value, error) {
+	g := ToGo(v)
+	if g == nil {
+		return reflect.Zero(t), nil
+	}
+
+	gv := reflect.ValueOf(g)
+	switch {
+	case gv.Type().AssignableTo(t):
+		return gv, nil
+	case gv.Kind() == reflect.Slice && t.Kind() == reflect.Slice:
+		s := reflect.MakeSlice(t, gv.Len(), gv.Len())
+		for i := 0; i < gv.Len(); i++ {
+			vi, err := toType(gv.Index(i).Interface(), t.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			s.Index(i).Set(vi)
+		}
+
+		return s, nil
+	case gv.Kind() == reflect.Map && t.Kind() == reflect.Map && t.Key().Kind() == reflect.String:
+		m := reflect.MakeMapWithSize(t, gv.Len())
+		for _, k := range gv.MapKeys() {
+			vk, err := toType(gv.MapIndex(k).Interface(), t.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			m.SetMapIndex(k.Convert(t.Key()), vk)
+		}
+
+		return m, nil
+	case gv.Kind() != reflect.String && gv.Type().ConvertibleTo(t) && t.Kind() != reflect.String:
+		return convert(gv, t)
+	default:
+		return reflect.Value{}, fmt.Errorf("cannot use %s as %v", TypeName(v), t)
+	}
+}
+
+func isSigned(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+func isUnsigned(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uintptr
+}
+
+func isFloat(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// the numbers are converted only when they fit the target type: integers need to convert back to the same value,
+// and floats must not overflow. Converting an int to a float may lose precision, the same way as the float
+// arithmetic does.
+func convert(v reflect.Value, t reflect.Type) (reflect.Value, error) {
+	c := v.Convert(t)
+	vk, tk := v.Kind(), t.Kind()
+	switch {
+	case (isSigned(tk) || isUnsigned(tk)) && isFloat(vk) && math.IsNaN(v.Float()),
+		isUnsigned(tk) && isSigned(vk) && v.Int() < 0,
+		isUnsigned(tk) && isFloat(vk) && v.Float() < 0,
+		(isSigned(tk) || isUnsigned(tk)) && c.Convert(v.Type()).Interface() != v.Interface(),
+		isFloat(tk) && isFloat(vk) && math.IsInf(c.Float(), 0) && !math.IsInf(v.Float(), 0):
+		return reflect.Value{}, fmt.Errorf("cannot use %v as %v: the value does not fit", v.Interface(), t)
+	default:
+		return c, nil
+	}
+}
+
+func recoverError(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if e, ok := r.(error); ok {
+		*err = e
+		return
+	}
+
+	*err = fmt.Errorf("%v", r)
+}
+
+// CallFunction calls an mml function with Go arguments. The arguments are converted with FromGo, and the result
+// with ToGo. When the function returns an mml error, or it panics, CallFunction returns an error.
+func CallFunction(f interface{}, args ...interface{}) (result interface{}, err error) {
+	defer recoverError(&err)
+
+	fn, ok := f.(*Function)
+	if !ok {
+		return nil, fmt.Errorf("not a function: %s", TypeName(f))
+	}
+
+	a := make([]interface{}, len(args))
+	for i := range args {
+		if a[i], err = FromGo(args[i]); err != nil {
+			return nil, fmt.Errorf("argument %d: %v", i+1, err)
+		}
+	}
+
+	r := fn.Call(a)
+	if rerr, ok := r.(error); ok {
+		return nil, rerr
+	}
+
+	return ToGo(r), nil
+}
+
+// Export returns an exported value of a module, converted with ToGo. The module is initialized if it was not
+// used before.
+func (c *ModuleContext) Export(path, name string) (value interface{}, err error) {
+	defer recoverError(&err)
+
+	m, err := c.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := m.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("%s: export not found: %s", path, name)
+	}
+
+	return ToGo(v), nil
+}
+
+// Call calls an exported function of a module with Go arguments, the same way as CallFunction.
+func (c *ModuleContext) Call(path, name string, args ...interface{}) (result interface{}, err error) {
+	defer recoverError(&err)
+
+	m, err := c.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, ok := m.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("%s: export not found: %s", path, name)
+	}
+
+	if _, ok := f.(*Function); !ok {
+		return nil, fmt.Errorf("%s: not a function: %s", path, name)
+	}
+
+	return CallFunction(f, args...)
+}
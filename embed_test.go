@@ -0,0 +1,225 @@
+package mml
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestToTypeNumbers(t *testing.T) {
+	for _, test := range []struct {
+		value    interface{}
+		typ      interface{}
+		expected interface{}
+		fail     bool
+	}{
+		{value: 255, typ: uint8(0), expected: uint8(255)},
+		{value: 256, typ: uint8(0), fail: true},
+		{value: -1, typ: uint(0), fail: true},
+		{value: -128, typ: int8(0), expected: int8(-128)},
+		{value: -129, typ: int8(0), fail: true},
+		{value: 3, typ: time.Duration(0), expected: time.Duration(3)},
+		{value: 42, typ: float32(0), expected: float32(42)},
+		{value: 2.0, typ: 0, expected: 2},
+		{value: 1.5, typ: 0, fail: true},
+		{value: -1.0, typ: uint64(0), fail: true},
+		{value: 1e300, typ: 0, fail: true},
+		{value: math.NaN(), typ: 0, fail: true},
+		{value: 0.1, typ: float32(0), expected: float32(0.1)},
+		{value: 1e300, typ: float32(0), fail: true},
+		{value: math.Inf(-1), typ: float32(0), expected: float32(math.Inf(-1))},
+	} {
+		v, err := toType(test.value, reflect.TypeOf(test.typ))
+		if test.fail {
+			if err == nil {
+				t.Errorf("failed to fail: %v as %T, got: %v", test.value, test.typ, v)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%v as %T: %v", test.value, test.typ, err)
+			continue
+		}
+
+		if v.Interface() != test.expected {
+			t.Errorf("%v as %T: got %v, expected: %v", test.value, test.typ, v, test.expected)
+		}
+	}
+}
+
+func TestFromGoUnsignedOverflow(t *testing.T) {
+	if v, err := FromGo(uint64(math.MaxInt)); err != nil || v != math.MaxInt {
+		t.Fatalf("unexpected result: %v, %v", v, err)
+	}
+
+	for _, v := range []interface{}{uint(math.MaxInt + 1), uint64(math.MaxUint64), []uint64{1, math.MaxUint64}} {
+		if r, err := FromGo(v); err == nil {
+			t.Errorf("failed to fail: %v, got: %v", v, r)
+		}
+	}
+}
+
+func TestFromGoCollections(t *testing.T) {
+	l, err := FromGo([]int8{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkItems(t, l.(*List), 1, 2, 3)
+
+	s, err := FromGo(map[string]interface{}{"foo": uint16(1), "bar": []string{"baz"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st := s.(*Struct)
+	if foo, _ := st.Get("foo"); foo != 1 {
+		t.Fatalf("unexpected value of foo: %v", foo)
+	}
+
+	bar, _ := st.Get("bar")
+	checkItems(t, bar.(*List), "baz")
+
+	if _, err := FromGo(map[int]string{1: "foo"}); err == nil {
+		t.Fatal("failed to fail with int keys")
+	}
+
+	if v, err := FromGo([]int(nil)); err != nil || v.(*List).Len() != 0 {
+		t.Fatalf("unexpected result: %v, %v", v, err)
+	}
+}
+
+func TestFromGoFunction(t *testing.T) {
+	f, err := FromGo(func(a int8, rest ...string) (int, error) {
+		if a < 0 {
+			return 0, errors.New("negative")
+		}
+
+		return int(a) + len(rest), nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fn := f.(*Function)
+	for _, test := range []struct {
+		args     []interface{}
+		expected interface{}
+		fail     bool
+	}{
+		{args: []interface{}{3}, expected: 3},
+		{args: []interface{}{3, "foo", "bar"}, expected: 5},
+		{args: []interface{}{-1}, fail: true},
+		{args: []interface{}{128}, fail: true},
+		{args: []interface{}{3, 4}, fail: true},
+	} {
+		r := fn.Call(test.args)
+		if _, isErr := r.(error); isErr != test.fail || !test.fail && r != test.expected {
+			t.Errorf("%v: unexpected result: %v", test.args, r)
+		}
+	}
+
+	fixed, err := FromGo(func(a, b int) int { return a - b })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r, ok := fixed.(*Function).Call([]interface{}{1, 2, 3}).(error); !ok {
+		t.Fatalf("failed to fail with too many arguments: %v", r)
+	}
+}
+
+func TestCallFunction(t *testing.T) {
+	f := &Function{
+		F: func(a []interface{}) interface{} {
+			switch a[0] {
+			case "fail":
+				return errors.New("failed")
+			case "panic":
+				panic(&RuntimeError{Message: "panicked"})
+			default:
+				return NewList(a...)
+			}
+		},
+		FixedArgs: 1,
+	}
+
+	r, err := CallFunction(f, "foo", uint8(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := r.([]interface{}); !ok || len(v) != 2 || v[0] != "foo" || v[1] != 42 {
+		t.Fatalf("unexpected result: %v", r)
+	}
+
+	for _, args := range [][]interface{}{{"fail"}, {"panic"}, {"foo", uint64(math.MaxUint64)}} {
+		if r, err := CallFunction(f, args...); err == nil {
+			t.Errorf("%v: failed to fail, got: %v", args, r)
+		}
+	}
+
+	if _, err := CallFunction(42); err == nil {
+		t.Fatal("failed to fail with a non-function")
+	}
+}
+
+func TestModuleExportAndCall(t *testing.T) {
+	c := NewModuleContext()
+	c.Set("foo.mml", func(*Loader) *Struct {
+		return NewStruct(map[string]interface{}{
+			"answer": 42,
+			"items":  NewList(1, NewStruct(map[string]interface{}{"bar": "baz"})),
+			"add": &Function{
+				F:         func(a []interface{}) interface{} { return a[0].(int) + a[1].(int) },
+				FixedArgs: 2,
+			},
+		})
+	})
+
+	if v, err := c.Export("foo.mml", "answer"); err != nil || v != 42 {
+		t.Fatalf("unexpected export: %v, %v", v, err)
+	}
+
+	items, err := c.Export("foo.mml", "items")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, ok := items.([]interface{}); !ok || len(v) != 2 || v[0] != 1 ||
+		!reflect.DeepEqual(v[1], map[string]interface{}{"bar": "baz"}) {
+		t.Fatalf("unexpected export: %v", items)
+	}
+
+	add, err := c.Export("foo.mml", "add")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r, err := add.(func(...interface{}) (interface{}, error))(1, uint32(2)); err != nil || r != 3 {
+		t.Fatalf("unexpected result of the exported function: %v, %v", r, err)
+	}
+
+	if r, err := c.Call("foo.mml", "add", 3, int64(4)); err != nil || r != 7 {
+		t.Fatalf("unexpected result: %v, %v", r, err)
+	}
+
+	for _, test := range []struct{ path, name string }{
+		{"foo.mml", "qux"},
+		{"foo.mml", "answer"},
+		{"bar.mml", "add"},
+	} {
+		if r, err := c.Call(test.path, test.name); err == nil {
+			t.Errorf("%s.%s: failed to fail, got: %v", test.path, test.name, r)
+		}
+	}
+
+	if _, err := c.Export("foo.mml", "qux"); err == nil {
+		t.Fatal("failed to fail with a missing export")
+	}
+}
@@ -0,0 +1,90 @@
+package mml
+
+// ErrorValue is the error created by mml code. Besides the message, it can carry a structure as payload, and it
+// can wrap another error as its cause. It records the position of the mml code that created it.
+type ErrorValue struct {
+	Message string
+	Data    *Struct
+	Cause   error
+	Pos     Pos
+}
+
+// NewError creates an error value. The data and the cause are optional. The error gets its position from the
+// function application returning it, see Function.CallAt.
+func NewError(message string, data *Struct, cause error) *ErrorValue {
+	return &ErrorValue{Message: message, Data: data, Cause: cause}
+}
+
+// Error returns the message, followed by the message of the cause, if any.
+func (e *ErrorValue) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	}
+
+	return e.Message + ": " + e.Cause.Error()
+}
+
+func (e *ErrorValue) Unwrap() error {
+	return e.Cause
+}
+
+func posFields(p Pos) *Struct {
+	return NewStruct(nil).
+		With("path", p.Path).
+		With("line", p.Line).
+		With("column", p.Column)
+}
+
+// errorFields returns the fields of an error accessible from mml: the message, and when known, the data, the
+// cause and the position
+func errorFields(err error) *Struct {
+	switch et := err.(type) {
+	case *ErrorValue:
+		s := NewStruct(nil).With("message", et.Message)
+		if et.Data != nil {
+			s = s.With("data", et.Data)
+		}
+
+		if et.Cause != nil {
+			s = s.With("cause", et.Cause)
+		}
+
+		if et.Pos != (Pos{}) {
+			s = s.With("position", posFields(et.Pos))
+		}
+
+		return s
+	case *RuntimeError:
+		s := NewStruct(nil).With("message", et.Message)
+		if et.Pos != (Pos{}) {
+			s = s.With("position", posFields(et.Pos))
+		}
+
+		return s
+	default:
+		return NewStruct(nil).With("message", err.Error())
+	}
+}
+
+// errors are equal when their messages, including the messages of their causes, and their data are equal. The
+// position is not considered.
+func equalErrors(left, right error) bool {
+	if left.Error() != right.Error() {
+		return false
+	}
+
+	var leftData, rightData *Struct
+	if e, ok := left.(*ErrorValue); ok {
+		leftData = e.Data
+	}
+
+	if e, ok := right.(*ErrorValue); ok {
+		rightData = e.Data
+	}
+
+	if leftData == nil || rightData == nil {
+		return leftData == rightData
+	}
+
+	return equal(leftData, rightData)
+}
@@ -0,0 +1,37 @@
+// Package errors implements the Go side of the errors module of the mml standard library. The errors created
+// here record the position of the mml code calling them.
+package errors
+
+import "github.com/aryszka/mml"
+
+var New = mml.NewGoFunction(
+	mml.Signature(mml.ErrorType, mml.StringType, mml.StructType),
+	func(a, _ []interface{}) interface{} {
+		return mml.NewError(a[0].(string), a[1].(*mml.Struct), nil)
+	},
+)
+
+var Wrap = mml.NewGoFunction(
+	mml.Signature(mml.ErrorType, mml.StringType, mml.ErrorType),
+	func(a, _ []interface{}) interface{} {
+		return mml.NewError(a[0].(string), nil, a[1].(error))
+	},
+)
+
+var WrapWith = mml.NewGoFunction(
+	mml.Signature(mml.ErrorType, mml.StringType, mml.StructType, mml.ErrorType),
+	func(a, _ []interface{}) interface{} {
+		return mml.NewError(a[0].(string), a[1].(*mml.Struct), a[2].(error))
+	},
+)
+
+// when the error has no cause, it returns nil
+var Unwrap = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.ErrorType), func(a, _ []interface{}) interface{} {
+	if e, ok := a[0].(interface{ Unwrap() error }); ok {
+		if cause := e.Unwrap(); cause != nil {
+			return cause
+		}
+	}
+
+	return nil
+})
@@ -0,0 +1,683 @@
+// Package goast implements a builder of Go syntax trees used by the mml compiler via interop. The builder
+// functions validate their arguments, and Render prints the tree in gofmt style, after removing the unused local
+// variables, and checks that the result is valid Go.
+//
+// Wherever a list of expressions or statements is expected, a single node is accepted, too, and the statement
+// lists can be nested. Expressions in a statement list are turned into statements. Optional parts are passed
+// as empty lists.
+package goast
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aryszka/mml"
+)
+
+// lineMarker is the name of the function called by the statements marking the position of the mml code. The
+// markers are replaced by line directives after formatting, because the Go printer doesn't place comments
+// without source positions.
+const lineMarker = "mmlLineDirective"
+
+var lineMarkerExpression = regexp.MustCompile(
+	"(?m)^[ \t]*" + lineMarker + `\(("(?:[^"\\]|\\.)*"), ([0-9]+), ([0-9]+)\)$`,
+)
+
+func invalid(context, expected string, v interface{}) {
+	panic(fmt.Errorf("goast: %s: expected %s, got %T", context, expected, v))
+}
+
+func isEmpty(v interface{}) bool {
+	l, ok := v.(*mml.List)
+	return ok && l.Len() == 0
+}
+
+func expression(context string, v interface{}) ast.Expr {
+	e, ok := v.(ast.Expr)
+	if !ok {
+		invalid(context, "expression", v)
+	}
+
+	return e
+}
+
+func optionalExpression(context string, v interface{}) ast.Expr {
+	if isEmpty(v) {
+		return nil
+	}
+
+	return expression(context, v)
+}
+
+func expressions(context string, v interface{}) []ast.Expr {
+	l, ok := v.(*mml.List)
+	if !ok {
+		return []ast.Expr{expression(context, v)}
+	}
+
+	var e []ast.Expr
+	for _, item := range l.Items() {
+		e = append(e, expression(context, item))
+	}
+
+	return e
+}
+
+// types can be passed as strings, too, e.g. "*mml.List" or "interface{}"
+func typeExpression(context string, v interface{}) ast.Expr {
+	s, ok := v.(string)
+	if !ok {
+		return expression(context, v)
+	}
+
+	t, err := parser.ParseExpr(s)
+	if err != nil {
+		panic(fmt.Errorf("goast: %s: invalid type: %s", context, s))
+	}
+
+	return t
+}
+
+func identifier(context string, name string) *ast.Ident {
+	if !token.IsIdentifier(name) && name != "_" {
+		panic(fmt.Errorf("goast: %s: invalid identifier: %s", context, name))
+	}
+
+	return ast.NewIdent(name)
+}
+
+func isCall(e ast.Expr) bool {
+	switch et := ast.Unparen(e).(type) {
+	case *ast.CallExpr:
+		return true
+	case *ast.UnaryExpr:
+		return et.Op == token.ARROW
+	default:
+		return false
+	}
+}
+
+// only the calls and receives can be statements in Go, other expressions are assigned to the blank identifier
+func expressionStatement(e ast.Expr) ast.Stmt {
+	if isCall(e) {
+		return &ast.ExprStmt{X: ast.Unparen(e)}
+	}
+
+	return &ast.AssignStmt{Lhs: []ast.Expr{ast.NewIdent("_")}, Tok: token.ASSIGN, Rhs: []ast.Expr{e}}
+}
+
+func statements(context string, v interface{}) []ast.Stmt {
+	switch vt := v.(type) {
+	case *mml.List:
+		var s []ast.Stmt
+		for _, item := range vt.Items() {
+			s = append(s, statements(context, item)...)
+		}
+
+		return s
+	case ast.Stmt:
+		return []ast.Stmt{vt}
+	case ast.Expr:
+		return []ast.Stmt{expressionStatement(vt)}
+	default:
+		invalid(context, "statement", v)
+		return nil
+	}
+}
+
+func statement(context string, v interface{}) ast.Stmt {
+	s := statements(context, v)
+	if len(s) != 1 {
+		panic(fmt.Errorf("goast: %s: expected a single statement, got %d", context, len(s)))
+	}
+
+	return s[0]
+}
+
+func optionalStatement(context string, v interface{}) ast.Stmt {
+	if isEmpty(v) {
+		return nil
+	}
+
+	return statement(context, v)
+}
+
+func block(context string, v interface{}) *ast.BlockStmt {
+	return &ast.BlockStmt{List: statements(context, v)}
+}
+
+func call(context string, v interface{}) *ast.CallExpr {
+	c, ok := ast.Unparen(expression(context, v)).(*ast.CallExpr)
+	if !ok {
+		invalid(context, "call", v)
+	}
+
+	return c
+}
+
+func operator(context, op string, valid ...token.Token) token.Token {
+	for _, t := range valid {
+		if t.String() == op {
+			return t
+		}
+	}
+
+	panic(fmt.Errorf("goast: %s: unsupported operator: %s", context, op))
+}
+
+func fields(context string, v interface{}) *ast.FieldList {
+	l, ok := v.(*mml.List)
+	if !ok {
+		invalid(context, "list of fields", v)
+	}
+
+	fl := &ast.FieldList{}
+	for _, item := range l.Items() {
+		f, ok := item.(*ast.Field)
+		if !ok {
+			invalid(context, "field", item)
+		}
+
+		fl.List = append(fl.List, f)
+	}
+
+	return fl
+}
+
+func varSpec(context string, name string, t, value ast.Expr) *ast.DeclStmt {
+	spec := &ast.ValueSpec{Names: []*ast.Ident{identifier(context, name)}, Type: t}
+	if value != nil {
+		spec.Values = []ast.Expr{value}
+	}
+
+	return &ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{spec}}}
+}
+
+var Ident = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.StringType), func(a, _ []interface{}) interface{} {
+	return identifier("ident", a[0].(string))
+})
+
+// the expression can be passed as a string, too, when it is an identifier
+var Selector = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.StringType),
+	func(a, _ []interface{}) interface{} {
+		x := a[0]
+		if s, ok := x.(string); ok {
+			x = identifier("selector", s)
+		}
+
+		return &ast.SelectorExpr{X: expression("selector", x), Sel: identifier("selector", a[1].(string))}
+	},
+)
+
+var Call = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.CallExpr{Fun: expression("call", a[0]), Args: expressions("call", a[1])}
+	},
+)
+
+// the last argument is spread, like in f(a...)
+var CallSpread = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		args := expressions("call spread", a[1])
+		if len(args) == 0 {
+			panic(fmt.Errorf("goast: call spread: missing argument"))
+		}
+
+		return &ast.CallExpr{Fun: expression("call spread", a[0]), Args: args, Ellipsis: 1}
+	},
+)
+
+var Int = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.IntType), func(a, _ []interface{}) interface{} {
+	return &ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(a[0].(int))}
+})
+
+// the float literals keep a decimal point or an exponent, so that they remain floats in the Go code
+var Float = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.FloatType), func(a, _ []interface{}) interface{} {
+	s := strconv.FormatFloat(a[0].(float64), 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+
+	return &ast.BasicLit{Kind: token.FLOAT, Value: s}
+})
+
+var String = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.StringType), func(a, _ []interface{}) interface{} {
+	return &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(a[0].(string))}
+})
+
+var Bool = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.BoolType), func(a, _ []interface{}) interface{} {
+	return ast.NewIdent(strconv.FormatBool(a[0].(bool)))
+})
+
+var TypeAssert = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.TypeAssertExpr{
+			X:    expression("type assertion", a[0]),
+			Type: typeExpression("type assertion", a[1]),
+		}
+	},
+)
+
+var Unary = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.UnaryExpr{
+			Op: operator("unary", a[0].(string), token.NOT, token.AND, token.ARROW, token.SUB, token.ADD, token.XOR),
+			X:  expression("unary", a[1]),
+		}
+	},
+)
+
+var Binary = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		op := operator(
+			"binary",
+			a[0].(string),
+			token.LAND, token.LOR,
+			token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ,
+			token.ADD, token.SUB, token.MUL, token.QUO, token.REM,
+			token.AND, token.OR, token.XOR, token.AND_NOT,
+		)
+
+		return &ast.BinaryExpr{X: expression("binary", a[1]), Op: op, Y: expression("binary", a[2])}
+	},
+)
+
+var Paren = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &ast.ParenExpr{X: expression("paren", a[0])}
+})
+
+var Composite = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.CompositeLit{
+			Type: typeExpression("composite", a[0]),
+			Elts: expressions("composite", a[1]),
+		}
+	},
+)
+
+var KeyValue = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		k := a[0]
+		if s, ok := k.(string); ok {
+			k = identifier("key-value", s)
+		}
+
+		return &ast.KeyValueExpr{Key: expression("key-value", k), Value: expression("key-value", a[1])}
+	},
+)
+
+var Index = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.IndexExpr{X: expression("index", a[0]), Index: expression("index", a[1])}
+	},
+)
+
+var SliceFrom = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.SliceExpr{X: expression("slice", a[0]), Low: expression("slice", a[1])}
+	},
+)
+
+// the name can be empty for unnamed parameters and results
+var Field = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		f := &ast.Field{Type: typeExpression("field", a[1])}
+		if name := a[0].(string); name != "" {
+			f.Names = []*ast.Ident{identifier("field", name)}
+		}
+
+		return f
+	},
+)
+
+var FuncLit = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.ListType, mml.ListType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.FuncLit{
+			Type: &ast.FuncType{Params: fields("function", a[0]), Results: fields("function", a[1])},
+			Body: block("function", a[2]),
+		}
+	},
+)
+
+var Assign = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.AssignStmt{Lhs: expressions("assign", a[0]), Tok: token.ASSIGN, Rhs: expressions("assign", a[1])}
+	},
+)
+
+var Define = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		lhs := expressions("define", a[0])
+		for _, l := range lhs {
+			if _, ok := l.(*ast.Ident); !ok {
+				invalid("define", "identifier", l)
+			}
+		}
+
+		return &ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE, Rhs: expressions("define", a[1])}
+	},
+)
+
+var Inc = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &ast.IncDecStmt{X: expression("increment", a[0]), Tok: token.INC}
+})
+
+var Declare = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return varSpec("declare", a[0].(string), typeExpression("declare", a[1]), nil)
+	},
+)
+
+var DeclareValue = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return varSpec("declare", a[0].(string), nil, expression("declare", a[1]))
+	},
+)
+
+var DeclareTyped = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return varSpec("declare", a[0].(string), typeExpression("declare", a[1]), expression("declare", a[2]))
+	},
+)
+
+var Return = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &ast.ReturnStmt{Results: expressions("return", a[0])}
+})
+
+var If = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &ast.IfStmt{Cond: expression("if", a[0]), Body: block("if", a[1])}
+})
+
+// when the alternative is a single if statement, it is printed as else if
+var IfElse = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		var alternative ast.Stmt = block("if", a[2])
+		if s := alternative.(*ast.BlockStmt).List; len(s) == 1 {
+			if _, ok := s[0].(*ast.IfStmt); ok {
+				alternative = s[0]
+			}
+		}
+
+		return &ast.IfStmt{Cond: expression("if", a[0]), Body: block("if", a[1]), Else: alternative}
+	},
+)
+
+var For = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.ForStmt{
+			Init: optionalStatement("for", a[0]),
+			Cond: optionalExpression("for", a[1]),
+			Post: optionalStatement("for", a[2]),
+			Body: block("for", a[3]),
+		}
+	},
+)
+
+// an empty list of expressions means the default case
+var Case = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		var list []ast.Expr
+		if !isEmpty(a[0]) {
+			list = expressions("case", a[0])
+		}
+
+		return &ast.CaseClause{List: list, Body: statements("case", a[1])}
+	},
+)
+
+var Switch = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		body := block("switch", a[1])
+		for _, s := range body.List {
+			if _, ok := s.(*ast.CaseClause); !ok {
+				invalid("switch", "case", s)
+			}
+		}
+
+		return &ast.SwitchStmt{Tag: optionalExpression("switch", a[0]), Body: body}
+	},
+)
+
+// an empty communication means the default case
+var CommCase = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.CommClause{Comm: optionalStatement("select case", a[0]), Body: statements("select case", a[1])}
+	},
+)
+
+var Select = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	body := block("select", a[0])
+	for _, s := range body.List {
+		if _, ok := s.(*ast.CommClause); !ok {
+			invalid("select", "select case", s)
+		}
+	}
+
+	return &ast.SelectStmt{Body: body}
+})
+
+var Send = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.SendStmt{Chan: expression("send", a[0]), Value: expression("send", a[1])}
+	},
+)
+
+var Go = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &ast.GoStmt{Call: call("go", a[0])}
+})
+
+var Defer = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	return &ast.DeferStmt{Call: call("defer", a[0])}
+})
+
+// an empty label means the innermost loop
+var Branch = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.StringType),
+	func(a, _ []interface{}) interface{} {
+		b := &ast.BranchStmt{Tok: operator("branch", a[0].(string), token.BREAK, token.CONTINUE)}
+		if label := a[1].(string); label != "" {
+			b.Label = identifier("branch", label)
+		}
+
+		return b
+	},
+)
+
+var Labeled = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.LabeledStmt{Label: identifier("label", a[0].(string)), Stmt: statement("label", a[1])}
+	},
+)
+
+// Line marks the position of the mml code, that the following statements were compiled from. In the rendered
+// code, it becomes a line directive. An empty path marks the end of the mml code, and the following lines are
+// attributed to the generated file itself.
+var Line = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.IntType, mml.IntType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.ExprStmt{X: &ast.CallExpr{
+			Fun: ast.NewIdent(lineMarker),
+			Args: []ast.Expr{
+				&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(a[0].(string))},
+				&ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(a[1].(int))},
+				&ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(a[2].(int))},
+			},
+		}}
+	},
+)
+
+var Import = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.StringType),
+	func(a, _ []interface{}) interface{} {
+		spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(a[1].(string))}}
+		if name := a[0].(string); name != "" {
+			spec.Name = identifier("import", name)
+		}
+
+		return spec
+	},
+)
+
+var FuncDecl = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return &ast.FuncDecl{
+			Name: identifier("function declaration", a[0].(string)),
+			Type: &ast.FuncType{Params: &ast.FieldList{}},
+			Body: block("function declaration", a[1]),
+		}
+	},
+)
+
+func groupDecl(tok token.Token, specs []ast.Spec) *ast.GenDecl {
+	d := &ast.GenDecl{Tok: tok, Specs: specs}
+	if len(specs) > 1 {
+		d.Lparen = 1
+	}
+
+	return d
+}
+
+// File creates a Go source file from the imports and the top level declarations. The variable declarations are
+// grouped, and placed before the functions.
+var File = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StringType, mml.ListType, mml.ListType),
+	func(a, _ []interface{}) interface{} {
+		var (
+			imports []ast.Spec
+			vars    []ast.Spec
+			funcs   []ast.Decl
+		)
+
+		for _, i := range a[1].(*mml.List).Items() {
+			spec, ok := i.(*ast.ImportSpec)
+			if !ok {
+				invalid("file", "import", i)
+			}
+
+			imports = append(imports, spec)
+		}
+
+		for _, d := range a[2].(*mml.List).Items() {
+			switch dt := d.(type) {
+			case *ast.DeclStmt:
+				vars = append(vars, dt.Decl.(*ast.GenDecl).Specs...)
+			case *ast.FuncDecl:
+				funcs = append(funcs, dt)
+			default:
+				invalid("file", "declaration", d)
+			}
+		}
+
+		f := &ast.File{Name: identifier("file", a[0].(string))}
+		if len(imports) > 0 {
+			f.Decls = append(f.Decls, groupDecl(token.IMPORT, imports))
+		}
+
+		if len(vars) > 0 {
+			f.Decls = append(f.Decls, groupDecl(token.VAR, vars))
+		}
+
+		f.Decls = append(f.Decls, funcs...)
+		return f
+	},
+)
+
+// the directives need to start at the beginning of the line. The markers are replaced line by line, so the line
+// numbers of the generated file don't change.
+func lineDirectives(code []byte, fileName string) []byte {
+	var (
+		b           bytes.Buffer
+		last, lines int
+	)
+
+	for _, m := range lineMarkerExpression.FindAllSubmatchIndex(code, -1) {
+		lines += bytes.Count(code[last:m[0]], []byte("\n"))
+		b.Write(code[last:m[0]])
+		last = m[1]
+
+		path, _ := strconv.Unquote(string(code[m[2]:m[3]]))
+		if path == "" {
+			fmt.Fprintf(&b, "//line %s:%d", fileName, lines+2)
+			continue
+		}
+
+		fmt.Fprintf(&b, "//line %s:%s:%s", path, code[m[4]:m[5]], code[m[6]:m[7]])
+	}
+
+	b.Write(code[last:])
+	return b.Bytes()
+}
+
+func render(f *ast.File, fileName string) (code string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("goast: render: %v", r)
+		}
+	}()
+
+	removeUnused(f)
+
+	var b bytes.Buffer
+	b.WriteString("// Generated code\n")
+	if err := format.Node(&b, token.NewFileSet(), f); err != nil {
+		return "", err
+	}
+
+	c := lineDirectives(b.Bytes(), fileName)
+	if _, err := parser.ParseFile(token.NewFileSet(), "", c, parser.ParseComments); err != nil {
+		return "", fmt.Errorf("goast: invalid code generated: %v", err)
+	}
+
+	return string(c), nil
+}
+
+// Render prints a file created with File, or returns an error when the generated code is not valid. The file
+// name is used by the line directives pointing back to the generated code.
+var Render = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.StringType),
+	func(a, _ []interface{}) interface{} {
+		f, ok := a[0].(*ast.File)
+		if !ok {
+			invalid("render", "file", a[0])
+		}
+
+		code, err := render(f, a[1].(string))
+		if err != nil {
+			return err
+		}
+
+		return code
+	},
+)
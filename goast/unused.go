@@ -0,0 +1,441 @@
+package goast
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// the compiled code declares every symbol of a scope as a local variable, and Go doesn't accept the ones that
+// are never read. The unused variables are removed, and the assignments to them are turned into assignments to
+// the blank identifier, or removed when they have no side effects.
+
+type object struct {
+	reads int
+}
+
+type scope struct {
+	parent  *scope
+	objects map[string]*object
+}
+
+type resolver struct {
+	scope *scope
+	decls map[*ast.Ident]*object
+	uses  map[*ast.Ident]*object
+}
+
+func (r *resolver) push() {
+	r.scope = &scope{parent: r.scope, objects: make(map[string]*object)}
+}
+
+func (r *resolver) pop() {
+	r.scope = r.scope.parent
+}
+
+func (r *resolver) declare(id *ast.Ident) {
+	if id.Name == "_" {
+		return
+	}
+
+	o := &object{}
+	r.scope.objects[id.Name] = o
+	r.decls[id] = o
+}
+
+func (r *resolver) lookup(name string) *object {
+	for s := r.scope; s != nil; s = s.parent {
+		if o, ok := s.objects[name]; ok {
+			return o
+		}
+	}
+
+	return nil
+}
+
+// writes are recorded, but they don't count as reads
+func (r *resolver) write(e ast.Expr) {
+	id, ok := e.(*ast.Ident)
+	if !ok {
+		r.expr(e)
+		return
+	}
+
+	if o := r.lookup(id.Name); o != nil {
+		r.uses[id] = o
+	}
+}
+
+func (r *resolver) expr(e ast.Expr) {
+	if e == nil {
+		return
+	}
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch nt := n.(type) {
+		case *ast.Ident:
+			if o := r.lookup(nt.Name); o != nil {
+				o.reads++
+			}
+		case *ast.SelectorExpr:
+			r.expr(nt.X)
+			return false
+		case *ast.KeyValueExpr:
+			if _, ok := nt.Key.(*ast.Ident); !ok {
+				r.expr(nt.Key)
+			}
+
+			r.expr(nt.Value)
+			return false
+		case *ast.FuncLit:
+			r.push()
+			for _, f := range nt.Type.Params.List {
+				for _, name := range f.Names {
+					r.declare(name)
+				}
+			}
+
+			r.stmts(nt.Body.List)
+			r.pop()
+			return false
+		}
+
+		return true
+	})
+}
+
+func (r *resolver) exprs(e []ast.Expr) {
+	for _, ei := range e {
+		r.expr(ei)
+	}
+}
+
+func (r *resolver) stmts(s []ast.Stmt) {
+	for _, si := range s {
+		r.stmt(si)
+	}
+}
+
+func (r *resolver) block(s []ast.Stmt) {
+	r.push()
+	r.stmts(s)
+	r.pop()
+}
+
+func (r *resolver) stmt(s ast.Stmt) {
+	switch st := s.(type) {
+	case nil:
+	case *ast.DeclStmt:
+		for _, spec := range st.Decl.(*ast.GenDecl).Specs {
+			vs := spec.(*ast.ValueSpec)
+			r.exprs(vs.Values)
+			for _, name := range vs.Names {
+				r.declare(name)
+			}
+		}
+	case *ast.AssignStmt:
+		r.exprs(st.Rhs)
+		for _, l := range st.Lhs {
+			if st.Tok == token.DEFINE {
+				r.declare(l.(*ast.Ident))
+			} else {
+				r.write(l)
+			}
+		}
+	case *ast.ExprStmt:
+		r.expr(st.X)
+	case *ast.IncDecStmt:
+		r.expr(st.X)
+	case *ast.SendStmt:
+		r.expr(st.Chan)
+		r.expr(st.Value)
+	case *ast.GoStmt:
+		r.expr(st.Call)
+	case *ast.DeferStmt:
+		r.expr(st.Call)
+	case *ast.ReturnStmt:
+		r.exprs(st.Results)
+	case *ast.BlockStmt:
+		r.block(st.List)
+	case *ast.LabeledStmt:
+		r.stmt(st.Stmt)
+	case *ast.IfStmt:
+		r.push()
+		r.stmt(st.Init)
+		r.expr(st.Cond)
+		r.block(st.Body.List)
+		r.stmt(st.Else)
+		r.pop()
+	case *ast.ForStmt:
+		r.push()
+		r.stmt(st.Init)
+		r.expr(st.Cond)
+		r.stmt(st.Post)
+		r.block(st.Body.List)
+		r.pop()
+	case *ast.SwitchStmt:
+		r.push()
+		r.stmt(st.Init)
+		r.expr(st.Tag)
+		for _, c := range st.Body.List {
+			cc := c.(*ast.CaseClause)
+			r.exprs(cc.List)
+			r.block(cc.Body)
+		}
+
+		r.pop()
+	case *ast.SelectStmt:
+		for _, c := range st.Body.List {
+			cc := c.(*ast.CommClause)
+			r.push()
+			r.stmt(cc.Comm)
+			r.stmts(cc.Body)
+			r.pop()
+		}
+	}
+}
+
+// the expressions without calls, receives and type assertions can be removed without changing the behavior.
+// Function literals are not executed when created.
+func pure(e ast.Expr) bool {
+	p := true
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch nt := n.(type) {
+		case *ast.CallExpr, *ast.TypeAssertExpr:
+			p = false
+		case *ast.UnaryExpr:
+			if nt.Op == token.ARROW {
+				p = false
+			}
+		case *ast.FuncLit:
+			return false
+		}
+
+		return p
+	})
+
+	return p
+}
+
+func isBlank(e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == "_"
+}
+
+type remover struct {
+	unused  map[*ast.Ident]bool
+	changed bool
+}
+
+func (rm *remover) blankAssignment(s *ast.AssignStmt) []ast.Stmt {
+	var blanked bool
+	for i, l := range s.Lhs {
+		if id, ok := l.(*ast.Ident); ok && rm.unused[id] {
+			s.Lhs[i] = ast.NewIdent("_")
+			blanked = true
+		}
+	}
+
+	if !blanked {
+		return []ast.Stmt{s}
+	}
+
+	rm.changed = true
+	for _, l := range s.Lhs {
+		if !isBlank(l) {
+			return []ast.Stmt{s}
+		}
+	}
+
+	s.Tok = token.ASSIGN
+	if len(s.Rhs) != len(s.Lhs) {
+		return []ast.Stmt{s}
+	}
+
+	var replacement []ast.Stmt
+	for _, r := range s.Rhs {
+		switch {
+		case pure(r):
+		case isCall(r):
+			replacement = append(replacement, &ast.ExprStmt{X: ast.Unparen(r)})
+		default:
+			replacement = append(replacement, &ast.AssignStmt{
+				Lhs: []ast.Expr{ast.NewIdent("_")},
+				Tok: token.ASSIGN,
+				Rhs: []ast.Expr{r},
+			})
+		}
+	}
+
+	return replacement
+}
+
+func (rm *remover) declaration(s *ast.DeclStmt) []ast.Stmt {
+	vs := s.Decl.(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+	if len(vs.Names) != 1 || !rm.unused[vs.Names[0]] {
+		return []ast.Stmt{s}
+	}
+
+	rm.changed = true
+	if len(vs.Values) == 0 || pure(vs.Values[0]) {
+		return nil
+	}
+
+	return []ast.Stmt{&ast.AssignStmt{Lhs: []ast.Expr{ast.NewIdent("_")}, Tok: token.ASSIGN, Rhs: vs.Values}}
+}
+
+func (rm *remover) stmts(s []ast.Stmt) []ast.Stmt {
+	var result []ast.Stmt
+	for _, si := range s {
+		result = append(result, rm.stmt(si)...)
+	}
+
+	return result
+}
+
+// a communication of a select case needs to remain a single statement, or none
+func (rm *remover) comm(s ast.Stmt) ast.Stmt {
+	as, ok := s.(*ast.AssignStmt)
+	if !ok {
+		return s
+	}
+
+	for i, l := range as.Lhs {
+		if id, ok := l.(*ast.Ident); ok && rm.unused[id] {
+			as.Lhs[i] = ast.NewIdent("_")
+			rm.changed = true
+		}
+	}
+
+	if len(as.Lhs) == 1 && isBlank(as.Lhs[0]) {
+		return &ast.ExprStmt{X: as.Rhs[0]}
+	}
+
+	return as
+}
+
+func (rm *remover) stmt(s ast.Stmt) []ast.Stmt {
+	switch st := s.(type) {
+	case *ast.DeclStmt:
+		if replacement := rm.declaration(st); len(replacement) != 1 || replacement[0] != s {
+			rm.exprsIn(replacement)
+			return replacement
+		}
+
+		rm.exprs(st.Decl.(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values)
+	case *ast.AssignStmt:
+		rm.exprs(st.Rhs)
+		return rm.blankAssignment(st)
+	case *ast.ExprStmt:
+		rm.expr(st.X)
+	case *ast.IncDecStmt:
+		rm.expr(st.X)
+	case *ast.SendStmt:
+		rm.expr(st.Chan)
+		rm.expr(st.Value)
+	case *ast.GoStmt:
+		rm.expr(st.Call)
+	case *ast.DeferStmt:
+		rm.expr(st.Call)
+	case *ast.ReturnStmt:
+		rm.exprs(st.Results)
+	case *ast.BlockStmt:
+		st.List = rm.stmts(st.List)
+	case *ast.LabeledStmt:
+		rm.stmt(st.Stmt)
+	case *ast.IfStmt:
+		rm.expr(st.Cond)
+		st.Body.List = rm.stmts(st.Body.List)
+		if st.Else != nil {
+			rm.stmt(st.Else)
+		}
+	case *ast.ForStmt:
+		rm.expr(st.Cond)
+		st.Body.List = rm.stmts(st.Body.List)
+	case *ast.SwitchStmt:
+		rm.expr(st.Tag)
+		for _, c := range st.Body.List {
+			cc := c.(*ast.CaseClause)
+			rm.exprs(cc.List)
+			cc.Body = rm.stmts(cc.Body)
+		}
+	case *ast.SelectStmt:
+		for _, c := range st.Body.List {
+			cc := c.(*ast.CommClause)
+			if cc.Comm != nil {
+				cc.Comm = rm.comm(cc.Comm)
+			}
+
+			cc.Body = rm.stmts(cc.Body)
+		}
+	}
+
+	return []ast.Stmt{s}
+}
+
+func (rm *remover) exprsIn(s []ast.Stmt) {
+	for _, si := range s {
+		if as, ok := si.(*ast.AssignStmt); ok {
+			rm.exprs(as.Rhs)
+		}
+	}
+}
+
+func (rm *remover) exprs(e []ast.Expr) {
+	for _, ei := range e {
+		rm.expr(ei)
+	}
+}
+
+func (rm *remover) expr(e ast.Expr) {
+	if e == nil {
+		return
+	}
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		if f, ok := n.(*ast.FuncLit); ok {
+			f.Body.List = rm.stmts(f.Body.List)
+			return false
+		}
+
+		return true
+	})
+}
+
+// removing a variable can make others unused, so it repeats until there is nothing to remove
+func removeUnused(f *ast.File) {
+	for {
+		r := &resolver{decls: make(map[*ast.Ident]*object), uses: make(map[*ast.Ident]*object)}
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok {
+				r.block(fd.Body.List)
+			}
+		}
+
+		rm := &remover{unused: make(map[*ast.Ident]bool)}
+		for id, o := range r.decls {
+			if o.reads == 0 {
+				rm.unused[id] = true
+			}
+		}
+
+		for id, o := range r.uses {
+			if o.reads == 0 {
+				rm.unused[id] = true
+			}
+		}
+
+		if len(rm.unused) == 0 {
+			return
+		}
+
+		for _, d := range f.Decls {
+			if fd, ok := d.(*ast.FuncDecl); ok {
+				fd.Body.List = rm.stmts(fd.Body.List)
+			}
+		}
+
+		if !rm.changed {
+			return
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package mml
+
+import "testing"
+
+func TestInteropPositionsRuntimeErrors(t *testing.T) {
+	p := Pos{Path: "foo.mml", Line: 3, Column: 9}
+	g := NewGoFunction(Signature(NilType, IntType), func(a, _ []interface{}) interface{} {
+		if a[0].(int) < 0 {
+			panic(&RuntimeError{Message: "negative"})
+		}
+
+		panic(&RuntimeError{Pos: Pos{Path: "bar.mml", Line: 1}, Message: "positioned"})
+	})
+
+	f := Interop(p, "foo.bar", g)
+	for _, test := range []struct {
+		arg      int
+		expected string
+	}{
+		{-1, "foo.mml:3:9: negative"},
+		{1, "bar.mml:1: positioned"},
+	} {
+		func() {
+			defer func() {
+				if err, ok := recover().(*RuntimeError); !ok || err.Error() != test.expected {
+					t.Errorf("unexpected panic: %v, expected: %s", err, test.expected)
+				}
+			}()
+
+			f.Call([]interface{}{test.arg})
+		}()
+	}
+}
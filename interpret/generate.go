@@ -0,0 +1,245 @@
+//go:build ignore
+
+// generate.go writes interop.go, the built-ins and the Go packages linked into the interpreter. The built-ins
+// are taken from the builtin table of the compiler, in code.mml, and the packages are the ones in the
+// repository declaring Go functions for mml with mml.NewGoFunction, this way the interpreter provides the same
+// values as what the compiled code can use.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	goparser "go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/aryszka/mml/parser"
+)
+
+// the generator runs in the directory of the interpreter
+const (
+	root       = ".."
+	modulePath = "github.com/aryszka/mml"
+)
+
+type goPackage struct {
+	Path, Name string
+	Functions  []string
+}
+
+func child(n *parser.Node, name string) *parser.Node {
+	for _, c := range n.Nodes {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// the value-capture nodes hold the symbol and the value of a definition
+func findDefinition(n *parser.Node, symbol string) *parser.Node {
+	if n.Name == "value-capture" {
+		if s := child(n, "symbol"); s != nil && s.Text() == symbol {
+			return n
+		}
+	}
+
+	for _, c := range n.Nodes {
+		if d := findDefinition(c, symbol); d != nil {
+			return d
+		}
+	}
+
+	return nil
+}
+
+type builtin struct{ Name, GoName string }
+
+func builtins() ([]builtin, error) {
+	f, err := os.Open(filepath.Join(root, "code.mml"))
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+	n, err := parser.Parse(f)
+	if err != nil {
+		return nil, err
+	}
+
+	d := findDefinition(n, "builtin")
+	if d == nil || child(d, "struct") == nil {
+		return nil, fmt.Errorf("code.mml: builtin table not found")
+	}
+
+	var b []builtin
+	for _, e := range child(d, "struct").Nodes {
+		k, v := child(e, "symbol"), child(e, "string")
+		if e.Name != "entry" || k == nil || v == nil {
+			return nil, fmt.Errorf("code.mml: invalid entry in the builtin table: %s", e.Text())
+		}
+
+		goName, err := strconv.Unquote(v.Text())
+		if err != nil {
+			return nil, fmt.Errorf("code.mml: %s: %v", k.Text(), err)
+		}
+
+		b = append(b, builtin{Name: k.Text(), GoName: goName})
+	}
+
+	return b, nil
+}
+
+func isGoFunction(e ast.Expr) bool {
+	c, ok := e.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	s, ok := c.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	x, ok := s.X.(*ast.Ident)
+	return ok && x.Name == "mml" && s.Sel.Name == "NewGoFunction"
+}
+
+func goFunctions(dir string) (*goPackage, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &goPackage{Path: modulePath + "/" + filepath.Base(dir)}
+	fs := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		f, err := goparser.ParseFile(fs, path, nil, goparser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		p.Name = f.Name.Name
+		for _, d := range f.Decls {
+			g, ok := d.(*ast.GenDecl)
+			if !ok || g.Tok != token.VAR {
+				continue
+			}
+
+			for _, s := range g.Specs {
+				v := s.(*ast.ValueSpec)
+				for i, n := range v.Names {
+					if n.IsExported() && i < len(v.Values) && isGoFunction(v.Values[i]) {
+						p.Functions = append(p.Functions, n.Name)
+					}
+				}
+			}
+		}
+	}
+
+	return p, nil
+}
+
+func packages() ([]*goPackage, error) {
+	dirs, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	// the functions of the interpreter itself are not available in the interpreted code
+	var p []*goPackage
+	for _, d := range dirs {
+		if !d.IsDir() || d.Name() == "interpret" {
+			continue
+		}
+
+		gp, err := goFunctions(filepath.Join(root, d.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(gp.Functions) > 0 {
+			p = append(p, gp)
+		}
+	}
+
+	return p, nil
+}
+
+var source = template.Must(template.New("interop.go").Parse(`// Code generated by generate.go from code.mml and the Go packages of the standard library. DO NOT EDIT.
+
+package interpret
+
+import (
+	"github.com/aryszka/mml"
+{{- range .Packages}}
+	"{{.Path}}"
+{{- end}}
+)
+
+//go:generate go run generate.go
+
+// the interpreter cannot load Go packages, the interop.use declarations can refer only to the Go packages of the
+// standard library, linked into the interpreter
+var packages = map[string]map[string]*mml.GoFunction{
+{{- range $p := .Packages}}
+	"{{$p.Path}}": {
+	{{- range $p.Functions}}
+		"{{.}}": {{$p.Name}}.{{.}},
+	{{- end}}
+	},
+{{- end}}
+}
+
+// the built-in values, the same as the ones in the builtin table of the compiler, where args is set for the
+// interpreted program. It is not a package level variable, because some of the built-ins are set only by the
+// init function of the runtime.
+func builtins() map[string]interface{} {
+	return map[string]interface{}{
+	{{- range .Builtins}}
+		"{{.Name}}": mml.{{.GoName}},
+	{{- end}}
+	}
+}
+`))
+
+func main() {
+	b, err := builtins()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	p, err := packages()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var src bytes.Buffer
+	if err := source.Execute(&src, struct {
+		Packages []*goPackage
+		Builtins []builtin
+	}{p, b}); err != nil {
+		log.Fatal(err)
+	}
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("interop.go", formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
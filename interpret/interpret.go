@@ -0,0 +1,1078 @@
+// Package interpret implements a tree-walking interpreter of the mml code parsed by the mml compiler. It shares
+// the runtime with the compiled code, including the built-in functions, and the interpreted code behaves the same
+// way as the Go code that the compiler generates from it.
+//
+// The code is first turned into a tree of Go closures, and these closures are executed when the modules are
+// used. The interpreter doesn't validate the code, it expects that the compiler already checked it.
+package interpret
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aryszka/mml"
+)
+
+// the same as in the code module of the compiler
+const (
+	breakControl    = 0
+	continueControl = 1
+	logicalNot      = 3
+	logicalAnd      = 17
+	logicalOr       = 18
+)
+
+type control int
+
+const (
+	next control = iota
+	returned
+	broken
+	continued
+)
+
+type (
+	expression func(*scope) interface{}
+	statement  func(*scope) (control, interface{})
+)
+
+// frame holds the calls deferred by a function or a module initializer
+type frame struct {
+	deferred []func()
+}
+
+// the modules are loaded by the loader of the using module, or, in the REPL, directly by the module context
+type loader interface {
+	Use(string) *mml.Struct
+}
+
+type module struct {
+	loader  loader
+	exports *mml.Struct
+}
+
+// every name of a scope is declared when the scope is created, this way the variables can be shared with
+// goroutines without synchronizing the scope itself
+type scope struct {
+	parent *scope
+	vars   map[string]*interface{}
+	frame  *frame
+	module *module
+}
+
+// block is a statement list with the names defined in it
+type block struct {
+	names      []string
+	statements []statement
+}
+
+type selectCase struct {
+	dir     reflect.SelectDir
+	channel expression
+	value   expression
+	symbol  string
+	body    *block
+}
+
+func (f *frame) runDeferred() {
+	for i := len(f.deferred) - 1; i >= 0; i-- {
+		f.deferred[i]()
+	}
+}
+
+func newScope(parent *scope, names []string) *scope {
+	s := &scope{parent: parent, vars: make(map[string]*interface{}, len(names))}
+	if parent != nil {
+		s.frame = parent.frame
+		s.module = parent.module
+	}
+
+	for _, n := range names {
+		s.vars[n] = new(interface{})
+	}
+
+	return s
+}
+
+func (s *scope) lookup(name string) *interface{} {
+	for si := s; si != nil; si = si.parent {
+		if v, ok := si.vars[name]; ok {
+			return v
+		}
+	}
+
+	panic(&mml.RuntimeError{Message: fmt.Sprintf("undefined: %s", name)})
+}
+
+func (b *block) run(s *scope) (control, interface{}) {
+	for _, st := range b.statements {
+		if c, v := st(s); c != next {
+			return c, v
+		}
+	}
+
+	return next, nil
+}
+
+func unsupported(n interface{}) {
+	panic(fmt.Errorf("interpret: unsupported code: %s", nodeType(n)))
+}
+
+func field(n interface{}, key string) interface{} {
+	s, ok := n.(*mml.Struct)
+	if !ok {
+		return nil
+	}
+
+	v, _ := s.Get(key)
+	return v
+}
+
+func has(n interface{}, key string) bool {
+	s, ok := n.(*mml.Struct)
+	if !ok {
+		return false
+	}
+
+	_, ok = s.Get(key)
+	return ok
+}
+
+func stringField(n interface{}, key string) string {
+	s, _ := field(n, key).(string)
+	return s
+}
+
+func intField(n interface{}, key string) int {
+	i, _ := field(n, key).(int)
+	return i
+}
+
+func boolField(n interface{}, key string) bool {
+	b, _ := field(n, key).(bool)
+	return b
+}
+
+func listField(n interface{}, key string) []interface{} {
+	l, ok := field(n, key).(*mml.List)
+	if !ok {
+		return nil
+	}
+
+	return l.Items()
+}
+
+func stringsField(n interface{}, key string) []string {
+	var s []string
+	for _, v := range listField(n, key) {
+		s = append(s, v.(string))
+	}
+
+	return s
+}
+
+func nodeType(n interface{}) string {
+	return stringField(n, "type")
+}
+
+func position(n interface{}) mml.Pos {
+	p := field(n, "pos")
+	return mml.Pos{Path: stringField(p, "path"), Line: intField(p, "line"), Column: intField(p, "column")}
+}
+
+// the statements of a definition or use list are flattened
+func flattened(itemType, listType, listField string, statements []interface{}) []interface{} {
+	var items []interface{}
+	for _, s := range statements {
+		switch nodeType(s) {
+		case itemType:
+			items = append(items, s)
+		case listType:
+			items = append(items, flattened(itemType, listType, listField, listFieldValues(s, listField))...)
+		}
+	}
+
+	return items
+}
+
+func listFieldValues(n interface{}, key string) []interface{} {
+	return listField(n, key)
+}
+
+// the names defined by the definitions and the uses in a statement list, like in the compiled code
+func scopeNames(statements []interface{}) []string {
+	var names []string
+	for _, d := range flattened("definition", "definition-list", "definitions", statements) {
+		names = append(names, stringField(d, "symbol"))
+	}
+
+	for _, u := range flattened("use", "use-list", "uses", statements) {
+		switch capture := stringField(u, "capture"); capture {
+		case ".":
+			names = append(names, stringsField(u, "exportNames")...)
+		case "":
+			names = append(names, stringField(u, "path"))
+		default:
+			names = append(names, capture)
+		}
+	}
+
+	return names
+}
+
+func compileBlock(statements []interface{}) *block {
+	b := &block{names: scopeNames(statements)}
+	for _, s := range statements {
+		if st := compileStatement(s); st != nil {
+			b.statements = append(b.statements, st)
+		}
+	}
+
+	return b
+}
+
+func compileStatementList(n interface{}) *block {
+	return compileBlock(listField(n, "statements"))
+}
+
+func constant(v interface{}) expression {
+	return func(*scope) interface{} { return v }
+}
+
+func symbol(n interface{}) expression {
+	name := stringField(n, "name")
+	return func(s *scope) interface{} { return *s.lookup(name) }
+}
+
+func compileList(n interface{}) expression {
+	type item struct {
+		spread bool
+		value  expression
+	}
+
+	var items []item
+	for _, v := range listField(n, "values") {
+		if nodeType(v) == "spread" {
+			items = append(items, item{spread: true, value: compileExpression(field(v, "value"))})
+			continue
+		}
+
+		items = append(items, item{value: compileExpression(v)})
+	}
+
+	return func(s *scope) interface{} {
+		var (
+			l      = mml.NewList()
+			simple []interface{}
+		)
+
+		for _, i := range items {
+			if !i.spread {
+				simple = append(simple, i.value(s))
+				continue
+			}
+
+			if len(simple) > 0 {
+				l, simple = l.Append(simple...), nil
+			}
+
+			l = l.Concat(i.value(s).(*mml.List))
+		}
+
+		if len(simple) > 0 {
+			l = l.Append(simple...)
+		}
+
+		return l
+	}
+}
+
+func compileArgs(args []interface{}) func(*scope) []interface{} {
+	for _, a := range args {
+		if nodeType(a) == "spread" {
+			l := compileList(mml.NewStruct(nil).With("values", mml.NewList(args...)))
+			return func(s *scope) []interface{} { return l(s).(*mml.List).Items() }
+		}
+	}
+
+	values := make([]expression, len(args))
+	for i, a := range args {
+		values[i] = compileExpression(a)
+	}
+
+	return func(s *scope) []interface{} {
+		a := make([]interface{}, len(values))
+		for i, v := range values {
+			a[i] = v(s)
+		}
+
+		return a
+	}
+}
+
+func entryKey(k interface{}) expression {
+	switch {
+	case nodeType(k) == "":
+		return constant(k)
+	case nodeType(k) == "symbol":
+		return constant(stringField(k, "name"))
+	default:
+		v := compileExpression(field(k, "value"))
+		return func(s *scope) interface{} { return v(s).(string) }
+	}
+}
+
+func compileStruct(n interface{}) expression {
+	type entry struct {
+		spread     bool
+		key, value expression
+	}
+
+	var entries []entry
+	for _, e := range listField(n, "entries") {
+		if nodeType(e) == "spread" {
+			entries = append(entries, entry{spread: true, value: compileExpression(field(e, "value"))})
+			continue
+		}
+
+		entries = append(entries, entry{key: entryKey(field(e, "key")), value: compileExpression(field(e, "value"))})
+	}
+
+	return func(s *scope) interface{} {
+		st := mml.NewStruct(nil)
+		for _, e := range entries {
+			if e.spread {
+				st = st.Merge(e.value(s).(*mml.Struct))
+				continue
+			}
+
+			st = st.With(e.key(s).(string), e.value(s))
+		}
+
+		return st
+	}
+}
+
+func compileFunction(n interface{}) expression {
+	var (
+		params  = stringsField(n, "params")
+		collect = stringField(n, "collectParam")
+		body    *block
+		value   expression
+		names   = append([]string(nil), params...)
+	)
+
+	if collect != "" {
+		names = append(names, collect)
+	}
+
+	// the body of a function can be a single statement, too, e.g. an assignment
+	switch st := field(n, "statement"); nodeType(st) {
+	case "statement-list":
+		body = compileStatementList(st)
+	case "assign-list", "send", "go", "defer", "select", "loop":
+		body = compileBlock([]interface{}{st})
+	default:
+		value = compileExpression(st)
+	}
+
+	if body != nil {
+		names = append(names, body.names...)
+	}
+
+	return func(s *scope) interface{} {
+		return &mml.Function{
+			F: func(a []interface{}) interface{} {
+				fs := newScope(s, names)
+				for i, p := range params {
+					*fs.vars[p] = a[i]
+				}
+
+				if collect != "" {
+					*fs.vars[collect] = mml.NewList(a[len(params):]...)
+				}
+
+				fs.frame = &frame{}
+				defer fs.frame.runDeferred()
+
+				if body == nil {
+					return value(fs)
+				}
+
+				_, v := body.run(fs)
+				return v
+			},
+			FixedArgs: len(params),
+		}
+	}
+}
+
+func compileIndexer(n interface{}) expression {
+	var (
+		p     = position(n)
+		e     = compileExpression(field(n, "expression"))
+		index = field(n, "index")
+	)
+
+	if nodeType(index) != "range-expression" {
+		i := compileExpression(index)
+		return func(s *scope) interface{} { return mml.Ref(p, e(s), i(s)) }
+	}
+
+	from, to := constant(nil), constant(nil)
+	if has(index, "from") {
+		from = compileExpression(field(index, "from"))
+	}
+
+	if has(index, "to") {
+		to = compileExpression(field(index, "to"))
+	}
+
+	return func(s *scope) interface{} { return mml.RefRange(p, e(s), from(s), to(s)) }
+}
+
+func compileInterop(n interface{}) expression {
+	var (
+		p    = position(n)
+		args = listField(n, "args")
+	)
+
+	path, _ := args[0].(string)
+	name, _ := args[1].(string)
+	// a missing Go function fails only when it is called, this way the modules using it can still be loaded
+	g, ok := packages[path][name]
+	if !ok {
+		return constant(&mml.Function{F: func([]interface{}) interface{} {
+			panic(&mml.RuntimeError{Pos: p, Message: fmt.Sprintf("interop: not available in the interpreter: %s.%s", path, name)})
+		}})
+	}
+
+	return constant(mml.Interop(p, path+"."+name, g))
+}
+
+// returns the function and the arguments of an application, used by the go and defer statements, too
+func compileApplication(n interface{}) func(*scope) (*mml.Function, []interface{}) {
+	if nodeType(n) != "function-application" {
+		unsupported(n)
+	}
+
+	var (
+		f    = compileExpression(field(n, "function"))
+		args = compileArgs(listField(n, "args"))
+	)
+
+	return func(s *scope) (*mml.Function, []interface{}) {
+		return f(s).(*mml.Function), args(s)
+	}
+}
+
+func compileCall(n interface{}) expression {
+	var (
+		p = position(n)
+		a = compileApplication(n)
+	)
+
+	return func(s *scope) interface{} {
+		f, args := a(s)
+		return f.CallAt(p, args)
+	}
+}
+
+func compileUnary(n interface{}) expression {
+	var (
+		p   = position(n)
+		op  = intField(n, "op")
+		arg = compileExpression(field(n, "arg"))
+	)
+
+	if op == logicalNot {
+		return func(s *scope) interface{} { return !arg(s).(bool) }
+	}
+
+	return func(s *scope) interface{} { return mml.UnaryOp(p, op, arg(s)) }
+}
+
+func compileBinary(n interface{}) expression {
+	var (
+		p     = position(n)
+		op    = intField(n, "op")
+		left  = compileExpression(field(n, "left"))
+		right = compileExpression(field(n, "right"))
+	)
+
+	switch op {
+	case logicalAnd:
+		return func(s *scope) interface{} { return left(s).(bool) && right(s).(bool) }
+	case logicalOr:
+		return func(s *scope) interface{} { return left(s).(bool) || right(s).(bool) }
+	default:
+		return func(s *scope) interface{} { return mml.BinaryOp(p, op, left(s), right(s)) }
+	}
+}
+
+func compileTernary(n interface{}) expression {
+	var (
+		condition   = compileExpression(field(n, "condition"))
+		consequent  = compileExpression(field(n, "consequent"))
+		alternative = compileExpression(field(n, "alternative"))
+	)
+
+	return func(s *scope) interface{} {
+		if condition(s).(bool) {
+			return consequent(s)
+		}
+
+		return alternative(s)
+	}
+}
+
+func compileReceive(n interface{}) expression {
+	c := compileExpression(field(n, "channel"))
+	return func(s *scope) interface{} { return <-c(s).(*mml.Channel).C }
+}
+
+func compileExpression(n interface{}) expression {
+	switch n.(type) {
+	case int, float64, string, bool:
+		return constant(n)
+	}
+
+	switch nodeType(n) {
+	case "symbol":
+		return symbol(n)
+	case "list":
+		return compileList(n)
+	case "struct":
+		return compileStruct(n)
+	case "function":
+		return compileFunction(n)
+	case "indexer":
+		return compileIndexer(n)
+	case "interop":
+		return compileInterop(n)
+	case "function-application":
+		return compileCall(n)
+	case "unary":
+		return compileUnary(n)
+	case "binary":
+		return compileBinary(n)
+	case "cond":
+		if !boolField(n, "ternary") {
+			unsupported(n)
+		}
+
+		return compileTernary(n)
+	case "receive":
+		return compileReceive(n)
+	default:
+		unsupported(n)
+		return nil
+	}
+}
+
+// a nested statement list gets its own scope
+func nested(b *block) statement {
+	return func(s *scope) (control, interface{}) {
+		return b.run(newScope(s, b.names))
+	}
+}
+
+func compileIf(n interface{}) statement {
+	var (
+		condition   = compileExpression(field(n, "condition"))
+		consequent  = compileStatement(field(n, "consequent"))
+		alternative statement
+	)
+
+	if has(n, "alternative") {
+		alternative = compileStatement(field(n, "alternative"))
+	}
+
+	return func(s *scope) (control, interface{}) {
+		if condition(s).(bool) {
+			return consequent(s)
+		}
+
+		if alternative == nil {
+			return next, nil
+		}
+
+		return alternative(s)
+	}
+}
+
+// like in Go, a switch without an expression selects the first case that is true, and break exits the switch
+func compileSwitch(n interface{}) statement {
+	type switchCase struct {
+		expression expression
+		body       *block
+	}
+
+	var (
+		tag         expression
+		cases       []switchCase
+		defaultCase = compileStatementList(field(n, "defaultStatements"))
+	)
+
+	if has(n, "expression") {
+		tag = compileExpression(field(n, "expression"))
+	}
+
+	for _, c := range listField(n, "cases") {
+		cases = append(cases, switchCase{
+			expression: compileExpression(field(c, "expression")),
+			body:       compileStatementList(field(c, "body")),
+		})
+	}
+
+	return func(s *scope) (control, interface{}) {
+		var t interface{} = true
+		if tag != nil {
+			t = tag(s)
+		}
+
+		body := defaultCase
+		for _, c := range cases {
+			if c.expression(s) == t {
+				body = c.body
+				break
+			}
+		}
+
+		ctl, v := body.run(newScope(s, body.names))
+		if ctl == broken {
+			return next, nil
+		}
+
+		return ctl, v
+	}
+}
+
+func compileSend(n interface{}) statement {
+	var (
+		c     = compileExpression(field(n, "channel"))
+		value = compileExpression(field(n, "value"))
+	)
+
+	return func(s *scope) (control, interface{}) {
+		c(s).(*mml.Channel).C <- value(s)
+		return next, nil
+	}
+}
+
+func compileSelectCase(n interface{}) selectCase {
+	var (
+		e  = field(n, "expression")
+		sc = selectCase{body: compileStatementList(field(n, "body"))}
+	)
+
+	if nodeType(e) == "definition" {
+		sc.symbol = stringField(e, "symbol")
+		sc.body.names = append(sc.body.names, sc.symbol)
+		e = field(e, "expression")
+	}
+
+	switch nodeType(e) {
+	case "send":
+		sc.dir = reflect.SelectSend
+		sc.channel = compileExpression(field(e, "channel"))
+		sc.value = compileExpression(field(e, "value"))
+	case "receive":
+		sc.dir = reflect.SelectRecv
+		sc.channel = compileExpression(field(e, "channel"))
+	default:
+		unsupported(e)
+	}
+
+	return sc
+}
+
+// the cases are selected dynamically with the reflect package
+func compileSelect(n interface{}) statement {
+	var cases []selectCase
+	for _, c := range listField(n, "cases") {
+		cases = append(cases, compileSelectCase(c))
+	}
+
+	if boolField(n, "hasDefault") {
+		cases = append(cases, selectCase{
+			dir:  reflect.SelectDefault,
+			body: compileStatementList(field(n, "defaultStatements")),
+		})
+	}
+
+	return func(s *scope) (control, interface{}) {
+		rc := make([]reflect.SelectCase, len(cases))
+		for i, c := range cases {
+			rc[i].Dir = c.dir
+			if c.dir == reflect.SelectDefault {
+				continue
+			}
+
+			rc[i].Chan = reflect.ValueOf(c.channel(s).(*mml.Channel).C)
+			if c.dir == reflect.SelectSend {
+				v := c.value(s)
+				rc[i].Send = reflect.ValueOf(&v).Elem()
+			}
+		}
+
+		chosen, received, _ := reflect.Select(rc)
+		c := cases[chosen]
+		cs := newScope(s, c.body.names)
+		if c.symbol != "" {
+			*cs.vars[c.symbol] = received.Interface()
+		}
+
+		ctl, v := c.body.run(cs)
+		if ctl == broken {
+			return next, nil
+		}
+
+		return ctl, v
+	}
+}
+
+func compileGo(n interface{}) statement {
+	a := compileApplication(field(n, "application"))
+	return func(s *scope) (control, interface{}) {
+		f, args := a(s)
+		go f.Call(args)
+		return next, nil
+	}
+}
+
+func compileDefer(n interface{}) statement {
+	a := compileApplication(field(n, "application"))
+	return func(s *scope) (control, interface{}) {
+		f, args := a(s)
+		s.frame.deferred = append(s.frame.deferred, func() { f.Call(args) })
+		return next, nil
+	}
+}
+
+// runs the body of a loop in a new scope for every iteration. The iteration sets the loop variables in the
+// scope, and returns false when the loop ends.
+func loop(names []string, body *block, iterate func(*scope, *scope) bool) statement {
+	names = append(names, body.names...)
+	return func(s *scope) (control, interface{}) {
+		for {
+			ls := newScope(s, names)
+			if !iterate(s, ls) {
+				return next, nil
+			}
+
+			switch ctl, v := body.run(ls); ctl {
+			case returned:
+				return ctl, v
+			case broken:
+				return next, nil
+			}
+		}
+	}
+}
+
+func set(s *scope, name string, value interface{}) {
+	if name != "" {
+		*s.vars[name] = value
+	}
+}
+
+func compileRangeLoop(r interface{}, body *block) statement {
+	var (
+		key     = stringField(r, "key")
+		symbol  = stringField(r, "symbol")
+		names   []string
+		e       = field(r, "expression")
+		from    = constant(0)
+		to      expression
+		counter bool
+	)
+
+	for _, n := range []string{key, symbol} {
+		if n != "" {
+			names = append(names, n)
+		}
+	}
+
+	switch {
+	case e == nil:
+		counter = true
+	case nodeType(e) == "range-expression":
+		counter = true
+		if has(e, "from") {
+			from = compileExpression(field(e, "from"))
+		}
+
+		if has(e, "to") {
+			to = compileExpression(field(e, "to"))
+		}
+	}
+
+	if !counter {
+		var (
+			p     = position(r)
+			value = compileExpression(e)
+		)
+
+		return func(s *scope) (control, interface{}) {
+			it := mml.Iterate(p, value(s))
+			return loop(names, body, func(_, ls *scope) bool {
+				if !it.Next() {
+					return false
+				}
+
+				set(ls, key, it.Key())
+				set(ls, symbol, it.Value())
+				return true
+			})(s)
+		}
+	}
+
+	return func(s *scope) (control, interface{}) {
+		var i, value int
+		first := true
+		return loop(names, body, func(s, ls *scope) bool {
+			if first {
+				value, first = from(s).(int), false
+			} else {
+				i, value = i+1, value+1
+			}
+
+			if to != nil && value >= to(ls).(int) {
+				return false
+			}
+
+			set(ls, key, i)
+			set(ls, symbol, value)
+			return true
+		})(s)
+	}
+}
+
+func compileLoop(n interface{}) statement {
+	body := compileStatementList(field(n, "body"))
+	if !has(n, "expression") {
+		return loop(nil, body, func(_, _ *scope) bool { return true })
+	}
+
+	e := field(n, "expression")
+	if nodeType(e) == "range-over" {
+		return compileRangeLoop(e, body)
+	}
+
+	condition := compileExpression(e)
+	return loop(nil, body, func(s, _ *scope) bool { return condition(s).(bool) })
+}
+
+func compileDefinition(n interface{}) statement {
+	var (
+		symbol   = stringField(n, "symbol")
+		value    = compileExpression(field(n, "expression"))
+		exported = boolField(n, "exported")
+	)
+
+	return func(s *scope) (control, interface{}) {
+		v := value(s)
+		*s.vars[symbol] = v
+		if exported {
+			s.module.exports.Set(symbol, v)
+		}
+
+		return next, nil
+	}
+}
+
+func compileAssign(n interface{}) statement {
+	var (
+		capture = field(n, "capture")
+		value   = compileExpression(field(n, "value"))
+	)
+
+	if nodeType(capture) == "symbol" {
+		name := stringField(capture, "name")
+		return func(s *scope) (control, interface{}) {
+			*s.lookup(name) = value(s)
+			return next, nil
+		}
+	}
+
+	var (
+		p     = position(capture)
+		e     = compileExpression(field(capture, "expression"))
+		index = compileExpression(field(capture, "index"))
+	)
+
+	return func(s *scope) (control, interface{}) {
+		mml.SetRef(p, e(s), index(s), value(s))
+		return next, nil
+	}
+}
+
+func compileReturn(n interface{}) statement {
+	value := constant(nil)
+	if has(n, "value") {
+		value = compileExpression(field(n, "value"))
+	}
+
+	return func(s *scope) (control, interface{}) {
+		return returned, value(s)
+	}
+}
+
+func compileControl(n interface{}) statement {
+	c := continued
+	if intField(n, "control") == breakControl {
+		c = broken
+	}
+
+	return func(*scope) (control, interface{}) {
+		return c, nil
+	}
+}
+
+func compileUse(n interface{}) statement {
+	var (
+		p       = position(n)
+		path    = stringField(n, "path")
+		module  = stringField(n, "modulePath")
+		capture = stringField(n, "capture")
+		names   = stringsField(n, "exportNames")
+	)
+
+	return func(s *scope) (control, interface{}) {
+		m := s.module.loader.Use(module)
+		switch capture {
+		case ".":
+			for _, name := range names {
+				*s.vars[name] = mml.Ref(p, m, name)
+			}
+		case "":
+			*s.vars[path] = m
+		default:
+			*s.vars[capture] = m
+		}
+
+		return next, nil
+	}
+}
+
+func sequence(items []interface{}) statement {
+	var statements []statement
+	for _, i := range items {
+		statements = append(statements, compileStatement(i))
+	}
+
+	return (&block{statements: statements}).run
+}
+
+func compileStatement(n interface{}) statement {
+	switch nodeType(n) {
+	case "comment":
+		return nil
+	case "statement-list":
+		return nested(compileStatementList(n))
+	case "cond":
+		if !boolField(n, "ternary") {
+			return compileIf(n)
+		}
+	case "switch-statement":
+		return compileSwitch(n)
+	case "send":
+		return compileSend(n)
+	case "select":
+		return compileSelect(n)
+	case "go":
+		return compileGo(n)
+	case "defer":
+		return compileDefer(n)
+	case "loop":
+		return compileLoop(n)
+	case "definition":
+		return compileDefinition(n)
+	case "definition-list":
+		return sequence(listField(n, "definitions"))
+	case "assign":
+		return compileAssign(n)
+	case "assign-list":
+		return sequence(listField(n, "assignments"))
+	case "ret":
+		return compileReturn(n)
+	case "control-statement":
+		return compileControl(n)
+	case "use":
+		return compileUse(n)
+	case "use-list":
+		return sequence(listField(n, "uses"))
+	}
+
+	e := compileExpression(n)
+	return func(s *scope) (control, interface{}) {
+		e(s)
+		return next, nil
+	}
+}
+
+func compileModule(root *scope, m interface{}) func(*mml.Loader) *mml.Struct {
+	b := compileBlock(listField(m, "statements"))
+	return func(l *mml.Loader) *mml.Struct {
+		s := newScope(root, b.names)
+		s.frame = &frame{}
+		s.module = &module{loader: l, exports: mml.NewStruct(nil)}
+		defer s.frame.runDeferred()
+		b.run(s)
+		return s.module.exports
+	}
+}
+
+// the panics of the mml code are errors, while other panics are bugs
+func recoverError(err *error) {
+	if r := recover(); r != nil {
+		if e, ok := r.(error); ok {
+			*err = e
+			return
+		}
+
+		panic(r)
+	}
+}
+
+func compileModules(root *scope, modules []interface{}) (c *mml.ModuleContext, err error) {
+	defer recoverError(&err)
+	c = mml.NewModuleContext()
+	for _, m := range modules {
+		c.Set(stringField(m, "path"), compileModule(root, m))
+	}
+
+	return c, nil
+}
+
+func rootScope(args interface{}) *scope {
+	root := newScope(nil, nil)
+	for name, value := range builtins() {
+		v := value
+		root.vars[name] = &v
+	}
+
+	root.vars["args"] = &args
+	return root
+}
+
+// Run executes a program from its modules, as returned by the parser of the compiler. It expects the path of the
+// main module, and the arguments of the program. It returns an error when the program fails.
+var Run = mml.NewGoFunction(
+	mml.Signature(mml.ErrorType, mml.ListType, mml.StringType, mml.ListType),
+	func(a, _ []interface{}) interface{} {
+		c, err := compileModules(rootScope(a[2]), a[0].(*mml.List).Items())
+		if err != nil {
+			return err
+		}
+
+		// like the compiled programs, the interpreter reports the original failure of the program:
+		_, err = c.Load(a[1].(string))
+		if me, ok := err.(*mml.ModuleError); ok && me.Unwrap() != nil {
+			return me.Unwrap()
+		}
+
+		return err
+	},
+)
@@ -0,0 +1,115 @@
+package interpret
+
+import "github.com/aryszka/mml"
+
+// session holds the state of the REPL between the lines of the input. Every line that defines new names gets
+// a new scope, the child of the previous one. The scopes are not changed after the line was executed, because
+// the goroutines started by the earlier lines may still use them.
+type session struct {
+	context *mml.ModuleContext
+	root    *scope
+	current *scope
+	module  *module
+	modules map[string]bool
+}
+
+// the REPL prints the value of the expression statements
+func isExpression(n interface{}) bool {
+	switch n.(type) {
+	case int, float64, string, bool:
+		return true
+	}
+
+	switch nodeType(n) {
+	case "symbol", "list", "struct", "function", "indexer", "interop", "function-application", "unary", "binary",
+		"receive":
+		return true
+	case "cond":
+		return boolField(n, "ternary")
+	default:
+		return false
+	}
+}
+
+func (s *session) register(modules []interface{}) {
+	for _, m := range modules {
+		path := stringField(m, "path")
+		if s.modules[path] {
+			continue
+		}
+
+		s.context.Set(path, compileModule(s.root, m))
+		s.modules[path] = true
+	}
+}
+
+func (s *session) eval(modules []interface{}, code interface{}) (result *mml.Struct, err error) {
+	defer recoverError(&err)
+	s.register(modules)
+
+	var (
+		st   statement
+		e    expression
+		list = []interface{}{code}
+	)
+
+	if isExpression(code) {
+		e = compileExpression(code)
+	} else {
+		st = compileStatement(code)
+	}
+
+	// the new names are kept even when the line fails, because they are already defined for the validation
+	ls := newScope(s.current, scopeNames(list))
+	if len(ls.vars) > 0 {
+		s.current = ls
+	}
+
+	ls.frame = &frame{}
+	ls.module = s.module
+	defer ls.frame.runDeferred()
+
+	result = mml.NewStruct(nil)
+	switch {
+	case e != nil:
+		if v := e(ls); v != nil {
+			result = result.With("value", v)
+		}
+	case st != nil:
+		st(ls)
+	}
+
+	return result, nil
+}
+
+// NewSession creates the state of the REPL. It expects the arguments of the program.
+var NewSession = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.ListType),
+	func(a, _ []interface{}) interface{} {
+		root := rootScope(a[0])
+		c := mml.NewModuleContext()
+		return &session{
+			context: c,
+			root:    root,
+			current: root,
+			module:  &module{loader: c, exports: mml.NewStruct(nil)},
+			modules: make(map[string]bool),
+		}
+	},
+)
+
+// Eval executes a top level statement in the REPL, after loading the modules that it uses. The modules are
+// loaded only once, when they were not loaded by an earlier statement. When the statement is an expression, and
+// its value is not nil, it returns a structure with the value in the value field, otherwise an empty structure,
+// or an error when the statement fails.
+var Eval = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.AnyType, mml.ListType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		result, err := a[0].(*session).eval(a[1].(*mml.List).Items(), a[2])
+		if err != nil {
+			return err
+		}
+
+		return result
+	},
+)
@@ -0,0 +1,286 @@
+// Package json implements the Go side of the json module of the mml standard library. JSON objects are
+// represented as structures, arrays as lists, numbers as ints or floats, and null as the value of Null.
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/aryszka/mml"
+)
+
+type null struct{}
+
+func (null) String() string {
+	return "json.null"
+}
+
+// NullValue represents the JSON null. It is different from every other mml value, including the empty ones.
+var NullValue = null{}
+
+// the syntax errors are mml errors, carrying the byte offset where the parsing failed as their data
+func syntaxError(message string, offset int64) error {
+	return &mml.ErrorValue{
+		Message: fmt.Sprintf("json: %s at offset %d", message, offset),
+		Data:    mml.NewStruct(nil).With("offset", int(offset)),
+	}
+}
+
+func decodeError(d *json.Decoder, err error) error {
+	switch et := err.(type) {
+	case *json.SyntaxError:
+		return syntaxError(strings.TrimPrefix(et.Error(), "json: "), et.Offset)
+	default:
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return syntaxError("unexpected end of JSON input", d.InputOffset())
+		}
+
+		return syntaxError(err.Error(), d.InputOffset())
+	}
+}
+
+// the integers that don't fit in an int are parsed as floats, and the numbers that don't fit in a float are
+// syntax errors
+func number(d *json.Decoder, n json.Number) (interface{}, error) {
+	if i, err := strconv.ParseInt(string(n), 10, 0); err == nil {
+		return int(i), nil
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return nil, syntaxError("number out of range: "+string(n), d.InputOffset()-int64(len(n)))
+	}
+
+	return f, nil
+}
+
+func parseArray(d *json.Decoder) (interface{}, error) {
+	l := mml.NewList()
+	for d.More() {
+		v, err := parseValue(d)
+		if err != nil {
+			return nil, err
+		}
+
+		l = l.Append(v)
+	}
+
+	if _, err := d.Token(); err != nil {
+		return nil, decodeError(d, err)
+	}
+
+	return l, nil
+}
+
+// the keys of the structure keep the order of the object, and in case of duplicate keys, the last value wins
+func parseObject(d *json.Decoder) (interface{}, error) {
+	s := mml.NewStruct(nil)
+	for d.More() {
+		k, err := d.Token()
+		if err != nil {
+			return nil, decodeError(d, err)
+		}
+
+		v, err := parseValue(d)
+		if err != nil {
+			return nil, err
+		}
+
+		s = s.With(k.(string), v)
+	}
+
+	if _, err := d.Token(); err != nil {
+		return nil, decodeError(d, err)
+	}
+
+	return s, nil
+}
+
+func parseValue(d *json.Decoder) (interface{}, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, decodeError(d, err)
+	}
+
+	switch tt := t.(type) {
+	case json.Delim:
+		if tt == '[' {
+			return parseArray(d)
+		}
+
+		return parseObject(d)
+	case json.Number:
+		return number(d, tt)
+	case nil:
+		return NullValue, nil
+	default:
+		return tt, nil
+	}
+}
+
+func parse(s string) (interface{}, error) {
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	v, err := parseValue(d)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := d.InputOffset()
+	if _, err := d.Token(); err != io.EOF {
+		if err != nil {
+			return nil, decodeError(d, err)
+		}
+
+		return nil, syntaxError("unexpected data after the value", offset)
+	}
+
+	return v, nil
+}
+
+func stringifyString(b *bytes.Buffer, s string) {
+	e := json.NewEncoder(b)
+	e.SetEscapeHTML(false)
+	e.Encode(s)
+	b.Truncate(b.Len() - 1)
+}
+
+// like in mml notation, the floats are rendered with a decimal point or an exponent, so that they are parsed
+// back as floats
+func stringifyFloat(b *bytes.Buffer, f float64) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return fmt.Errorf("json: unsupported value: %v", f)
+	}
+
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	b.WriteString(s)
+	if !strings.ContainsAny(s, ".e") {
+		b.WriteString(".0")
+	}
+
+	return nil
+}
+
+func stringifyValue(b *bytes.Buffer, v interface{}) error {
+	switch vt := v.(type) {
+	case null:
+		b.WriteString("null")
+	case bool:
+		b.WriteString(strconv.FormatBool(vt))
+	case int:
+		b.WriteString(strconv.Itoa(vt))
+	case float64:
+		return stringifyFloat(b, vt)
+	case string:
+		stringifyString(b, vt)
+	case *mml.List:
+		b.WriteByte('[')
+		for i, item := range vt.Items() {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+
+			if err := stringifyValue(b, item); err != nil {
+				return err
+			}
+		}
+
+		b.WriteByte(']')
+	case *mml.Struct:
+		b.WriteByte('{')
+		for i, k := range vt.Keys() {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+
+			stringifyString(b, k)
+			b.WriteByte(':')
+			item, _ := vt.Get(k)
+			if err := stringifyValue(b, item); err != nil {
+				return err
+			}
+		}
+
+		b.WriteByte('}')
+	default:
+		return fmt.Errorf("json: unsupported value: %v", v)
+	}
+
+	return nil
+}
+
+func stringOption(options *mml.Struct, name, defaultValue string) (string, error) {
+	v, ok := options.Get(name)
+	if !ok {
+		return defaultValue, nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("json: invalid option: %s", name)
+	}
+
+	return s, nil
+}
+
+func stringify(v interface{}, options *mml.Struct) (interface{}, error) {
+	var b bytes.Buffer
+	if err := stringifyValue(&b, v); err != nil {
+		return nil, err
+	}
+
+	if options == nil {
+		return b.String(), nil
+	}
+
+	prefix, err := stringOption(options, "prefix", "")
+	if err != nil {
+		return nil, err
+	}
+
+	indent, err := stringOption(options, "indent", "\t")
+	if err != nil {
+		return nil, err
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, b.Bytes(), prefix, indent); err != nil {
+		return nil, err
+	}
+
+	return pretty.String(), nil
+}
+
+func result(v interface{}, err error) interface{} {
+	if err != nil {
+		return err
+	}
+
+	return v
+}
+
+var Null = mml.NewGoFunction(mml.Signature(mml.AnyType), func([]interface{}, []interface{}) interface{} {
+	return NullValue
+})
+
+var Parse = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.StringType), func(a, _ []interface{}) interface{} {
+	return result(parse(a[0].(string)))
+})
+
+var Stringify = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.AnyType), func(a, _ []interface{}) interface{} {
+	return result(stringify(a[0], nil))
+})
+
+// the options can contain an indent and a prefix string, used as in Go's encoding/json.Indent. The default
+// indent is a tab.
+var StringifyWith = mml.NewGoFunction(
+	mml.Signature(mml.AnyType, mml.StructType, mml.AnyType),
+	func(a, _ []interface{}) interface{} {
+		return result(stringify(a[1], a[0].(*mml.Struct)))
+	},
+)
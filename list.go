@@ -0,0 +1,290 @@
+package mml
+
+import "sync/atomic"
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// the children of the inner nodes are *vectorNode values, while the leaves store the items
+type vectorNode [vectorWidth]interface{}
+
+// vector is a persistent bit-partitioned vector trie with a tail buffer. Pushing, setting and getting an item
+// takes O(log32 n) steps, and the unchanged nodes are shared between the versions.
+type vector struct {
+	count int
+	shift uint
+	root  *vectorNode
+	tail  []interface{}
+}
+
+// List is an immutable sequence of values. It is a view over a persistent vector, this way slicing takes
+// constant time, while appending and setting an item takes O(log n) steps.
+type List struct {
+	// Values contains the items of the list.
+	//
+	// Deprecated: use Len, Get and Items. Values is kept in sync with the list, and it must not be changed.
+	Values []interface{}
+
+	vector *vector
+	offset int
+	length int
+
+	// the backing slice of Values. The lists sharing its array append to it in place, as long as there is free
+	// capacity left after their last item. Otherwise, and when setting an item of a shared array, it is copied.
+	items  []interface{}
+	free   *int64
+	shared int32
+}
+
+var emptyVector = &vector{shift: vectorBits, root: &vectorNode{}}
+
+func (v *vector) tailOffset() int {
+	if v.count < vectorWidth {
+		return 0
+	}
+
+	return ((v.count - 1) >> vectorBits) << vectorBits
+}
+
+func (v *vector) leaf(i int) []interface{} {
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+
+	n := v.root
+	for level := v.shift; level > 0; level -= vectorBits {
+		n = n[(i>>level)&vectorMask].(*vectorNode)
+	}
+
+	return n[:]
+}
+
+func (v *vector) get(i int) interface{} {
+	return v.leaf(i)[i&vectorMask]
+}
+
+func newPath(level uint, n *vectorNode) *vectorNode {
+	if level == 0 {
+		return n
+	}
+
+	p := &vectorNode{}
+	p[0] = newPath(level-vectorBits, n)
+	return p
+}
+
+func (v *vector) pushTail(level uint, parent, tail *vectorNode) *vectorNode {
+	n := *parent
+	i := ((v.count - 1) >> level) & vectorMask
+	switch child, ok := parent[i].(*vectorNode); {
+	case level == vectorBits:
+		n[i] = tail
+	case ok && child != nil:
+		n[i] = v.pushTail(level-vectorBits, child, tail)
+	default:
+		n[i] = newPath(level-vectorBits, tail)
+	}
+
+	return &n
+}
+
+func (v *vector) push(item interface{}) *vector {
+	if v.count-v.tailOffset() < vectorWidth {
+		tail := make([]interface{}, len(v.tail)+1)
+		copy(tail, v.tail)
+		tail[len(v.tail)] = item
+		return &vector{count: v.count + 1, shift: v.shift, root: v.root, tail: tail}
+	}
+
+	tail := &vectorNode{}
+	copy(tail[:], v.tail)
+
+	var (
+		root  *vectorNode
+		shift = v.shift
+	)
+
+	if (v.count >> vectorBits) > (1 << v.shift) {
+		root = &vectorNode{}
+		root[0] = v.root
+		root[1] = newPath(v.shift, tail)
+		shift += vectorBits
+	} else {
+		root = v.pushTail(v.shift, v.root, tail)
+	}
+
+	return &vector{count: v.count + 1, shift: shift, root: root, tail: []interface{}{item}}
+}
+
+func setNode(level uint, n *vectorNode, i int, item interface{}) *vectorNode {
+	c := *n
+	if level == 0 {
+		c[i&vectorMask] = item
+		return &c
+	}
+
+	ci := (i >> level) & vectorMask
+	c[ci] = setNode(level-vectorBits, n[ci].(*vectorNode), i, item)
+	return &c
+}
+
+func (v *vector) set(i int, item interface{}) *vector {
+	if i >= v.tailOffset() {
+		tail := make([]interface{}, len(v.tail))
+		copy(tail, v.tail)
+		tail[i&vectorMask] = item
+		return &vector{count: v.count, shift: v.shift, root: v.root, tail: tail}
+	}
+
+	return &vector{count: v.count, shift: v.shift, root: setNode(v.shift, v.root, i, item), tail: v.tail}
+}
+
+// NewList creates a list from the provided values.
+func NewList(values ...interface{}) *List {
+	return (&List{}).Append(values...)
+}
+
+func (l *List) vec() *vector {
+	if l.vector == nil {
+		return emptyVector
+	}
+
+	return l.vector
+}
+
+// Len returns the number of items in the list.
+func (l *List) Len() int {
+	return l.length
+}
+
+// Get returns the item at the index i. It panics when i is out of range.
+func (l *List) Get(i int) interface{} {
+	if i < 0 || i >= l.length {
+		panic("list: index out of range")
+	}
+
+	return l.vec().get(l.offset + i)
+}
+
+// Set replaces the item at the index i in place. It is used only with mutable lists.
+func (l *List) Set(i int, item interface{}) {
+	if i < 0 || i >= l.length {
+		panic("list: index out of range")
+	}
+
+	l.vector = l.vec().set(l.offset+i, item)
+	if atomic.LoadInt32(&l.shared) != 0 {
+		l.items, l.free = copyItems(l.items, nil)
+		atomic.StoreInt32(&l.shared, 0)
+	}
+
+	l.items[i] = item
+	l.Values = l.items[:l.length:l.length]
+}
+
+func copyItems(items, values []interface{}) ([]interface{}, *int64) {
+	c := make([]interface{}, len(items)+len(values), 2*(len(items)+len(values)))
+	copy(c, items)
+	copy(c[len(items):], values)
+	free := int64(cap(c) - len(c))
+	return c, &free
+}
+
+// appends in place when no other list took the capacity after the last item yet
+func (l *List) appendItems(values []interface{}) ([]interface{}, *int64, int32) {
+	free := int64(cap(l.items) - len(l.items))
+	if l.free != nil && free >= int64(len(values)) &&
+		atomic.CompareAndSwapInt64(l.free, free, free-int64(len(values))) {
+		atomic.StoreInt32(&l.shared, 1)
+		return append(l.items, values...), l.free, 1
+	}
+
+	items, f := copyItems(l.items, values)
+	return items, f, 0
+}
+
+// Append returns a new list with the values appended to the items of the current one.
+func (l *List) Append(values ...interface{}) *List {
+	v, offset := l.vec(), l.offset
+
+	// dropping the unreachable head of the vector, when it got larger than the list itself:
+	if offset > vectorWidth && offset > l.length {
+		v = emptyVector
+		for i := 0; i < l.length; i++ {
+			v = v.push(l.Get(i))
+		}
+
+		offset = 0
+	}
+
+	end := offset + l.length
+	for _, item := range values {
+		if end < v.count {
+			v = v.set(end, item)
+		} else {
+			v = v.push(item)
+		}
+
+		end++
+	}
+
+	items, free, shared := l.appendItems(values)
+	return &List{
+		Values: items[:len(items):len(items)],
+		vector: v,
+		offset: offset,
+		length: l.length + len(values),
+		items:  items,
+		free:   free,
+		shared: shared,
+	}
+}
+
+// Concat returns a new list with the items of both lists.
+func (l *List) Concat(c *List) *List {
+	if l.length == 0 {
+		return c.Slice(0, c.length)
+	}
+
+	return l.Append(c.items...)
+}
+
+// Slice returns the items between from and to as a new list, in constant time. It panics when the range is
+// invalid.
+func (l *List) Slice(from, to int) *List {
+	if from < 0 || to > l.length || from > to {
+		panic("list: slice out of range")
+	}
+
+	atomic.StoreInt32(&l.shared, 1)
+	items := l.items[from:to]
+	return &List{
+		Values: items[:len(items):len(items)],
+		vector: l.vector,
+		offset: l.offset + from,
+		length: to - from,
+		items:  items,
+		free:   l.free,
+		shared: 1,
+	}
+}
+
+// Items returns the items of the list as a Go slice. Changing the returned slice doesn't change the list.
+func (l *List) Items() []interface{} {
+	values := make([]interface{}, 0, l.length)
+	v := l.vec()
+	for i := l.offset; i < l.offset+l.length; {
+		leaf := v.leaf(i)[i&vectorMask:]
+		if rest := l.offset + l.length - i; len(leaf) > rest {
+			leaf = leaf[:rest]
+		}
+
+		values = append(values, leaf...)
+		i += len(leaf)
+	}
+
+	return values
+}
@@ -0,0 +1,180 @@
+package mml
+
+import "testing"
+
+// more items than what fits in three levels of the trie
+const manyItems = vectorWidth*vectorWidth*vectorWidth + 3*vectorWidth + 7
+
+func rangeList(n int) *List {
+	l := NewList()
+	for i := 0; i < n; i++ {
+		l = l.Append(i)
+	}
+
+	return l
+}
+
+func checkItems(t *testing.T, l *List, expected ...interface{}) {
+	t.Helper()
+	if l.Len() != len(expected) {
+		t.Fatalf("invalid length: %d, expected: %d", l.Len(), len(expected))
+	}
+
+	for i, e := range expected {
+		if v := l.Get(i); v != e {
+			t.Fatalf("invalid item at %d: %v, expected: %v", i, v, e)
+		}
+	}
+
+	values := l.Items()
+	if len(values) != len(expected) {
+		t.Fatalf("invalid number of values: %d, expected: %d", len(values), len(expected))
+	}
+
+	for i, e := range expected {
+		if values[i] != e {
+			t.Fatalf("invalid value at %d: %v, expected: %v", i, values[i], e)
+		}
+	}
+
+	if len(l.Values) != len(expected) {
+		t.Fatalf("invalid length of the values field: %d, expected: %d", len(l.Values), len(expected))
+	}
+
+	for i, e := range expected {
+		if l.Values[i] != e {
+			t.Fatalf("invalid item in the values field at %d: %v, expected: %v", i, l.Values[i], e)
+		}
+	}
+}
+
+func ints(from, to int) []interface{} {
+	var v []interface{}
+	for i := from; i < to; i++ {
+		v = append(v, i)
+	}
+
+	return v
+}
+
+func TestListAppendAcrossNodeSplits(t *testing.T) {
+	var versions []*List
+	l := NewList()
+	for i := 0; i < manyItems; i++ {
+		l = l.Append(i)
+		switch i + 1 {
+		case vectorWidth, vectorWidth + 1, vectorWidth * vectorWidth, vectorWidth*vectorWidth + 1:
+			versions = append(versions, l)
+		}
+	}
+
+	checkItems(t, l, ints(0, manyItems)...)
+	for _, v := range versions {
+		checkItems(t, v, ints(0, v.Len())...)
+	}
+}
+
+func TestListAppendMultiple(t *testing.T) {
+	l := NewList(ints(0, 3*vectorWidth+5)...)
+	l = l.Append(ints(3*vectorWidth+5, manyItems)...)
+	checkItems(t, l, ints(0, manyItems)...)
+}
+
+func TestListSet(t *testing.T) {
+	original := rangeList(manyItems)
+	l := original.Slice(0, original.Len())
+	for _, i := range []int{0, vectorWidth - 1, vectorWidth, vectorWidth * vectorWidth, manyItems - 1} {
+		l.Set(i, -i)
+	}
+
+	expected := ints(0, manyItems)
+	checkItems(t, original, expected...)
+
+	for _, i := range []int{0, vectorWidth - 1, vectorWidth, vectorWidth * vectorWidth, manyItems - 1} {
+		expected[i] = -i
+	}
+
+	checkItems(t, l, expected...)
+}
+
+func TestListSetOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("failed to panic")
+		}
+	}()
+
+	l := NewList(1, 2, 3).Slice(1, 2)
+	l.Set(1, 42)
+}
+
+func TestListSlice(t *testing.T) {
+	l := rangeList(manyItems)
+	checkItems(t, l.Slice(0, 0))
+	checkItems(t, l.Slice(vectorWidth-1, vectorWidth+1), vectorWidth-1, vectorWidth)
+	checkItems(t, l.Slice(manyItems-3, manyItems), ints(manyItems-3, manyItems)...)
+	checkItems(t, l.Slice(5, manyItems).Slice(vectorWidth, vectorWidth+3), ints(vectorWidth+5, vectorWidth+8)...)
+}
+
+// appending to a slice that doesn't end at the end of the vector replaces the items after it only in the new
+// version
+func TestListAppendToSlice(t *testing.T) {
+	original := rangeList(3 * vectorWidth)
+	s := original.Slice(0, vectorWidth+1)
+	appended := s.Append("foo", "bar")
+
+	checkItems(t, original, ints(0, 3*vectorWidth)...)
+	checkItems(t, s, ints(0, vectorWidth+1)...)
+	checkItems(t, appended, append(ints(0, vectorWidth+1), "foo", "bar")...)
+}
+
+// appending to a short slice at the end of a long list doesn't keep the unreachable head of the vector
+func TestListAppendDropsHead(t *testing.T) {
+	original := rangeList(manyItems)
+	s := original.Slice(manyItems-3, manyItems)
+	appended := s.Append("foo")
+
+	checkItems(t, appended, append(ints(manyItems-3, manyItems), "foo")...)
+	if appended.offset != 0 || appended.vector.count != 4 {
+		t.Fatalf("head not dropped: offset %d, count %d", appended.offset, appended.vector.count)
+	}
+
+	checkItems(t, original, ints(0, manyItems)...)
+}
+
+func TestListConcat(t *testing.T) {
+	left := rangeList(vectorWidth + 3)
+	right := NewList(ints(vectorWidth+3, manyItems)...)
+
+	checkItems(t, left.Concat(right), ints(0, manyItems)...)
+	checkItems(t, NewList().Concat(right), ints(vectorWidth+3, manyItems)...)
+	checkItems(t, left.Concat(NewList()), ints(0, vectorWidth+3)...)
+	checkItems(t, left, ints(0, vectorWidth+3)...)
+}
+
+func TestListItemsCopy(t *testing.T) {
+	l := NewList(1, 2, 3)
+	l.Items()[0] = 42
+	checkItems(t, l, 1, 2, 3)
+}
+
+func TestListValuesShared(t *testing.T) {
+	base := NewList(1, 2, 3)
+	left := base.Append(4)
+	right := base.Append(5)
+	checkItems(t, left, 1, 2, 3, 4)
+	checkItems(t, right, 1, 2, 3, 5)
+	checkItems(t, base.Slice(1, 2).Append(6), 2, 6)
+	checkItems(t, left, 1, 2, 3, 4)
+
+	mutable := left.Slice(0, 4)
+	mutable.Set(0, 7)
+	checkItems(t, mutable, 7, 2, 3, 4)
+	checkItems(t, left, 1, 2, 3, 4)
+	checkItems(t, base, 1, 2, 3)
+
+	appended := mutable.Append(8)
+	mutable.Set(1, 9)
+	checkItems(t, mutable, 7, 9, 3, 4)
+	checkItems(t, appended, 7, 2, 3, 4, 8)
+}
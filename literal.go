@@ -0,0 +1,196 @@
+package mml
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var symbolExpression = regexp.MustCompile("^[a-zA-Z_][a-zA-Z_0-9]*$")
+
+var literalEscapes = map[rune]string{
+	'\b': "\\b",
+	'\f': "\\f",
+	'\n': "\\n",
+	'\r': "\\r",
+	'\t': "\\t",
+	'\v': "\\v",
+	'"':  "\\\"",
+	'\\': "\\\\",
+}
+
+func stringLiteral(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		if e, ok := literalEscapes[r]; ok {
+			b.WriteString(e)
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	b.WriteByte('"')
+	return b.String()
+}
+
+// the float literals need a decimal point or an exponent, otherwise they would be read back as ints. The
+// infinities and NaN have no literal, they are rendered as the float divisions producing them.
+func floatLiteral(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "1.0 / 0.0"
+	case math.IsInf(f, -1):
+		return "-1.0 / 0.0"
+	case math.IsNaN(f):
+		return "0.0 / 0.0"
+	}
+
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if strings.ContainsAny(s, ".e") {
+		return s
+	}
+
+	return s + ".0"
+}
+
+// the errors created with data or with a cause are rendered as calls to the errors module. The position of the
+// errors is not rendered, because it is not part of their equality.
+func errorLiteral(err error) string {
+	e, ok := err.(*ErrorValue)
+	switch {
+	case !ok || e.Data == nil && e.Cause == nil:
+		return "error(" + stringLiteral(err.Error()) + ")"
+	case e.Cause == nil:
+		return "errors.new(" + stringLiteral(e.Message) + ", " + literal(e.Data) + ")"
+	case e.Data == nil:
+		return "errors.wrap(" + stringLiteral(e.Message) + ", " + errorLiteral(e.Cause) + ")"
+	default:
+		return "errors.wrapWith(" + stringLiteral(e.Message) + ", " + literal(e.Data) + ", " + errorLiteral(e.Cause) + ")"
+	}
+}
+
+func keyLiteral(k string) string {
+	if symbolExpression.MatchString(k) {
+		return k
+	}
+
+	return stringLiteral(k)
+}
+
+// literal renders a value in mml notation. Lists, structures, strings, numbers, booleans and errors are
+// rendered in a form that evaluates to an equal value, where the errors with data or a cause expect the errors
+// module to be used as errors. Functions and channels have no such form.
+func literal(v interface{}) string {
+	switch vt := v.(type) {
+	case nil:
+		return "nil"
+	case bool:
+		return strconv.FormatBool(vt)
+	case int:
+		return strconv.Itoa(vt)
+	case float64:
+		return floatLiteral(vt)
+	case string:
+		return stringLiteral(vt)
+	case *List:
+		items := vt.Items()
+		s := make([]string, len(items))
+		for i := range items {
+			s[i] = literal(items[i])
+		}
+
+		return "[" + strings.Join(s, ", ") + "]"
+	case *Struct:
+		var s []string
+		vt.each(func(k string, v interface{}) {
+			s = append(s, keyLiteral(k)+": "+literal(v))
+		})
+
+		return "{" + strings.Join(s, ", ") + "}"
+	case *Function:
+		if vt.stream != nil {
+			return "<stream>"
+		}
+
+		return "<function>"
+	case *Channel:
+		return "<channel>"
+	case error:
+		return errorLiteral(vt)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// literalVerbs replaces the %m verbs with %s in a format string, and the corresponding arguments with their
+// representation in mml notation. Explicit argument indexes are not supported together with %m.
+func literalVerbs(f string, args []interface{}) (string, []interface{}) {
+	var (
+		b    strings.Builder
+		argi int
+	)
+
+	args = append([]interface{}(nil), args...)
+	for i := 0; i < len(f); i++ {
+		b.WriteByte(f[i])
+		if f[i] != '%' {
+			continue
+		}
+
+		j := i + 1
+		for j < len(f) && strings.IndexByte("+-# 0123456789.*", f[j]) >= 0 {
+			if f[j] == '*' {
+				argi++
+			}
+
+			j++
+		}
+
+		if j == len(f) {
+			b.WriteString(f[i+1:])
+			break
+		}
+
+		b.WriteString(f[i+1 : j])
+		switch f[j] {
+		case '%':
+			b.WriteByte('%')
+		case 'm':
+			b.WriteByte('s')
+			if argi < len(args) {
+				args[argi] = literal(args[argi])
+			}
+
+			argi++
+		default:
+			b.WriteByte(f[j])
+			argi++
+		}
+
+		i = j
+	}
+
+	return b.String(), args
+}
+
+// String returns the list in mml notation.
+func (l *List) String() string {
+	return literal(l)
+}
+
+// String returns the structure in mml notation.
+func (s *Struct) String() string {
+	return literal(s)
+}
+
+func (f *Function) String() string {
+	return literal(f)
+}
+
+func (c *Channel) String() string {
+	return literal(c)
+}
@@ -0,0 +1,34 @@
+package mml
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestLiteral(t *testing.T) {
+	notFound := NewError("not found", NewStruct(nil).With("code", 404), nil)
+	for _, test := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{3.0, "3.0"},
+		{1e21, "1e+21"},
+		{-0.5, "-0.5"},
+		{math.Inf(1), "1.0 / 0.0"},
+		{math.Inf(-1), "-1.0 / 0.0"},
+		{NewList(math.NaN()), "[0.0 / 0.0]"},
+		{NewError("failed", nil, nil), `error("failed")`},
+		{errors.New("go error"), `error("go error")`},
+		{notFound, `errors.new("not found", {code: 404})`},
+		{NewError("loading", nil, notFound), `errors.wrap("loading", errors.new("not found", {code: 404}))`},
+		{
+			NewError("startup", NewStruct(nil).With("attempt", 3), NewError("loading", nil, errors.New("io"))),
+			`errors.wrapWith("startup", {attempt: 3}, errors.wrap("loading", error("io")))`,
+		},
+	} {
+		if l := literal(test.value); l != test.expected {
+			t.Errorf("got: %s, expected: %s", l, test.expected)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package mml
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestModuleInitPanic(t *testing.T) {
+	failure := &RuntimeError{Pos: Pos{Path: "bar.mml", Line: 3, Column: 5}, Message: "failed"}
+	c := NewModuleContext()
+	c.Set("foo.mml", func(l *Loader) *Struct {
+		l.Use("bar.mml")
+		return NewStruct(nil)
+	})
+
+	c.Set("bar.mml", func(*Loader) *Struct {
+		panic(failure)
+	})
+
+	_, err := c.Load("foo.mml")
+	if me, ok := err.(*ModuleError); !ok || me.Path != "foo.mml" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !errors.Is(err, failure) {
+		t.Fatalf("original value not found: %v", err)
+	}
+
+	defer func() {
+		if r := recover(); r != failure {
+			t.Fatalf("unexpected panic: %v", r)
+		}
+	}()
+
+	c.Use("foo.mml")
+}
+
+func TestModuleNotFound(t *testing.T) {
+	c := NewModuleContext()
+	defer func() {
+		if me, ok := recover().(*ModuleError); !ok || me.Path != "foo.mml" || me.Value != nil {
+			t.Fatalf("unexpected panic: %v", me)
+		}
+	}()
+
+	c.Use("foo.mml")
+}
@@ -0,0 +1,142 @@
+// Package os implements the Go side of the os module of the mml standard library.
+package os
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+
+	"github.com/aryszka/mml"
+)
+
+// like mkdir -p, it creates the missing parent directories, too, and it is not an error when the directory
+// already exists
+var Mkdir = mml.NewGoFunction(mml.Signature(mml.ErrorType, mml.StringType), func(a, _ []interface{}) interface{} {
+	return os.MkdirAll(a[0].(string), 0777)
+})
+
+// TempDir creates a new, empty directory in the default location of the temporary files, and returns its path.
+var TempDir = mml.NewGoFunction(mml.Signature(mml.AnyType), func([]interface{}, []interface{}) interface{} {
+	dir, err := os.MkdirTemp("", "mml")
+	if err != nil {
+		return err
+	}
+
+	return dir
+})
+
+// Abs returns the absolute form of a path, relative to the working directory of the current process.
+var Abs = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.StringType), func(a, _ []interface{}) interface{} {
+	p, err := filepath.Abs(a[0].(string))
+	if err != nil {
+		return err
+	}
+
+	return p
+})
+
+// like rm -rf, it removes the directories with their contents, and it is not an error when the path doesn't
+// exist
+var Remove = mml.NewGoFunction(mml.Signature(mml.ErrorType, mml.StringType), func(a, _ []interface{}) interface{} {
+	return os.RemoveAll(a[0].(string))
+})
+
+// Run executes a command, a list of the program and its arguments, with the standard input and output of the
+// current process. It returns the exit status of the command, or an error when the command could not be
+// started.
+var Run = mml.NewGoFunction(mml.Signature(mml.AnyType, mml.ListType), func(a, _ []interface{}) interface{} {
+	var args []string
+	for _, ai := range a[0].(*mml.List).Items() {
+		s, ok := ai.(string)
+		if !ok {
+			return fmt.Errorf("run: unsupported argument: %v", ai)
+		}
+
+		args = append(args, s)
+	}
+
+	if len(args) == 0 {
+		return fmt.Errorf("run: missing command")
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	err := cmd.Run()
+	if ee, ok := err.(*exec.ExitError); ok {
+		return ee.ExitCode()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return 0
+})
+
+const runtimeModule = "github.com/aryszka/mml"
+
+// the version of the runtime module in the build info of the current binary, when it was built from a released
+// version
+func runtimeVersion() string {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	m := &bi.Main
+	if m.Path != runtimeModule {
+		m = nil
+		for _, d := range bi.Deps {
+			if d.Path == runtimeModule {
+				m = d
+				break
+			}
+		}
+	}
+
+	switch {
+	case m == nil:
+		return ""
+	case m.Replace != nil:
+		return m.Replace.Version
+	case m.Version == "(devel)":
+		return ""
+	default:
+		return m.Version
+	}
+}
+
+// the source directory of the runtime that the current binary was built with, when it is a Go module
+func runtimeDir() string {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok || !filepath.IsAbs(file) {
+		return ""
+	}
+
+	dir := filepath.Dir(filepath.Dir(file))
+	if _, err := os.Stat(filepath.Join(dir, "go.mod")); err != nil {
+		return ""
+	}
+
+	return dir
+}
+
+// Runtime returns the Go module of the runtime that the current binary was built with: the module path, and its
+// version, or, when it is not a released version, its source directory. The version and the directory are
+// empty when they are not known.
+var Runtime = mml.NewGoFunction(mml.Signature(mml.StructType), func([]interface{}, []interface{}) interface{} {
+	return mml.NewStruct(map[string]interface{}{
+		"module":  runtimeModule,
+		"version": runtimeVersion(),
+		"dir":     runtimeDir(),
+	})
+})
+
+// Exit terminates the process with the given status. The deferred calls are not executed.
+var Exit = mml.NewGoFunction(mml.Signature(mml.NilType, mml.IntType), func(a, _ []interface{}) interface{} {
+	os.Exit(a[0].(int))
+	return nil
+})
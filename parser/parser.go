@@ -758,29 +758,29 @@ func parseInput(r io.Reader, p parser, b builder) (*Node, error) {
 }
 
 func Parse(r io.Reader) (*Node, error) {
-	var p828 = sequenceParser{id: 828, commit: 32, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p826 = choiceParser{id: 826, commit: 2}
-	var p824 = choiceParser{id: 824, commit: 70, name: "ws", generalizations: []int{826, 15}}
-	var p2 = sequenceParser{id: 2, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{824, 826, 15}}
+	var p835 = sequenceParser{id: 835, commit: 32, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p833 = choiceParser{id: 833, commit: 2}
+	var p831 = choiceParser{id: 831, commit: 70, name: "ws", generalizations: []int{833, 15}}
+	var p2 = sequenceParser{id: 2, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{831, 833, 15}}
 	var p1 = charParser{id: 1, chars: []rune{32}}
 	p2.items = []parser{&p1}
-	var p4 = sequenceParser{id: 4, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{824, 826, 15}}
+	var p4 = sequenceParser{id: 4, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{831, 833, 15}}
 	var p3 = charParser{id: 3, chars: []rune{8}}
 	p4.items = []parser{&p3}
-	var p6 = sequenceParser{id: 6, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{824, 826, 15}}
+	var p6 = sequenceParser{id: 6, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{831, 833, 15}}
 	var p5 = charParser{id: 5, chars: []rune{12}}
 	p6.items = []parser{&p5}
-	var p8 = sequenceParser{id: 8, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{824, 826, 15}}
+	var p8 = sequenceParser{id: 8, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{831, 833, 15}}
 	var p7 = charParser{id: 7, chars: []rune{13}}
 	p8.items = []parser{&p7}
-	var p10 = sequenceParser{id: 10, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{824, 826, 15}}
+	var p10 = sequenceParser{id: 10, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{831, 833, 15}}
 	var p9 = charParser{id: 9, chars: []rune{9}}
 	p10.items = []parser{&p9}
-	var p12 = sequenceParser{id: 12, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{824, 826, 15}}
+	var p12 = sequenceParser{id: 12, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{831, 833, 15}}
 	var p11 = charParser{id: 11, chars: []rune{11}}
 	p12.items = []parser{&p11}
-	p824.options = []parser{&p2, &p4, &p6, &p8, &p10, &p12}
-	var p825 = sequenceParser{id: 825, commit: 70, name: "wsc", ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{826}}
+	p831.options = []parser{&p2, &p4, &p6, &p8, &p10, &p12}
+	var p832 = sequenceParser{id: 832, commit: 70, name: "wsc", ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{833}}
 	var p43 = sequenceParser{id: 43, commit: 66, name: "comment", ranges: [][]int{{1, 1}, {0, 1}}}
 	var p39 = choiceParser{id: 39, commit: 66, name: "comment-part"}
 	var p22 = sequenceParser{id: 22, commit: 74, name: "line-comment", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}, generalizations: []int{39}}
@@ -822,47 +822,47 @@ func Parse(r io.Reader) (*Node, error) {
 	p39.options = []parser{&p22, &p38}
 	var p42 = sequenceParser{id: 42, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p40 = sequenceParser{id: 40, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var p14 = sequenceParser{id: 14, commit: 74, name: "nl", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{806, 15, 112}}
+	var p14 = sequenceParser{id: 14, commit: 74, name: "nl", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{813, 15, 112}}
 	var p13 = charParser{id: 13, chars: []rune{10}}
 	p14.items = []parser{&p13}
-	p40.items = []parser{&p14, &p826, &p39}
+	p40.items = []parser{&p14, &p833, &p39}
 	var p41 = sequenceParser{id: 41, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p41.items = []parser{&p826, &p40}
-	p42.items = []parser{&p826, &p40, &p41}
+	p41.items = []parser{&p833, &p40}
+	p42.items = []parser{&p833, &p40, &p41}
 	p43.items = []parser{&p39, &p42}
-	p825.items = []parser{&p43}
-	p826.options = []parser{&p824, &p825}
-	var p827 = sequenceParser{id: 827, commit: 66, name: "mml:wsroot", ranges: [][]int{{0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}}}
-	var p823 = sequenceParser{id: 823, commit: 64, name: "shebang", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {1, 1}}}
-	var p820 = sequenceParser{id: 820, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p818 = charParser{id: 818, chars: []rune{35}}
-	var p819 = charParser{id: 819, chars: []rune{33}}
-	p820.items = []parser{&p818, &p819}
-	var p817 = sequenceParser{id: 817, commit: 64, name: "shebang-command", ranges: [][]int{{0, 1}}}
-	var p816 = sequenceParser{id: 816, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var p814 = sequenceParser{id: 814, commit: 2, allChars: true, ranges: [][]int{{1, 1}}}
-	var p813 = charParser{id: 813, not: true, chars: []rune{10}}
-	p814.items = []parser{&p813}
-	var p815 = sequenceParser{id: 815, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p815.items = []parser{&p826, &p814}
-	p816.items = []parser{&p814, &p815}
-	p817.items = []parser{&p816}
-	var p822 = sequenceParser{id: 822, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p821 = charParser{id: 821, chars: []rune{10}}
-	p822.items = []parser{&p821}
-	p823.items = []parser{&p820, &p826, &p817, &p826, &p822}
-	var p808 = sequenceParser{id: 808, commit: 66, name: "sep", ranges: [][]int{{1, 1}, {0, -1}}}
-	var p806 = choiceParser{id: 806, commit: 2}
-	var p805 = sequenceParser{id: 805, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{806}}
-	var p804 = charParser{id: 804, chars: []rune{59}}
-	p805.items = []parser{&p804}
-	p806.options = []parser{&p805, &p14}
-	var p807 = sequenceParser{id: 807, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p807.items = []parser{&p826, &p806}
-	p808.items = []parser{&p806, &p807}
-	var p812 = sequenceParser{id: 812, commit: 66, name: "statement-list", ranges: [][]int{{1, 1}, {0, 1}}}
-	var p794 = choiceParser{id: 794, commit: 66, name: "statement", generalizations: []int{481, 542}}
-	var p187 = sequenceParser{id: 187, commit: 64, name: "return", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}}, generalizations: []int{794, 481, 542}}
+	p832.items = []parser{&p43}
+	p833.options = []parser{&p831, &p832}
+	var p834 = sequenceParser{id: 834, commit: 66, name: "mml:wsroot", ranges: [][]int{{0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}}}
+	var p830 = sequenceParser{id: 830, commit: 64, name: "shebang", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {1, 1}}}
+	var p827 = sequenceParser{id: 827, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p825 = charParser{id: 825, chars: []rune{35}}
+	var p826 = charParser{id: 826, chars: []rune{33}}
+	p827.items = []parser{&p825, &p826}
+	var p824 = sequenceParser{id: 824, commit: 64, name: "shebang-command", ranges: [][]int{{0, 1}}}
+	var p823 = sequenceParser{id: 823, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var p821 = sequenceParser{id: 821, commit: 2, allChars: true, ranges: [][]int{{1, 1}}}
+	var p820 = charParser{id: 820, not: true, chars: []rune{10}}
+	p821.items = []parser{&p820}
+	var p822 = sequenceParser{id: 822, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p822.items = []parser{&p833, &p821}
+	p823.items = []parser{&p821, &p822}
+	p824.items = []parser{&p823}
+	var p829 = sequenceParser{id: 829, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p828 = charParser{id: 828, chars: []rune{10}}
+	p829.items = []parser{&p828}
+	p830.items = []parser{&p827, &p833, &p824, &p833, &p829}
+	var p815 = sequenceParser{id: 815, commit: 66, name: "sep", ranges: [][]int{{1, 1}, {0, -1}}}
+	var p813 = choiceParser{id: 813, commit: 2}
+	var p812 = sequenceParser{id: 812, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{813}}
+	var p811 = charParser{id: 811, chars: []rune{59}}
+	p812.items = []parser{&p811}
+	p813.options = []parser{&p812, &p14}
+	var p814 = sequenceParser{id: 814, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p814.items = []parser{&p833, &p813}
+	p815.items = []parser{&p813, &p814}
+	var p819 = sequenceParser{id: 819, commit: 66, name: "statement-list", ranges: [][]int{{1, 1}, {0, 1}}}
+	var p801 = choiceParser{id: 801, commit: 66, name: "statement", generalizations: []int{481, 542}}
+	var p187 = sequenceParser{id: 187, commit: 64, name: "return", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}}, generalizations: []int{801, 481, 542}}
 	var p183 = sequenceParser{id: 183, commit: 74, name: "return-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p182 = sequenceParser{id: 182, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p176 = charParser{id: 176, chars: []rune{114}}
@@ -873,17 +873,17 @@ func Parse(r io.Reader) (*Node, error) {
 	var p181 = charParser{id: 181, chars: []rune{110}}
 	p182.items = []parser{&p176, &p177, &p178, &p179, &p180, &p181}
 	var p15 = choiceParser{id: 15, commit: 66, name: "wsep"}
-	p15.options = []parser{&p824, &p14}
+	p15.options = []parser{&p831, &p14}
 	p183.items = []parser{&p182, &p15}
 	var p186 = sequenceParser{id: 186, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
 	var p185 = sequenceParser{id: 185, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p184 = sequenceParser{id: 184, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p184.items = []parser{&p826, &p14}
+	p184.items = []parser{&p833, &p14}
 	p185.items = []parser{&p14, &p184}
-	var p402 = choiceParser{id: 402, commit: 66, name: "expression", generalizations: []int{115, 784, 199, 591, 584, 794}}
-	var p273 = choiceParser{id: 273, commit: 66, name: "primary-expression", generalizations: []int{115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
-	var p61 = choiceParser{id: 61, commit: 64, name: "int", generalizations: []int{273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
-	var p52 = sequenceParser{id: 52, commit: 74, name: "decimal", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{61, 273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p402 = choiceParser{id: 402, commit: 66, name: "expression", generalizations: []int{115, 791, 199, 598, 591, 801}}
+	var p273 = choiceParser{id: 273, commit: 66, name: "primary-expression", generalizations: []int{115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
+	var p61 = choiceParser{id: 61, commit: 64, name: "int", generalizations: []int{273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
+	var p52 = sequenceParser{id: 52, commit: 74, name: "decimal", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{61, 273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p51 = sequenceParser{id: 51, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p50 = charParser{id: 50, ranges: [][]rune{{49, 57}}}
 	p51.items = []parser{&p50}
@@ -891,7 +891,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p44 = charParser{id: 44, ranges: [][]rune{{48, 57}}}
 	p45.items = []parser{&p44}
 	p52.items = []parser{&p51, &p45}
-	var p55 = sequenceParser{id: 55, commit: 74, name: "octal", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{61, 273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p55 = sequenceParser{id: 55, commit: 74, name: "octal", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{61, 273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p54 = sequenceParser{id: 54, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p53 = charParser{id: 53, chars: []rune{48}}
 	p54.items = []parser{&p53}
@@ -899,7 +899,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p46 = charParser{id: 46, ranges: [][]rune{{48, 55}}}
 	p47.items = []parser{&p46}
 	p55.items = []parser{&p54, &p47}
-	var p60 = sequenceParser{id: 60, commit: 74, name: "hexa", ranges: [][]int{{1, 1}, {1, 1}, {1, -1}, {1, 1}, {1, 1}, {1, -1}}, generalizations: []int{61, 273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p60 = sequenceParser{id: 60, commit: 74, name: "hexa", ranges: [][]int{{1, 1}, {1, 1}, {1, -1}, {1, 1}, {1, 1}, {1, -1}}, generalizations: []int{61, 273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p57 = sequenceParser{id: 57, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p56 = charParser{id: 56, chars: []rune{48}}
 	p57.items = []parser{&p56}
@@ -911,8 +911,8 @@ func Parse(r io.Reader) (*Node, error) {
 	p49.items = []parser{&p48}
 	p60.items = []parser{&p57, &p59, &p49}
 	p61.options = []parser{&p52, &p55, &p60}
-	var p74 = choiceParser{id: 74, commit: 72, name: "float", generalizations: []int{273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
-	var p69 = sequenceParser{id: 69, commit: 10, ranges: [][]int{{1, -1}, {1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 1}, {0, -1}, {0, 1}}, generalizations: []int{74, 273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p74 = choiceParser{id: 74, commit: 72, name: "float", generalizations: []int{273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
+	var p69 = sequenceParser{id: 69, commit: 10, ranges: [][]int{{1, -1}, {1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 1}, {0, -1}, {0, 1}}, generalizations: []int{74, 273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p68 = sequenceParser{id: 68, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p67 = charParser{id: 67, chars: []rune{46}}
 	p68.items = []parser{&p67}
@@ -925,15 +925,15 @@ func Parse(r io.Reader) (*Node, error) {
 	p65.items = []parser{&p64}
 	p66.items = []parser{&p63, &p65, &p45}
 	p69.items = []parser{&p45, &p68, &p45, &p66}
-	var p72 = sequenceParser{id: 72, commit: 10, ranges: [][]int{{1, 1}, {1, -1}, {0, 1}, {1, 1}, {1, -1}, {0, 1}}, generalizations: []int{74, 273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p72 = sequenceParser{id: 72, commit: 10, ranges: [][]int{{1, 1}, {1, -1}, {0, 1}, {1, 1}, {1, -1}, {0, 1}}, generalizations: []int{74, 273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p71 = sequenceParser{id: 71, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p70 = charParser{id: 70, chars: []rune{46}}
 	p71.items = []parser{&p70}
 	p72.items = []parser{&p71, &p45, &p66}
-	var p73 = sequenceParser{id: 73, commit: 10, ranges: [][]int{{1, -1}, {1, 1}, {1, -1}, {1, 1}}, generalizations: []int{74, 273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p73 = sequenceParser{id: 73, commit: 10, ranges: [][]int{{1, -1}, {1, 1}, {1, -1}, {1, 1}}, generalizations: []int{74, 273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	p73.items = []parser{&p45, &p66}
 	p74.options = []parser{&p69, &p72, &p73}
-	var p87 = sequenceParser{id: 87, commit: 72, name: "string", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {1, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 115, 140, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 757, 794}}
+	var p87 = sequenceParser{id: 87, commit: 72, name: "string", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {1, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 115, 140, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 764, 801}}
 	var p76 = sequenceParser{id: 76, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p75 = charParser{id: 75, chars: []rune{34}}
 	p76.items = []parser{&p75}
@@ -954,14 +954,14 @@ func Parse(r io.Reader) (*Node, error) {
 	var p85 = charParser{id: 85, chars: []rune{34}}
 	p86.items = []parser{&p85}
 	p87.items = []parser{&p76, &p84, &p86}
-	var p99 = choiceParser{id: 99, commit: 66, name: "bool", generalizations: []int{273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
-	var p92 = sequenceParser{id: 92, commit: 72, name: "true", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}, generalizations: []int{99, 273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p99 = choiceParser{id: 99, commit: 66, name: "bool", generalizations: []int{273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
+	var p92 = sequenceParser{id: 92, commit: 72, name: "true", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}, generalizations: []int{99, 273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p88 = charParser{id: 88, chars: []rune{116}}
 	var p89 = charParser{id: 89, chars: []rune{114}}
 	var p90 = charParser{id: 90, chars: []rune{117}}
 	var p91 = charParser{id: 91, chars: []rune{101}}
 	p92.items = []parser{&p88, &p89, &p90, &p91}
-	var p98 = sequenceParser{id: 98, commit: 72, name: "false", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}, generalizations: []int{99, 273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p98 = sequenceParser{id: 98, commit: 72, name: "false", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}, generalizations: []int{99, 273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p93 = charParser{id: 93, chars: []rune{102}}
 	var p94 = charParser{id: 94, chars: []rune{97}}
 	var p95 = charParser{id: 95, chars: []rune{108}}
@@ -969,7 +969,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p97 = charParser{id: 97, chars: []rune{101}}
 	p98.items = []parser{&p93, &p94, &p95, &p96, &p97}
 	p99.options = []parser{&p92, &p98}
-	var p515 = sequenceParser{id: 515, commit: 64, name: "receive", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 115, 784, 199, 402, 339, 340, 341, 342, 343, 394, 519, 591, 584, 794}}
+	var p515 = sequenceParser{id: 515, commit: 64, name: "receive", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 115, 791, 199, 402, 339, 340, 341, 342, 343, 394, 519, 598, 591, 801}}
 	var p507 = sequenceParser{id: 507, commit: 74, name: "receive-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p506 = sequenceParser{id: 506, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p499 = charParser{id: 499, chars: []rune{114}}
@@ -983,10 +983,10 @@ func Parse(r io.Reader) (*Node, error) {
 	p507.items = []parser{&p506, &p15}
 	var p514 = sequenceParser{id: 514, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p513 = sequenceParser{id: 513, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p513.items = []parser{&p826, &p14}
-	p514.items = []parser{&p826, &p14, &p513}
-	p515.items = []parser{&p507, &p514, &p826, &p273}
-	var p104 = sequenceParser{id: 104, commit: 72, name: "symbol", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{273, 115, 140, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 748, 794}}
+	p513.items = []parser{&p833, &p14}
+	p514.items = []parser{&p833, &p14, &p513}
+	p515.items = []parser{&p507, &p514, &p833, &p273}
+	var p104 = sequenceParser{id: 104, commit: 72, name: "symbol", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{273, 115, 140, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 755, 801}}
 	var p101 = sequenceParser{id: 101, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p100 = charParser{id: 100, chars: []rune{95}, ranges: [][]rune{{97, 122}, {65, 90}}}
 	p101.items = []parser{&p100}
@@ -994,7 +994,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var p102 = charParser{id: 102, chars: []rune{95}, ranges: [][]rune{{97, 122}, {65, 90}, {48, 57}}}
 	p103.items = []parser{&p102}
 	p104.items = []parser{&p101, &p103}
-	var p125 = sequenceParser{id: 125, commit: 64, name: "list", ranges: [][]int{{1, 1}}, generalizations: []int{115, 273, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p125 = sequenceParser{id: 125, commit: 64, name: "list", ranges: [][]int{{1, 1}}, generalizations: []int{115, 273, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p124 = sequenceParser{id: 124, commit: 66, name: "list-fact", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p121 = sequenceParser{id: 121, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p120 = charParser{id: 120, chars: []rune{91}}
@@ -1006,7 +1006,7 @@ func Parse(r io.Reader) (*Node, error) {
 	p111.items = []parser{&p110}
 	p112.options = []parser{&p14, &p111}
 	var p113 = sequenceParser{id: 113, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p113.items = []parser{&p826, &p112}
+	p113.items = []parser{&p833, &p112}
 	p114.items = []parser{&p112, &p113}
 	var p119 = sequenceParser{id: 119, commit: 66, name: "expression-list", ranges: [][]int{{1, 1}, {0, 1}}}
 	var p115 = choiceParser{id: 115, commit: 66, name: "list-item"}
@@ -1016,30 +1016,30 @@ func Parse(r io.Reader) (*Node, error) {
 	var p106 = charParser{id: 106, chars: []rune{46}}
 	var p107 = charParser{id: 107, chars: []rune{46}}
 	p108.items = []parser{&p105, &p106, &p107}
-	p109.items = []parser{&p273, &p826, &p108}
+	p109.items = []parser{&p273, &p833, &p108}
 	p115.options = []parser{&p402, &p109}
 	var p118 = sequenceParser{id: 118, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p116 = sequenceParser{id: 116, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	p116.items = []parser{&p114, &p826, &p115}
+	p116.items = []parser{&p114, &p833, &p115}
 	var p117 = sequenceParser{id: 117, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p117.items = []parser{&p826, &p116}
-	p118.items = []parser{&p826, &p116, &p117}
+	p117.items = []parser{&p833, &p116}
+	p118.items = []parser{&p833, &p116, &p117}
 	p119.items = []parser{&p115, &p118}
 	var p123 = sequenceParser{id: 123, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p122 = charParser{id: 122, chars: []rune{93}}
 	p123.items = []parser{&p122}
-	p124.items = []parser{&p121, &p826, &p114, &p826, &p119, &p826, &p114, &p826, &p123}
+	p124.items = []parser{&p121, &p833, &p114, &p833, &p119, &p833, &p114, &p833, &p123}
 	p125.items = []parser{&p124}
-	var p130 = sequenceParser{id: 130, commit: 64, name: "mutable-list", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p130 = sequenceParser{id: 130, commit: 64, name: "mutable-list", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p127 = sequenceParser{id: 127, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p126 = charParser{id: 126, chars: []rune{126}}
 	p127.items = []parser{&p126}
 	var p129 = sequenceParser{id: 129, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p128 = sequenceParser{id: 128, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p128.items = []parser{&p826, &p14}
-	p129.items = []parser{&p826, &p14, &p128}
-	p130.items = []parser{&p127, &p129, &p826, &p124}
-	var p159 = sequenceParser{id: 159, commit: 64, name: "struct", ranges: [][]int{{1, 1}}, generalizations: []int{273, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	p128.items = []parser{&p833, &p14}
+	p129.items = []parser{&p833, &p14, &p128}
+	p130.items = []parser{&p127, &p129, &p833, &p124}
+	var p159 = sequenceParser{id: 159, commit: 64, name: "struct", ranges: [][]int{{1, 1}}, generalizations: []int{273, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p158 = sequenceParser{id: 158, commit: 66, name: "struct-fact", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p155 = sequenceParser{id: 155, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p154 = charParser{id: 154, chars: []rune{123}}
@@ -1054,62 +1054,62 @@ func Parse(r io.Reader) (*Node, error) {
 	p132.items = []parser{&p131}
 	var p136 = sequenceParser{id: 136, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p135 = sequenceParser{id: 135, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p135.items = []parser{&p826, &p14}
-	p136.items = []parser{&p826, &p14, &p135}
+	p135.items = []parser{&p833, &p14}
+	p136.items = []parser{&p833, &p14, &p135}
 	var p138 = sequenceParser{id: 138, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p137 = sequenceParser{id: 137, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p137.items = []parser{&p826, &p14}
-	p138.items = []parser{&p826, &p14, &p137}
+	p137.items = []parser{&p833, &p14}
+	p138.items = []parser{&p833, &p14, &p137}
 	var p134 = sequenceParser{id: 134, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p133 = charParser{id: 133, chars: []rune{93}}
 	p134.items = []parser{&p133}
-	p139.items = []parser{&p132, &p136, &p826, &p402, &p138, &p826, &p134}
+	p139.items = []parser{&p132, &p136, &p833, &p402, &p138, &p833, &p134}
 	p140.options = []parser{&p104, &p87, &p139}
 	var p144 = sequenceParser{id: 144, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p143 = sequenceParser{id: 143, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p143.items = []parser{&p826, &p14}
-	p144.items = []parser{&p826, &p14, &p143}
+	p143.items = []parser{&p833, &p14}
+	p144.items = []parser{&p833, &p14, &p143}
 	var p142 = sequenceParser{id: 142, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p141 = charParser{id: 141, chars: []rune{58}}
 	p142.items = []parser{&p141}
 	var p146 = sequenceParser{id: 146, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p145 = sequenceParser{id: 145, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p145.items = []parser{&p826, &p14}
-	p146.items = []parser{&p826, &p14, &p145}
-	p147.items = []parser{&p140, &p144, &p826, &p142, &p146, &p826, &p402}
+	p145.items = []parser{&p833, &p14}
+	p146.items = []parser{&p833, &p14, &p145}
+	p147.items = []parser{&p140, &p144, &p833, &p142, &p146, &p833, &p402}
 	p148.options = []parser{&p147, &p109}
 	var p152 = sequenceParser{id: 152, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p150 = sequenceParser{id: 150, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
 	var p149 = choiceParser{id: 149, commit: 2}
 	p149.options = []parser{&p147, &p109}
-	p150.items = []parser{&p114, &p826, &p149}
+	p150.items = []parser{&p114, &p833, &p149}
 	var p151 = sequenceParser{id: 151, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p151.items = []parser{&p826, &p150}
-	p152.items = []parser{&p826, &p150, &p151}
+	p151.items = []parser{&p833, &p150}
+	p152.items = []parser{&p833, &p150, &p151}
 	p153.items = []parser{&p148, &p152}
 	var p157 = sequenceParser{id: 157, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p156 = charParser{id: 156, chars: []rune{125}}
 	p157.items = []parser{&p156}
-	p158.items = []parser{&p155, &p826, &p114, &p826, &p153, &p826, &p114, &p826, &p157}
+	p158.items = []parser{&p155, &p833, &p114, &p833, &p153, &p833, &p114, &p833, &p157}
 	p159.items = []parser{&p158}
-	var p164 = sequenceParser{id: 164, commit: 64, name: "mutable-struct", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 784, 199, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p164 = sequenceParser{id: 164, commit: 64, name: "mutable-struct", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 791, 199, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p161 = sequenceParser{id: 161, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p160 = charParser{id: 160, chars: []rune{126}}
 	p161.items = []parser{&p160}
 	var p163 = sequenceParser{id: 163, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p162 = sequenceParser{id: 162, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p162.items = []parser{&p826, &p14}
-	p163.items = []parser{&p826, &p14, &p162}
-	p164.items = []parser{&p161, &p163, &p826, &p158}
-	var p208 = sequenceParser{id: 208, commit: 64, name: "function", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{784, 199, 273, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	p162.items = []parser{&p833, &p14}
+	p163.items = []parser{&p833, &p14, &p162}
+	p164.items = []parser{&p161, &p163, &p833, &p158}
+	var p208 = sequenceParser{id: 208, commit: 64, name: "function", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{791, 199, 273, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p205 = sequenceParser{id: 205, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p203 = charParser{id: 203, chars: []rune{102}}
 	var p204 = charParser{id: 204, chars: []rune{110}}
 	p205.items = []parser{&p203, &p204}
 	var p207 = sequenceParser{id: 207, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p206 = sequenceParser{id: 206, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p206.items = []parser{&p826, &p14}
-	p207.items = []parser{&p826, &p14, &p206}
+	p206.items = []parser{&p833, &p14}
+	p207.items = []parser{&p833, &p14, &p206}
 	var p202 = sequenceParser{id: 202, commit: 66, name: "function-fact", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p194 = sequenceParser{id: 194, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p193 = charParser{id: 193, chars: []rune{40}}
@@ -1118,10 +1118,10 @@ func Parse(r io.Reader) (*Node, error) {
 	var p168 = sequenceParser{id: 168, commit: 66, name: "parameter-list", ranges: [][]int{{1, 1}, {0, 1}}, generalizations: []int{196}}
 	var p167 = sequenceParser{id: 167, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p165 = sequenceParser{id: 165, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	p165.items = []parser{&p114, &p826, &p104}
+	p165.items = []parser{&p114, &p833, &p104}
 	var p166 = sequenceParser{id: 166, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p166.items = []parser{&p826, &p165}
-	p167.items = []parser{&p826, &p165, &p166}
+	p166.items = []parser{&p833, &p165}
+	p167.items = []parser{&p833, &p165, &p166}
 	p168.items = []parser{&p104, &p167}
 	var p195 = sequenceParser{id: 195, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {1, 1}}, generalizations: []int{196}}
 	var p175 = sequenceParser{id: 175, commit: 64, name: "collect-parameter", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{196}}
@@ -1132,21 +1132,21 @@ func Parse(r io.Reader) (*Node, error) {
 	p172.items = []parser{&p169, &p170, &p171}
 	var p174 = sequenceParser{id: 174, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p173 = sequenceParser{id: 173, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p173.items = []parser{&p826, &p14}
-	p174.items = []parser{&p826, &p14, &p173}
-	p175.items = []parser{&p172, &p174, &p826, &p104}
-	p195.items = []parser{&p168, &p826, &p114, &p826, &p175}
+	p173.items = []parser{&p833, &p14}
+	p174.items = []parser{&p833, &p14, &p173}
+	p175.items = []parser{&p172, &p174, &p833, &p104}
+	p195.items = []parser{&p168, &p833, &p114, &p833, &p175}
 	p196.options = []parser{&p168, &p195, &p175}
 	var p198 = sequenceParser{id: 198, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p197 = charParser{id: 197, chars: []rune{41}}
 	p198.items = []parser{&p197}
 	var p201 = sequenceParser{id: 201, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p200 = sequenceParser{id: 200, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p200.items = []parser{&p826, &p14}
-	p201.items = []parser{&p826, &p14, &p200}
+	p200.items = []parser{&p833, &p14}
+	p201.items = []parser{&p833, &p14, &p200}
 	var p199 = choiceParser{id: 199, commit: 2}
-	var p784 = choiceParser{id: 784, commit: 66, name: "simple-statement", generalizations: []int{199, 794}}
-	var p512 = sequenceParser{id: 512, commit: 64, name: "send", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{784, 199, 519, 794}}
+	var p791 = choiceParser{id: 791, commit: 66, name: "simple-statement", generalizations: []int{199, 801}}
+	var p512 = sequenceParser{id: 512, commit: 64, name: "send", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{791, 199, 519, 801}}
 	var p498 = sequenceParser{id: 498, commit: 74, name: "send-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p497 = sequenceParser{id: 497, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p493 = charParser{id: 493, chars: []rune{115}}
@@ -1157,14 +1157,14 @@ func Parse(r io.Reader) (*Node, error) {
 	p498.items = []parser{&p497, &p15}
 	var p509 = sequenceParser{id: 509, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p508 = sequenceParser{id: 508, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p508.items = []parser{&p826, &p14}
-	p509.items = []parser{&p826, &p14, &p508}
+	p508.items = []parser{&p833, &p14}
+	p509.items = []parser{&p833, &p14, &p508}
 	var p511 = sequenceParser{id: 511, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p510 = sequenceParser{id: 510, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p510.items = []parser{&p826, &p14}
-	p511.items = []parser{&p826, &p14, &p510}
-	p512.items = []parser{&p498, &p509, &p826, &p273, &p511, &p826, &p273}
-	var p565 = sequenceParser{id: 565, commit: 64, name: "go", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{784, 199, 794}}
+	p510.items = []parser{&p833, &p14}
+	p511.items = []parser{&p833, &p14, &p510}
+	p512.items = []parser{&p498, &p509, &p833, &p273, &p511, &p833, &p273}
+	var p565 = sequenceParser{id: 565, commit: 64, name: "go", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{791, 199, 801}}
 	var p555 = sequenceParser{id: 555, commit: 74, name: "go-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p554 = sequenceParser{id: 554, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p552 = charParser{id: 552, chars: []rune{103}}
@@ -1173,18 +1173,18 @@ func Parse(r io.Reader) (*Node, error) {
 	p555.items = []parser{&p554, &p15}
 	var p564 = sequenceParser{id: 564, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p563 = sequenceParser{id: 563, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p563.items = []parser{&p826, &p14}
-	p564.items = []parser{&p826, &p14, &p563}
-	var p263 = sequenceParser{id: 263, commit: 64, name: "function-application", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	p563.items = []parser{&p833, &p14}
+	p564.items = []parser{&p833, &p14, &p563}
+	var p263 = sequenceParser{id: 263, commit: 64, name: "function-application", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p260 = sequenceParser{id: 260, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p259 = charParser{id: 259, chars: []rune{40}}
 	p260.items = []parser{&p259}
 	var p262 = sequenceParser{id: 262, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p261 = charParser{id: 261, chars: []rune{41}}
 	p262.items = []parser{&p261}
-	p263.items = []parser{&p273, &p826, &p260, &p826, &p114, &p826, &p119, &p826, &p114, &p826, &p262}
-	p565.items = []parser{&p555, &p564, &p826, &p263}
-	var p574 = sequenceParser{id: 574, commit: 64, name: "defer", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{784, 199, 794}}
+	p263.items = []parser{&p273, &p833, &p260, &p833, &p114, &p833, &p119, &p833, &p114, &p833, &p262}
+	p565.items = []parser{&p555, &p564, &p833, &p263}
+	var p574 = sequenceParser{id: 574, commit: 64, name: "defer", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{791, 199, 801}}
 	var p571 = sequenceParser{id: 571, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p566 = charParser{id: 566, chars: []rune{100}}
 	var p567 = charParser{id: 567, chars: []rune{101}}
@@ -1194,91 +1194,91 @@ func Parse(r io.Reader) (*Node, error) {
 	p571.items = []parser{&p566, &p567, &p568, &p569, &p570}
 	var p573 = sequenceParser{id: 573, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p572 = sequenceParser{id: 572, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p572.items = []parser{&p826, &p14}
-	p573.items = []parser{&p826, &p14, &p572}
-	p574.items = []parser{&p571, &p573, &p826, &p263}
-	var p638 = choiceParser{id: 638, commit: 64, name: "assignment", generalizations: []int{784, 199, 794}}
-	var p622 = sequenceParser{id: 622, commit: 66, name: "assign-set", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{638, 784, 199, 794}}
-	var p607 = sequenceParser{id: 607, commit: 74, name: "set-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p606 = sequenceParser{id: 606, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p603 = charParser{id: 603, chars: []rune{115}}
-	var p604 = charParser{id: 604, chars: []rune{101}}
-	var p605 = charParser{id: 605, chars: []rune{116}}
-	p606.items = []parser{&p603, &p604, &p605}
-	p607.items = []parser{&p606, &p15}
-	var p621 = sequenceParser{id: 621, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p620 = sequenceParser{id: 620, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p620.items = []parser{&p826, &p14}
-	p621.items = []parser{&p826, &p14, &p620}
-	var p615 = sequenceParser{id: 615, commit: 66, name: "assign-capture", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var p612 = sequenceParser{id: 612, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var p611 = sequenceParser{id: 611, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var p610 = sequenceParser{id: 610, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p610.items = []parser{&p826, &p14}
-	p611.items = []parser{&p14, &p610}
-	var p609 = sequenceParser{id: 609, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p608 = charParser{id: 608, chars: []rune{61}}
-	p609.items = []parser{&p608}
-	p612.items = []parser{&p611, &p826, &p609}
-	var p614 = sequenceParser{id: 614, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p613 = sequenceParser{id: 613, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p613.items = []parser{&p826, &p14}
-	p614.items = []parser{&p826, &p14, &p613}
-	p615.items = []parser{&p273, &p826, &p612, &p614, &p826, &p402}
-	p622.items = []parser{&p607, &p621, &p826, &p615}
-	var p629 = sequenceParser{id: 629, commit: 66, name: "assign-eq", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{638, 784, 199, 794}}
-	var p626 = sequenceParser{id: 626, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p625 = sequenceParser{id: 625, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p625.items = []parser{&p826, &p14}
-	p626.items = []parser{&p826, &p14, &p625}
-	var p624 = sequenceParser{id: 624, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p623 = charParser{id: 623, chars: []rune{61}}
-	p624.items = []parser{&p623}
+	p572.items = []parser{&p833, &p14}
+	p573.items = []parser{&p833, &p14, &p572}
+	p574.items = []parser{&p571, &p573, &p833, &p263}
+	var p645 = choiceParser{id: 645, commit: 64, name: "assignment", generalizations: []int{791, 199, 801}}
+	var p629 = sequenceParser{id: 629, commit: 66, name: "assign-set", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{645, 791, 199, 801}}
+	var p614 = sequenceParser{id: 614, commit: 74, name: "set-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p613 = sequenceParser{id: 613, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p610 = charParser{id: 610, chars: []rune{115}}
+	var p611 = charParser{id: 611, chars: []rune{101}}
+	var p612 = charParser{id: 612, chars: []rune{116}}
+	p613.items = []parser{&p610, &p611, &p612}
+	p614.items = []parser{&p613, &p15}
 	var p628 = sequenceParser{id: 628, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p627 = sequenceParser{id: 627, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p627.items = []parser{&p826, &p14}
-	p628.items = []parser{&p826, &p14, &p627}
-	p629.items = []parser{&p273, &p626, &p826, &p624, &p628, &p826, &p402}
-	var p637 = sequenceParser{id: 637, commit: 66, name: "assign-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{638, 784, 199, 794}}
-	var p636 = sequenceParser{id: 636, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p635 = sequenceParser{id: 635, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p635.items = []parser{&p826, &p14}
-	p636.items = []parser{&p826, &p14, &p635}
+	p627.items = []parser{&p833, &p14}
+	p628.items = []parser{&p833, &p14, &p627}
+	var p622 = sequenceParser{id: 622, commit: 66, name: "assign-capture", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var p619 = sequenceParser{id: 619, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var p618 = sequenceParser{id: 618, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var p617 = sequenceParser{id: 617, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p617.items = []parser{&p833, &p14}
+	p618.items = []parser{&p14, &p617}
+	var p616 = sequenceParser{id: 616, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p615 = charParser{id: 615, chars: []rune{61}}
+	p616.items = []parser{&p615}
+	p619.items = []parser{&p618, &p833, &p616}
+	var p621 = sequenceParser{id: 621, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p620 = sequenceParser{id: 620, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p620.items = []parser{&p833, &p14}
+	p621.items = []parser{&p833, &p14, &p620}
+	p622.items = []parser{&p273, &p833, &p619, &p621, &p833, &p402}
+	p629.items = []parser{&p614, &p628, &p833, &p622}
+	var p636 = sequenceParser{id: 636, commit: 66, name: "assign-eq", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{645, 791, 199, 801}}
+	var p633 = sequenceParser{id: 633, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p632 = sequenceParser{id: 632, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p632.items = []parser{&p833, &p14}
+	p633.items = []parser{&p833, &p14, &p632}
 	var p631 = sequenceParser{id: 631, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p630 = charParser{id: 630, chars: []rune{40}}
+	var p630 = charParser{id: 630, chars: []rune{61}}
 	p631.items = []parser{&p630}
-	var p632 = sequenceParser{id: 632, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	var p619 = sequenceParser{id: 619, commit: 66, name: "assign-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
-	var p618 = sequenceParser{id: 618, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p616 = sequenceParser{id: 616, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	p616.items = []parser{&p114, &p826, &p615}
-	var p617 = sequenceParser{id: 617, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p617.items = []parser{&p826, &p616}
-	p618.items = []parser{&p826, &p616, &p617}
-	p619.items = []parser{&p615, &p618}
-	p632.items = []parser{&p114, &p826, &p619}
-	var p634 = sequenceParser{id: 634, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p633 = charParser{id: 633, chars: []rune{41}}
-	p634.items = []parser{&p633}
-	p637.items = []parser{&p607, &p636, &p826, &p631, &p826, &p632, &p826, &p114, &p826, &p634}
-	p638.options = []parser{&p622, &p629, &p637}
-	var p793 = sequenceParser{id: 793, commit: 66, name: "simple-statement-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{784, 199, 794}}
-	var p786 = sequenceParser{id: 786, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p785 = charParser{id: 785, chars: []rune{40}}
-	p786.items = []parser{&p785}
-	var p790 = sequenceParser{id: 790, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p789 = sequenceParser{id: 789, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p789.items = []parser{&p826, &p14}
-	p790.items = []parser{&p826, &p14, &p789}
-	var p792 = sequenceParser{id: 792, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p791 = sequenceParser{id: 791, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p791.items = []parser{&p826, &p14}
-	p792.items = []parser{&p826, &p14, &p791}
-	var p788 = sequenceParser{id: 788, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p787 = charParser{id: 787, chars: []rune{41}}
-	p788.items = []parser{&p787}
-	p793.items = []parser{&p786, &p790, &p826, &p784, &p792, &p826, &p788}
-	p784.options = []parser{&p512, &p565, &p574, &p638, &p793, &p402}
+	var p635 = sequenceParser{id: 635, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p634 = sequenceParser{id: 634, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p634.items = []parser{&p833, &p14}
+	p635.items = []parser{&p833, &p14, &p634}
+	p636.items = []parser{&p273, &p633, &p833, &p631, &p635, &p833, &p402}
+	var p644 = sequenceParser{id: 644, commit: 66, name: "assign-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{645, 791, 199, 801}}
+	var p643 = sequenceParser{id: 643, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p642 = sequenceParser{id: 642, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p642.items = []parser{&p833, &p14}
+	p643.items = []parser{&p833, &p14, &p642}
+	var p638 = sequenceParser{id: 638, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p637 = charParser{id: 637, chars: []rune{40}}
+	p638.items = []parser{&p637}
+	var p639 = sequenceParser{id: 639, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	var p626 = sequenceParser{id: 626, commit: 66, name: "assign-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
+	var p625 = sequenceParser{id: 625, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p623 = sequenceParser{id: 623, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	p623.items = []parser{&p114, &p833, &p622}
+	var p624 = sequenceParser{id: 624, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p624.items = []parser{&p833, &p623}
+	p625.items = []parser{&p833, &p623, &p624}
+	p626.items = []parser{&p622, &p625}
+	p639.items = []parser{&p114, &p833, &p626}
+	var p641 = sequenceParser{id: 641, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p640 = charParser{id: 640, chars: []rune{41}}
+	p641.items = []parser{&p640}
+	p644.items = []parser{&p614, &p643, &p833, &p638, &p833, &p639, &p833, &p114, &p833, &p641}
+	p645.options = []parser{&p629, &p636, &p644}
+	var p800 = sequenceParser{id: 800, commit: 66, name: "simple-statement-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{791, 199, 801}}
+	var p793 = sequenceParser{id: 793, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p792 = charParser{id: 792, chars: []rune{40}}
+	p793.items = []parser{&p792}
+	var p797 = sequenceParser{id: 797, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p796 = sequenceParser{id: 796, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p796.items = []parser{&p833, &p14}
+	p797.items = []parser{&p833, &p14, &p796}
+	var p799 = sequenceParser{id: 799, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p798 = sequenceParser{id: 798, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p798.items = []parser{&p833, &p14}
+	p799.items = []parser{&p833, &p14, &p798}
+	var p795 = sequenceParser{id: 795, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p794 = charParser{id: 794, chars: []rune{41}}
+	p795.items = []parser{&p794}
+	p800.items = []parser{&p793, &p797, &p833, &p791, &p799, &p833, &p795}
+	p791.options = []parser{&p512, &p565, &p574, &p645, &p800, &p402}
 	var p192 = sequenceParser{id: 192, commit: 64, name: "block", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{199}}
 	var p189 = sequenceParser{id: 189, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p188 = charParser{id: 188, chars: []rune{123}}
@@ -1286,32 +1286,32 @@ func Parse(r io.Reader) (*Node, error) {
 	var p191 = sequenceParser{id: 191, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p190 = charParser{id: 190, chars: []rune{125}}
 	p191.items = []parser{&p190}
-	p192.items = []parser{&p189, &p826, &p808, &p826, &p812, &p826, &p808, &p826, &p191}
-	p199.options = []parser{&p784, &p192}
-	p202.items = []parser{&p194, &p826, &p114, &p826, &p196, &p826, &p114, &p826, &p198, &p201, &p826, &p199}
-	p208.items = []parser{&p205, &p207, &p826, &p202}
-	var p218 = sequenceParser{id: 218, commit: 64, name: "effect", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	p192.items = []parser{&p189, &p833, &p815, &p833, &p819, &p833, &p815, &p833, &p191}
+	p199.options = []parser{&p791, &p192}
+	p202.items = []parser{&p194, &p833, &p114, &p833, &p196, &p833, &p114, &p833, &p198, &p201, &p833, &p199}
+	p208.items = []parser{&p205, &p207, &p833, &p202}
+	var p218 = sequenceParser{id: 218, commit: 64, name: "effect", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p211 = sequenceParser{id: 211, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p209 = charParser{id: 209, chars: []rune{102}}
 	var p210 = charParser{id: 210, chars: []rune{110}}
 	p211.items = []parser{&p209, &p210}
 	var p215 = sequenceParser{id: 215, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p214 = sequenceParser{id: 214, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p214.items = []parser{&p826, &p14}
-	p215.items = []parser{&p826, &p14, &p214}
+	p214.items = []parser{&p833, &p14}
+	p215.items = []parser{&p833, &p14, &p214}
 	var p213 = sequenceParser{id: 213, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p212 = charParser{id: 212, chars: []rune{126}}
 	p213.items = []parser{&p212}
 	var p217 = sequenceParser{id: 217, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p216 = sequenceParser{id: 216, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p216.items = []parser{&p826, &p14}
-	p217.items = []parser{&p826, &p14, &p216}
-	p218.items = []parser{&p211, &p215, &p826, &p213, &p217, &p826, &p202}
-	var p258 = sequenceParser{id: 258, commit: 64, name: "indexer", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	p216.items = []parser{&p833, &p14}
+	p217.items = []parser{&p833, &p14, &p216}
+	p218.items = []parser{&p211, &p215, &p833, &p213, &p217, &p833, &p202}
+	var p258 = sequenceParser{id: 258, commit: 64, name: "indexer", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p257 = sequenceParser{id: 257, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p256 = sequenceParser{id: 256, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p256.items = []parser{&p826, &p14}
-	p257.items = []parser{&p826, &p14, &p256}
+	p256.items = []parser{&p833, &p14}
+	p257.items = []parser{&p833, &p14, &p256}
 	var p255 = sequenceParser{id: 255, commit: 66, name: "index-list", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}}}
 	var p251 = choiceParser{id: 251, commit: 66, name: "index"}
 	var p232 = sequenceParser{id: 232, commit: 64, name: "symbol-index", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{251}}
@@ -1320,85 +1320,85 @@ func Parse(r io.Reader) (*Node, error) {
 	p229.items = []parser{&p228}
 	var p231 = sequenceParser{id: 231, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p230 = sequenceParser{id: 230, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p230.items = []parser{&p826, &p14}
-	p231.items = []parser{&p826, &p14, &p230}
-	p232.items = []parser{&p229, &p231, &p826, &p104}
+	p230.items = []parser{&p833, &p14}
+	p231.items = []parser{&p833, &p14, &p230}
+	p232.items = []parser{&p229, &p231, &p833, &p104}
 	var p241 = sequenceParser{id: 241, commit: 64, name: "expression-index", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{251}}
 	var p234 = sequenceParser{id: 234, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p233 = charParser{id: 233, chars: []rune{91}}
 	p234.items = []parser{&p233}
 	var p238 = sequenceParser{id: 238, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p237 = sequenceParser{id: 237, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p237.items = []parser{&p826, &p14}
-	p238.items = []parser{&p826, &p14, &p237}
+	p237.items = []parser{&p833, &p14}
+	p238.items = []parser{&p833, &p14, &p237}
 	var p240 = sequenceParser{id: 240, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p239 = sequenceParser{id: 239, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p239.items = []parser{&p826, &p14}
-	p240.items = []parser{&p826, &p14, &p239}
+	p239.items = []parser{&p833, &p14}
+	p240.items = []parser{&p833, &p14, &p239}
 	var p236 = sequenceParser{id: 236, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p235 = charParser{id: 235, chars: []rune{93}}
 	p236.items = []parser{&p235}
-	p241.items = []parser{&p234, &p238, &p826, &p402, &p240, &p826, &p236}
+	p241.items = []parser{&p234, &p238, &p833, &p402, &p240, &p833, &p236}
 	var p250 = sequenceParser{id: 250, commit: 64, name: "range-index", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{251}}
 	var p243 = sequenceParser{id: 243, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p242 = charParser{id: 242, chars: []rune{91}}
 	p243.items = []parser{&p242}
 	var p247 = sequenceParser{id: 247, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p246 = sequenceParser{id: 246, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p246.items = []parser{&p826, &p14}
-	p247.items = []parser{&p826, &p14, &p246}
-	var p227 = sequenceParser{id: 227, commit: 66, name: "range", ranges: [][]int{{0, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {0, 1}}, generalizations: []int{584, 590, 591}}
+	p246.items = []parser{&p833, &p14}
+	p247.items = []parser{&p833, &p14, &p246}
+	var p227 = sequenceParser{id: 227, commit: 66, name: "range", ranges: [][]int{{0, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {0, 1}}, generalizations: []int{591, 597, 598}}
 	var p219 = sequenceParser{id: 219, commit: 64, name: "range-from", ranges: [][]int{{1, 1}}}
 	p219.items = []parser{&p402}
 	var p224 = sequenceParser{id: 224, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p223 = sequenceParser{id: 223, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p223.items = []parser{&p826, &p14}
-	p224.items = []parser{&p826, &p14, &p223}
+	p223.items = []parser{&p833, &p14}
+	p224.items = []parser{&p833, &p14, &p223}
 	var p222 = sequenceParser{id: 222, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p221 = charParser{id: 221, chars: []rune{58}}
 	p222.items = []parser{&p221}
 	var p226 = sequenceParser{id: 226, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p225 = sequenceParser{id: 225, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p225.items = []parser{&p826, &p14}
-	p226.items = []parser{&p826, &p14, &p225}
+	p225.items = []parser{&p833, &p14}
+	p226.items = []parser{&p833, &p14, &p225}
 	var p220 = sequenceParser{id: 220, commit: 64, name: "range-to", ranges: [][]int{{1, 1}}}
 	p220.items = []parser{&p402}
-	p227.items = []parser{&p219, &p224, &p826, &p222, &p226, &p826, &p220}
+	p227.items = []parser{&p219, &p224, &p833, &p222, &p226, &p833, &p220}
 	var p249 = sequenceParser{id: 249, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p248 = sequenceParser{id: 248, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p248.items = []parser{&p826, &p14}
-	p249.items = []parser{&p826, &p14, &p248}
+	p248.items = []parser{&p833, &p14}
+	p249.items = []parser{&p833, &p14, &p248}
 	var p245 = sequenceParser{id: 245, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p244 = charParser{id: 244, chars: []rune{93}}
 	p245.items = []parser{&p244}
-	p250.items = []parser{&p243, &p247, &p826, &p227, &p249, &p826, &p245}
+	p250.items = []parser{&p243, &p247, &p833, &p227, &p249, &p833, &p245}
 	p251.options = []parser{&p232, &p241, &p250}
 	var p254 = sequenceParser{id: 254, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
 	var p253 = sequenceParser{id: 253, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p252 = sequenceParser{id: 252, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p252.items = []parser{&p826, &p14}
+	p252.items = []parser{&p833, &p14}
 	p253.items = []parser{&p14, &p252}
-	p254.items = []parser{&p253, &p826, &p251}
-	p255.items = []parser{&p251, &p826, &p254}
-	p258.items = []parser{&p273, &p257, &p826, &p255}
-	var p272 = sequenceParser{id: 272, commit: 66, name: "expression-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	p254.items = []parser{&p253, &p833, &p251}
+	p255.items = []parser{&p251, &p833, &p254}
+	p258.items = []parser{&p273, &p257, &p833, &p255}
+	var p272 = sequenceParser{id: 272, commit: 66, name: "expression-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{273, 402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p265 = sequenceParser{id: 265, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p264 = charParser{id: 264, chars: []rune{40}}
 	p265.items = []parser{&p264}
 	var p269 = sequenceParser{id: 269, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p268 = sequenceParser{id: 268, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p268.items = []parser{&p826, &p14}
-	p269.items = []parser{&p826, &p14, &p268}
+	p268.items = []parser{&p833, &p14}
+	p269.items = []parser{&p833, &p14, &p268}
 	var p271 = sequenceParser{id: 271, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p270 = sequenceParser{id: 270, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p270.items = []parser{&p826, &p14}
-	p271.items = []parser{&p826, &p14, &p270}
+	p270.items = []parser{&p833, &p14}
+	p271.items = []parser{&p833, &p14, &p270}
 	var p267 = sequenceParser{id: 267, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p266 = charParser{id: 266, chars: []rune{41}}
 	p267.items = []parser{&p266}
-	p272.items = []parser{&p265, &p269, &p826, &p402, &p271, &p826, &p267}
+	p272.items = []parser{&p265, &p269, &p833, &p402, &p271, &p833, &p267}
 	p273.options = []parser{&p61, &p74, &p87, &p99, &p515, &p104, &p125, &p130, &p159, &p164, &p208, &p218, &p258, &p263, &p272}
-	var p333 = sequenceParser{id: 333, commit: 64, name: "unary-expression", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}, generalizations: []int{402, 339, 340, 341, 342, 343, 394, 591, 584, 794}}
+	var p333 = sequenceParser{id: 333, commit: 64, name: "unary-expression", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}, generalizations: []int{402, 339, 340, 341, 342, 343, 394, 598, 591, 801}}
 	var p332 = choiceParser{id: 332, commit: 66, name: "unary-operator"}
 	var p292 = sequenceParser{id: 292, commit: 72, name: "plus", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{332}}
 	var p291 = charParser{id: 291, chars: []rune{43}}
@@ -1413,15 +1413,15 @@ func Parse(r io.Reader) (*Node, error) {
 	var p305 = charParser{id: 305, chars: []rune{33}}
 	p306.items = []parser{&p305}
 	p332.options = []parser{&p292, &p294, &p275, &p306}
-	p333.items = []parser{&p332, &p826, &p273}
-	var p380 = choiceParser{id: 380, commit: 66, name: "binary-expression", generalizations: []int{402, 394, 591, 584, 794}}
-	var p351 = sequenceParser{id: 351, commit: 64, name: "binary0", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 340, 341, 342, 343, 402, 394, 591, 584, 794}}
+	p333.items = []parser{&p332, &p833, &p273}
+	var p380 = choiceParser{id: 380, commit: 66, name: "binary-expression", generalizations: []int{402, 394, 598, 591, 801}}
+	var p351 = sequenceParser{id: 351, commit: 64, name: "binary0", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 340, 341, 342, 343, 402, 394, 598, 591, 801}}
 	var p339 = choiceParser{id: 339, commit: 66, name: "operand0", generalizations: []int{340, 341, 342, 343}}
 	p339.options = []parser{&p273, &p333}
 	var p349 = sequenceParser{id: 349, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p346 = sequenceParser{id: 346, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p345 = sequenceParser{id: 345, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p345.items = []parser{&p826, &p14}
+	p345.items = []parser{&p833, &p14}
 	p346.items = []parser{&p14, &p345}
 	var p334 = choiceParser{id: 334, commit: 66, name: "binary-op0"}
 	var p277 = sequenceParser{id: 277, commit: 72, name: "binary-and", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{334}}
@@ -1451,19 +1451,19 @@ func Parse(r io.Reader) (*Node, error) {
 	p334.options = []parser{&p277, &p284, &p287, &p290, &p296, &p298, &p300}
 	var p348 = sequenceParser{id: 348, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p347 = sequenceParser{id: 347, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p347.items = []parser{&p826, &p14}
-	p348.items = []parser{&p826, &p14, &p347}
-	p349.items = []parser{&p346, &p826, &p334, &p348, &p826, &p339}
+	p347.items = []parser{&p833, &p14}
+	p348.items = []parser{&p833, &p14, &p347}
+	p349.items = []parser{&p346, &p833, &p334, &p348, &p833, &p339}
 	var p350 = sequenceParser{id: 350, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p350.items = []parser{&p826, &p349}
-	p351.items = []parser{&p339, &p826, &p349, &p350}
-	var p358 = sequenceParser{id: 358, commit: 64, name: "binary1", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 341, 342, 343, 402, 394, 591, 584, 794}}
+	p350.items = []parser{&p833, &p349}
+	p351.items = []parser{&p339, &p833, &p349, &p350}
+	var p358 = sequenceParser{id: 358, commit: 64, name: "binary1", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 341, 342, 343, 402, 394, 598, 591, 801}}
 	var p340 = choiceParser{id: 340, commit: 66, name: "operand1", generalizations: []int{341, 342, 343}}
 	p340.options = []parser{&p339, &p351}
 	var p356 = sequenceParser{id: 356, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p353 = sequenceParser{id: 353, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p352 = sequenceParser{id: 352, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p352.items = []parser{&p826, &p14}
+	p352.items = []parser{&p833, &p14}
 	p353.items = []parser{&p14, &p352}
 	var p335 = choiceParser{id: 335, commit: 66, name: "binary-op1"}
 	var p279 = sequenceParser{id: 279, commit: 72, name: "binary-or", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{335}}
@@ -1481,19 +1481,19 @@ func Parse(r io.Reader) (*Node, error) {
 	p335.options = []parser{&p279, &p281, &p302, &p304}
 	var p355 = sequenceParser{id: 355, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p354 = sequenceParser{id: 354, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p354.items = []parser{&p826, &p14}
-	p355.items = []parser{&p826, &p14, &p354}
-	p356.items = []parser{&p353, &p826, &p335, &p355, &p826, &p340}
+	p354.items = []parser{&p833, &p14}
+	p355.items = []parser{&p833, &p14, &p354}
+	p356.items = []parser{&p353, &p833, &p335, &p355, &p833, &p340}
 	var p357 = sequenceParser{id: 357, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p357.items = []parser{&p826, &p356}
-	p358.items = []parser{&p340, &p826, &p356, &p357}
-	var p365 = sequenceParser{id: 365, commit: 64, name: "binary2", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 342, 343, 402, 394, 591, 584, 794}}
+	p357.items = []parser{&p833, &p356}
+	p358.items = []parser{&p340, &p833, &p356, &p357}
+	var p365 = sequenceParser{id: 365, commit: 64, name: "binary2", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 342, 343, 402, 394, 598, 591, 801}}
 	var p341 = choiceParser{id: 341, commit: 66, name: "operand2", generalizations: []int{342, 343}}
 	p341.options = []parser{&p340, &p358}
 	var p363 = sequenceParser{id: 363, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p360 = sequenceParser{id: 360, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p359 = sequenceParser{id: 359, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p359.items = []parser{&p826, &p14}
+	p359.items = []parser{&p833, &p14}
 	p360.items = []parser{&p14, &p359}
 	var p336 = choiceParser{id: 336, commit: 66, name: "binary-op2"}
 	var p309 = sequenceParser{id: 309, commit: 72, name: "eq", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}, generalizations: []int{336}}
@@ -1521,19 +1521,19 @@ func Parse(r io.Reader) (*Node, error) {
 	p336.options = []parser{&p309, &p312, &p314, &p317, &p319, &p322}
 	var p362 = sequenceParser{id: 362, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p361 = sequenceParser{id: 361, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p361.items = []parser{&p826, &p14}
-	p362.items = []parser{&p826, &p14, &p361}
-	p363.items = []parser{&p360, &p826, &p336, &p362, &p826, &p341}
+	p361.items = []parser{&p833, &p14}
+	p362.items = []parser{&p833, &p14, &p361}
+	p363.items = []parser{&p360, &p833, &p336, &p362, &p833, &p341}
 	var p364 = sequenceParser{id: 364, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p364.items = []parser{&p826, &p363}
-	p365.items = []parser{&p341, &p826, &p363, &p364}
-	var p372 = sequenceParser{id: 372, commit: 64, name: "binary3", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 343, 402, 394, 591, 584, 794}}
+	p364.items = []parser{&p833, &p363}
+	p365.items = []parser{&p341, &p833, &p363, &p364}
+	var p372 = sequenceParser{id: 372, commit: 64, name: "binary3", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 343, 402, 394, 598, 591, 801}}
 	var p342 = choiceParser{id: 342, commit: 66, name: "operand3", generalizations: []int{343}}
 	p342.options = []parser{&p341, &p365}
 	var p370 = sequenceParser{id: 370, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p367 = sequenceParser{id: 367, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p366 = sequenceParser{id: 366, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p366.items = []parser{&p826, &p14}
+	p366.items = []parser{&p833, &p14}
 	p367.items = []parser{&p14, &p366}
 	var p337 = sequenceParser{id: 337, commit: 66, name: "binary-op3", ranges: [][]int{{1, 1}}}
 	var p325 = sequenceParser{id: 325, commit: 72, name: "logical-and", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -1543,19 +1543,19 @@ func Parse(r io.Reader) (*Node, error) {
 	p337.items = []parser{&p325}
 	var p369 = sequenceParser{id: 369, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p368 = sequenceParser{id: 368, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p368.items = []parser{&p826, &p14}
-	p369.items = []parser{&p826, &p14, &p368}
-	p370.items = []parser{&p367, &p826, &p337, &p369, &p826, &p342}
+	p368.items = []parser{&p833, &p14}
+	p369.items = []parser{&p833, &p14, &p368}
+	p370.items = []parser{&p367, &p833, &p337, &p369, &p833, &p342}
 	var p371 = sequenceParser{id: 371, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p371.items = []parser{&p826, &p370}
-	p372.items = []parser{&p342, &p826, &p370, &p371}
-	var p379 = sequenceParser{id: 379, commit: 64, name: "binary4", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 402, 394, 591, 584, 794}}
+	p371.items = []parser{&p833, &p370}
+	p372.items = []parser{&p342, &p833, &p370, &p371}
+	var p379 = sequenceParser{id: 379, commit: 64, name: "binary4", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{380, 402, 394, 598, 591, 801}}
 	var p343 = choiceParser{id: 343, commit: 66, name: "operand4"}
 	p343.options = []parser{&p342, &p372}
 	var p377 = sequenceParser{id: 377, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p374 = sequenceParser{id: 374, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p373 = sequenceParser{id: 373, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p373.items = []parser{&p826, &p14}
+	p373.items = []parser{&p833, &p14}
 	p374.items = []parser{&p14, &p373}
 	var p338 = sequenceParser{id: 338, commit: 66, name: "binary-op4", ranges: [][]int{{1, 1}}}
 	var p328 = sequenceParser{id: 328, commit: 72, name: "logical-or", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -1565,44 +1565,44 @@ func Parse(r io.Reader) (*Node, error) {
 	p338.items = []parser{&p328}
 	var p376 = sequenceParser{id: 376, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p375 = sequenceParser{id: 375, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p375.items = []parser{&p826, &p14}
-	p376.items = []parser{&p826, &p14, &p375}
-	p377.items = []parser{&p374, &p826, &p338, &p376, &p826, &p343}
+	p375.items = []parser{&p833, &p14}
+	p376.items = []parser{&p833, &p14, &p375}
+	p377.items = []parser{&p374, &p833, &p338, &p376, &p833, &p343}
 	var p378 = sequenceParser{id: 378, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p378.items = []parser{&p826, &p377}
-	p379.items = []parser{&p343, &p826, &p377, &p378}
+	p378.items = []parser{&p833, &p377}
+	p379.items = []parser{&p343, &p833, &p377, &p378}
 	p380.options = []parser{&p351, &p358, &p365, &p372, &p379}
-	var p393 = sequenceParser{id: 393, commit: 64, name: "ternary-expression", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{402, 394, 591, 584, 794}}
+	var p393 = sequenceParser{id: 393, commit: 64, name: "ternary-expression", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{402, 394, 598, 591, 801}}
 	var p386 = sequenceParser{id: 386, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p385 = sequenceParser{id: 385, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p385.items = []parser{&p826, &p14}
-	p386.items = []parser{&p826, &p14, &p385}
+	p385.items = []parser{&p833, &p14}
+	p386.items = []parser{&p833, &p14, &p385}
 	var p382 = sequenceParser{id: 382, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p381 = charParser{id: 381, chars: []rune{63}}
 	p382.items = []parser{&p381}
 	var p388 = sequenceParser{id: 388, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p387 = sequenceParser{id: 387, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p387.items = []parser{&p826, &p14}
-	p388.items = []parser{&p826, &p14, &p387}
+	p387.items = []parser{&p833, &p14}
+	p388.items = []parser{&p833, &p14, &p387}
 	var p390 = sequenceParser{id: 390, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p389 = sequenceParser{id: 389, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p389.items = []parser{&p826, &p14}
-	p390.items = []parser{&p826, &p14, &p389}
+	p389.items = []parser{&p833, &p14}
+	p390.items = []parser{&p833, &p14, &p389}
 	var p384 = sequenceParser{id: 384, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p383 = charParser{id: 383, chars: []rune{58}}
 	p384.items = []parser{&p383}
 	var p392 = sequenceParser{id: 392, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p391 = sequenceParser{id: 391, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p391.items = []parser{&p826, &p14}
-	p392.items = []parser{&p826, &p14, &p391}
-	p393.items = []parser{&p402, &p386, &p826, &p382, &p388, &p826, &p402, &p390, &p826, &p384, &p392, &p826, &p402}
-	var p401 = sequenceParser{id: 401, commit: 64, name: "chaining", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{402, 591, 584, 794}}
+	p391.items = []parser{&p833, &p14}
+	p392.items = []parser{&p833, &p14, &p391}
+	p393.items = []parser{&p402, &p386, &p833, &p382, &p388, &p833, &p402, &p390, &p833, &p384, &p392, &p833, &p402}
+	var p401 = sequenceParser{id: 401, commit: 64, name: "chaining", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}, generalizations: []int{402, 598, 591, 801}}
 	var p394 = choiceParser{id: 394, commit: 66, name: "chainingOperand"}
 	p394.options = []parser{&p273, &p333, &p380, &p393}
 	var p399 = sequenceParser{id: 399, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p396 = sequenceParser{id: 396, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p395 = sequenceParser{id: 395, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p395.items = []parser{&p826, &p14}
+	p395.items = []parser{&p833, &p14}
 	p396.items = []parser{&p14, &p395}
 	var p331 = sequenceParser{id: 331, commit: 74, name: "chain", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p329 = charParser{id: 329, chars: []rune{45}}
@@ -1610,16 +1610,16 @@ func Parse(r io.Reader) (*Node, error) {
 	p331.items = []parser{&p329, &p330}
 	var p398 = sequenceParser{id: 398, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p397 = sequenceParser{id: 397, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p397.items = []parser{&p826, &p14}
-	p398.items = []parser{&p826, &p14, &p397}
-	p399.items = []parser{&p396, &p826, &p331, &p398, &p826, &p394}
+	p397.items = []parser{&p833, &p14}
+	p398.items = []parser{&p833, &p14, &p397}
+	p399.items = []parser{&p396, &p833, &p331, &p398, &p833, &p394}
 	var p400 = sequenceParser{id: 400, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p400.items = []parser{&p826, &p399}
-	p401.items = []parser{&p394, &p826, &p399, &p400}
+	p400.items = []parser{&p833, &p399}
+	p401.items = []parser{&p394, &p833, &p399, &p400}
 	p402.options = []parser{&p273, &p333, &p380, &p393, &p401}
-	p186.items = []parser{&p185, &p826, &p402}
-	p187.items = []parser{&p183, &p826, &p186}
-	var p433 = sequenceParser{id: 433, commit: 64, name: "if", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {0, 1}}, generalizations: []int{794, 481, 542}}
+	p186.items = []parser{&p185, &p833, &p402}
+	p187.items = []parser{&p183, &p833, &p186}
+	var p433 = sequenceParser{id: 433, commit: 64, name: "if", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {0, 1}}, generalizations: []int{801, 481, 542}}
 	var p406 = sequenceParser{id: 406, commit: 74, name: "if-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p405 = sequenceParser{id: 405, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p403 = charParser{id: 403, chars: []rune{105}}
@@ -1628,17 +1628,17 @@ func Parse(r io.Reader) (*Node, error) {
 	p406.items = []parser{&p405, &p15}
 	var p428 = sequenceParser{id: 428, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p427 = sequenceParser{id: 427, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p427.items = []parser{&p826, &p14}
-	p428.items = []parser{&p826, &p14, &p427}
+	p427.items = []parser{&p833, &p14}
+	p428.items = []parser{&p833, &p14, &p427}
 	var p430 = sequenceParser{id: 430, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p429 = sequenceParser{id: 429, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p429.items = []parser{&p826, &p14}
-	p430.items = []parser{&p826, &p14, &p429}
+	p429.items = []parser{&p833, &p14}
+	p430.items = []parser{&p833, &p14, &p429}
 	var p432 = sequenceParser{id: 432, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p421 = sequenceParser{id: 421, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p414 = sequenceParser{id: 414, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p413 = sequenceParser{id: 413, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p413.items = []parser{&p826, &p14}
+	p413.items = []parser{&p833, &p14}
 	p414.items = []parser{&p14, &p413}
 	var p412 = sequenceParser{id: 412, commit: 74, name: "else-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p411 = sequenceParser{id: 411, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -1650,32 +1650,32 @@ func Parse(r io.Reader) (*Node, error) {
 	p412.items = []parser{&p411, &p15}
 	var p416 = sequenceParser{id: 416, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p415 = sequenceParser{id: 415, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p415.items = []parser{&p826, &p14}
-	p416.items = []parser{&p826, &p14, &p415}
+	p415.items = []parser{&p833, &p14}
+	p416.items = []parser{&p833, &p14, &p415}
 	var p418 = sequenceParser{id: 418, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p417 = sequenceParser{id: 417, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p417.items = []parser{&p826, &p14}
-	p418.items = []parser{&p826, &p14, &p417}
+	p417.items = []parser{&p833, &p14}
+	p418.items = []parser{&p833, &p14, &p417}
 	var p420 = sequenceParser{id: 420, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p419 = sequenceParser{id: 419, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p419.items = []parser{&p826, &p14}
-	p420.items = []parser{&p826, &p14, &p419}
-	p421.items = []parser{&p414, &p826, &p412, &p416, &p826, &p406, &p418, &p826, &p402, &p420, &p826, &p192}
+	p419.items = []parser{&p833, &p14}
+	p420.items = []parser{&p833, &p14, &p419}
+	p421.items = []parser{&p414, &p833, &p412, &p416, &p833, &p406, &p418, &p833, &p402, &p420, &p833, &p192}
 	var p431 = sequenceParser{id: 431, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p431.items = []parser{&p826, &p421}
-	p432.items = []parser{&p826, &p421, &p431}
+	p431.items = []parser{&p833, &p421}
+	p432.items = []parser{&p833, &p421, &p431}
 	var p426 = sequenceParser{id: 426, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p423 = sequenceParser{id: 423, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var p422 = sequenceParser{id: 422, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p422.items = []parser{&p826, &p14}
+	p422.items = []parser{&p833, &p14}
 	p423.items = []parser{&p14, &p422}
 	var p425 = sequenceParser{id: 425, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p424 = sequenceParser{id: 424, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p424.items = []parser{&p826, &p14}
-	p425.items = []parser{&p826, &p14, &p424}
-	p426.items = []parser{&p423, &p826, &p412, &p425, &p826, &p192}
-	p433.items = []parser{&p406, &p428, &p826, &p402, &p430, &p826, &p192, &p432, &p826, &p426}
-	var p492 = sequenceParser{id: 492, commit: 64, name: "switch", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{481, 794, 542}}
+	p424.items = []parser{&p833, &p14}
+	p425.items = []parser{&p833, &p14, &p424}
+	p426.items = []parser{&p423, &p833, &p412, &p425, &p833, &p192}
+	p433.items = []parser{&p406, &p428, &p833, &p402, &p430, &p833, &p192, &p432, &p833, &p426}
+	var p492 = sequenceParser{id: 492, commit: 64, name: "switch", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{481, 801, 542}}
 	var p447 = sequenceParser{id: 447, commit: 74, name: "switch-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p446 = sequenceParser{id: 446, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p440 = charParser{id: 440, chars: []rune{115}}
@@ -1688,12 +1688,12 @@ func Parse(r io.Reader) (*Node, error) {
 	p447.items = []parser{&p446, &p15}
 	var p489 = sequenceParser{id: 489, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p488 = sequenceParser{id: 488, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p488.items = []parser{&p826, &p14}
-	p489.items = []parser{&p826, &p14, &p488}
+	p488.items = []parser{&p833, &p14}
+	p489.items = []parser{&p833, &p14, &p488}
 	var p491 = sequenceParser{id: 491, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p490 = sequenceParser{id: 490, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p490.items = []parser{&p826, &p14}
-	p491.items = []parser{&p826, &p14, &p490}
+	p490.items = []parser{&p833, &p14}
+	p491.items = []parser{&p833, &p14, &p490}
 	var p479 = sequenceParser{id: 479, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p478 = charParser{id: 478, chars: []rune{123}}
 	p479.items = []parser{&p478}
@@ -1711,24 +1711,24 @@ func Parse(r io.Reader) (*Node, error) {
 	p439.items = []parser{&p438, &p15}
 	var p469 = sequenceParser{id: 469, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p468 = sequenceParser{id: 468, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p468.items = []parser{&p826, &p14}
-	p469.items = []parser{&p826, &p14, &p468}
+	p468.items = []parser{&p833, &p14}
+	p469.items = []parser{&p833, &p14, &p468}
 	var p471 = sequenceParser{id: 471, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p470 = sequenceParser{id: 470, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p470.items = []parser{&p826, &p14}
-	p471.items = []parser{&p826, &p14, &p470}
+	p470.items = []parser{&p833, &p14}
+	p471.items = []parser{&p833, &p14, &p470}
 	var p467 = sequenceParser{id: 467, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p466 = charParser{id: 466, chars: []rune{58}}
 	p467.items = []parser{&p466}
-	p472.items = []parser{&p439, &p469, &p826, &p402, &p471, &p826, &p467}
+	p472.items = []parser{&p439, &p469, &p833, &p402, &p471, &p833, &p467}
 	var p476 = sequenceParser{id: 476, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p474 = sequenceParser{id: 474, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p473 = charParser{id: 473, chars: []rune{59}}
 	p474.items = []parser{&p473}
 	var p475 = sequenceParser{id: 475, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p475.items = []parser{&p826, &p474}
-	p476.items = []parser{&p826, &p474, &p475}
-	p477.items = []parser{&p472, &p476, &p826, &p794}
+	p475.items = []parser{&p833, &p474}
+	p476.items = []parser{&p833, &p474, &p475}
+	p477.items = []parser{&p472, &p476, &p833, &p801}
 	var p465 = sequenceParser{id: 465, commit: 66, name: "default-line", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {0, 1}}, generalizations: []int{480, 481, 541, 542}}
 	var p460 = sequenceParser{id: 460, commit: 64, name: "default", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p455 = sequenceParser{id: 455, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -1742,35 +1742,35 @@ func Parse(r io.Reader) (*Node, error) {
 	p455.items = []parser{&p448, &p449, &p450, &p451, &p452, &p453, &p454}
 	var p459 = sequenceParser{id: 459, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p458 = sequenceParser{id: 458, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p458.items = []parser{&p826, &p14}
-	p459.items = []parser{&p826, &p14, &p458}
+	p458.items = []parser{&p833, &p14}
+	p459.items = []parser{&p833, &p14, &p458}
 	var p457 = sequenceParser{id: 457, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p456 = charParser{id: 456, chars: []rune{58}}
 	p457.items = []parser{&p456}
-	p460.items = []parser{&p455, &p459, &p826, &p457}
+	p460.items = []parser{&p455, &p459, &p833, &p457}
 	var p464 = sequenceParser{id: 464, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p462 = sequenceParser{id: 462, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p461 = charParser{id: 461, chars: []rune{59}}
 	p462.items = []parser{&p461}
 	var p463 = sequenceParser{id: 463, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p463.items = []parser{&p826, &p462}
-	p464.items = []parser{&p826, &p462, &p463}
-	p465.items = []parser{&p460, &p464, &p826, &p794}
+	p463.items = []parser{&p833, &p462}
+	p464.items = []parser{&p833, &p462, &p463}
+	p465.items = []parser{&p460, &p464, &p833, &p801}
 	p480.options = []parser{&p477, &p465}
 	var p484 = sequenceParser{id: 484, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p482 = sequenceParser{id: 482, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
 	var p481 = choiceParser{id: 481, commit: 2}
-	p481.options = []parser{&p477, &p465, &p794}
-	p482.items = []parser{&p808, &p826, &p481}
+	p481.options = []parser{&p477, &p465, &p801}
+	p482.items = []parser{&p815, &p833, &p481}
 	var p483 = sequenceParser{id: 483, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p483.items = []parser{&p826, &p482}
-	p484.items = []parser{&p826, &p482, &p483}
+	p483.items = []parser{&p833, &p482}
+	p484.items = []parser{&p833, &p482, &p483}
 	p485.items = []parser{&p480, &p484}
 	var p487 = sequenceParser{id: 487, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p486 = charParser{id: 486, chars: []rune{125}}
 	p487.items = []parser{&p486}
-	p492.items = []parser{&p447, &p489, &p826, &p402, &p491, &p826, &p479, &p826, &p808, &p826, &p485, &p826, &p808, &p826, &p487}
-	var p551 = sequenceParser{id: 551, commit: 64, name: "select", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{542, 794}}
+	p492.items = []parser{&p447, &p489, &p833, &p402, &p491, &p833, &p479, &p833, &p815, &p833, &p485, &p833, &p815, &p833, &p487}
+	var p551 = sequenceParser{id: 551, commit: 64, name: "select", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{542, 801}}
 	var p538 = sequenceParser{id: 538, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p532 = charParser{id: 532, chars: []rune{115}}
 	var p533 = charParser{id: 533, chars: []rune{101}}
@@ -1781,8 +1781,8 @@ func Parse(r io.Reader) (*Node, error) {
 	p538.items = []parser{&p532, &p533, &p534, &p535, &p536, &p537}
 	var p550 = sequenceParser{id: 550, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p549 = sequenceParser{id: 549, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p549.items = []parser{&p826, &p14}
-	p550.items = []parser{&p826, &p14, &p549}
+	p549.items = []parser{&p833, &p14}
+	p550.items = []parser{&p833, &p14, &p549}
 	var p540 = sequenceParser{id: 540, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p539 = charParser{id: 539, chars: []rune{123}}
 	p540.items = []parser{&p539}
@@ -1792,47 +1792,47 @@ func Parse(r io.Reader) (*Node, error) {
 	var p526 = sequenceParser{id: 526, commit: 64, name: "select-case", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var p523 = sequenceParser{id: 523, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p522 = sequenceParser{id: 522, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p522.items = []parser{&p826, &p14}
-	p523.items = []parser{&p826, &p14, &p522}
+	p522.items = []parser{&p833, &p14}
+	p523.items = []parser{&p833, &p14, &p522}
 	var p519 = choiceParser{id: 519, commit: 66, name: "communication"}
 	var p518 = sequenceParser{id: 518, commit: 64, name: "receive-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{519}}
 	var p517 = sequenceParser{id: 517, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p516 = sequenceParser{id: 516, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p516.items = []parser{&p826, &p14}
-	p517.items = []parser{&p826, &p14, &p516}
-	p518.items = []parser{&p104, &p517, &p826, &p515}
+	p516.items = []parser{&p833, &p14}
+	p517.items = []parser{&p833, &p14, &p516}
+	p518.items = []parser{&p104, &p517, &p833, &p515}
 	p519.options = []parser{&p512, &p515, &p518}
 	var p525 = sequenceParser{id: 525, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p524 = sequenceParser{id: 524, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p524.items = []parser{&p826, &p14}
-	p525.items = []parser{&p826, &p14, &p524}
+	p524.items = []parser{&p833, &p14}
+	p525.items = []parser{&p833, &p14, &p524}
 	var p521 = sequenceParser{id: 521, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p520 = charParser{id: 520, chars: []rune{58}}
 	p521.items = []parser{&p520}
-	p526.items = []parser{&p439, &p523, &p826, &p519, &p525, &p826, &p521}
+	p526.items = []parser{&p439, &p523, &p833, &p519, &p525, &p833, &p521}
 	var p530 = sequenceParser{id: 530, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p528 = sequenceParser{id: 528, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p527 = charParser{id: 527, chars: []rune{59}}
 	p528.items = []parser{&p527}
 	var p529 = sequenceParser{id: 529, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p529.items = []parser{&p826, &p528}
-	p530.items = []parser{&p826, &p528, &p529}
-	p531.items = []parser{&p526, &p530, &p826, &p794}
+	p529.items = []parser{&p833, &p528}
+	p530.items = []parser{&p833, &p528, &p529}
+	p531.items = []parser{&p526, &p530, &p833, &p801}
 	p541.options = []parser{&p531, &p465}
 	var p545 = sequenceParser{id: 545, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p543 = sequenceParser{id: 543, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
 	var p542 = choiceParser{id: 542, commit: 2}
-	p542.options = []parser{&p531, &p465, &p794}
-	p543.items = []parser{&p808, &p826, &p542}
+	p542.options = []parser{&p531, &p465, &p801}
+	p543.items = []parser{&p815, &p833, &p542}
 	var p544 = sequenceParser{id: 544, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p544.items = []parser{&p826, &p543}
-	p545.items = []parser{&p826, &p543, &p544}
+	p544.items = []parser{&p833, &p543}
+	p545.items = []parser{&p833, &p543, &p544}
 	p546.items = []parser{&p541, &p545}
 	var p548 = sequenceParser{id: 548, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var p547 = charParser{id: 547, chars: []rune{125}}
 	p548.items = []parser{&p547}
-	p551.items = []parser{&p538, &p550, &p826, &p540, &p826, &p808, &p826, &p546, &p826, &p808, &p826, &p548}
-	var p602 = sequenceParser{id: 602, commit: 64, name: "loop", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}, generalizations: []int{794}}
+	p551.items = []parser{&p538, &p550, &p833, &p540, &p833, &p815, &p833, &p546, &p833, &p815, &p833, &p548}
+	var p609 = sequenceParser{id: 609, commit: 64, name: "loop", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}, generalizations: []int{801}}
 	var p583 = sequenceParser{id: 583, commit: 74, name: "for-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p582 = sequenceParser{id: 582, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p579 = charParser{id: 579, chars: []rune{102}}
@@ -1840,333 +1840,346 @@ func Parse(r io.Reader) (*Node, error) {
 	var p581 = charParser{id: 581, chars: []rune{114}}
 	p582.items = []parser{&p579, &p580, &p581}
 	p583.items = []parser{&p582, &p15}
-	var p601 = choiceParser{id: 601, commit: 2}
-	var p597 = sequenceParser{id: 597, commit: 2, ranges: [][]int{{0, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{601}}
-	var p594 = sequenceParser{id: 594, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var p593 = sequenceParser{id: 593, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var p608 = choiceParser{id: 608, commit: 2}
+	var p604 = sequenceParser{id: 604, commit: 2, ranges: [][]int{{0, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{608}}
+	var p601 = sequenceParser{id: 601, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var p600 = sequenceParser{id: 600, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var p599 = sequenceParser{id: 599, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p599.items = []parser{&p833, &p14}
+	p600.items = []parser{&p14, &p599}
+	var p598 = choiceParser{id: 598, commit: 66, name: "loop-expression"}
+	var p597 = choiceParser{id: 597, commit: 64, name: "range-over-expression", generalizations: []int{598}}
+	var p596 = sequenceParser{id: 596, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{597, 598}}
+	var p590 = sequenceParser{id: 590, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var p587 = sequenceParser{id: 587, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var p586 = sequenceParser{id: 586, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p586.items = []parser{&p833, &p14}
+	p587.items = []parser{&p14, &p586}
+	var p585 = sequenceParser{id: 585, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p584 = charParser{id: 584, chars: []rune{44}}
+	p585.items = []parser{&p584}
+	var p589 = sequenceParser{id: 589, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p588 = sequenceParser{id: 588, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p588.items = []parser{&p833, &p14}
+	p589.items = []parser{&p833, &p14, &p588}
+	p590.items = []parser{&p587, &p833, &p585, &p589, &p833, &p104}
+	var p593 = sequenceParser{id: 593, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p592 = sequenceParser{id: 592, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p592.items = []parser{&p826, &p14}
-	p593.items = []parser{&p14, &p592}
-	var p591 = choiceParser{id: 591, commit: 66, name: "loop-expression"}
-	var p590 = choiceParser{id: 590, commit: 64, name: "range-over-expression", generalizations: []int{591}}
-	var p589 = sequenceParser{id: 589, commit: 2, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{590, 591}}
-	var p586 = sequenceParser{id: 586, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p585 = sequenceParser{id: 585, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p585.items = []parser{&p826, &p14}
-	p586.items = []parser{&p826, &p14, &p585}
+	p592.items = []parser{&p833, &p14}
+	p593.items = []parser{&p833, &p14, &p592}
 	var p578 = sequenceParser{id: 578, commit: 74, name: "in-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p577 = sequenceParser{id: 577, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var p575 = charParser{id: 575, chars: []rune{105}}
 	var p576 = charParser{id: 576, chars: []rune{110}}
 	p577.items = []parser{&p575, &p576}
 	p578.items = []parser{&p577, &p15}
-	var p588 = sequenceParser{id: 588, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p587 = sequenceParser{id: 587, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p587.items = []parser{&p826, &p14}
-	p588.items = []parser{&p826, &p14, &p587}
-	var p584 = choiceParser{id: 584, commit: 2}
-	p584.options = []parser{&p402, &p227}
-	p589.items = []parser{&p104, &p586, &p826, &p578, &p588, &p826, &p584}
-	p590.options = []parser{&p589, &p227}
-	p591.options = []parser{&p402, &p590}
-	p594.items = []parser{&p593, &p826, &p591}
-	var p596 = sequenceParser{id: 596, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p595 = sequenceParser{id: 595, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p595.items = []parser{&p826, &p14}
-	p596.items = []parser{&p826, &p14, &p595}
-	p597.items = []parser{&p594, &p596, &p826, &p192}
-	var p600 = sequenceParser{id: 600, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}, generalizations: []int{601}}
-	var p599 = sequenceParser{id: 599, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var p598 = sequenceParser{id: 598, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p598.items = []parser{&p826, &p14}
-	p599.items = []parser{&p14, &p598}
-	p600.items = []parser{&p599, &p826, &p192}
-	p601.options = []parser{&p597, &p600}
-	p602.items = []parser{&p583, &p826, &p601}
-	var p740 = choiceParser{id: 740, commit: 66, name: "definition", generalizations: []int{794}}
-	var p661 = sequenceParser{id: 661, commit: 64, name: "value-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{740, 794}}
-	var p643 = sequenceParser{id: 643, commit: 74, name: "let-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p642 = sequenceParser{id: 642, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p639 = charParser{id: 639, chars: []rune{108}}
-	var p640 = charParser{id: 640, chars: []rune{101}}
-	var p641 = charParser{id: 641, chars: []rune{116}}
-	p642.items = []parser{&p639, &p640, &p641}
-	p643.items = []parser{&p642, &p15}
-	var p660 = sequenceParser{id: 660, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p659 = sequenceParser{id: 659, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p659.items = []parser{&p826, &p14}
-	p660.items = []parser{&p826, &p14, &p659}
-	var p658 = choiceParser{id: 658, commit: 2}
-	var p652 = sequenceParser{id: 652, commit: 64, name: "value-capture", ranges: [][]int{{1, 1}}, generalizations: []int{658, 662, 663}}
-	var p651 = sequenceParser{id: 651, commit: 66, name: "value-capture-fact", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var p648 = sequenceParser{id: 648, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var p647 = sequenceParser{id: 647, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var p646 = sequenceParser{id: 646, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p646.items = []parser{&p826, &p14}
-	p647.items = []parser{&p14, &p646}
-	var p645 = sequenceParser{id: 645, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p644 = charParser{id: 644, chars: []rune{61}}
-	p645.items = []parser{&p644}
-	p648.items = []parser{&p647, &p826, &p645}
-	var p650 = sequenceParser{id: 650, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p649 = sequenceParser{id: 649, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p649.items = []parser{&p826, &p14}
-	p650.items = []parser{&p826, &p14, &p649}
-	p651.items = []parser{&p104, &p826, &p648, &p650, &p826, &p402}
+	var p595 = sequenceParser{id: 595, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p594 = sequenceParser{id: 594, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p594.items = []parser{&p833, &p14}
+	p595.items = []parser{&p833, &p14, &p594}
+	var p591 = choiceParser{id: 591, commit: 2}
+	p591.options = []parser{&p402, &p227}
+	p596.items = []parser{&p104, &p833, &p590, &p593, &p833, &p578, &p595, &p833, &p591}
+	p597.options = []parser{&p596, &p227}
+	p598.options = []parser{&p402, &p597}
+	p601.items = []parser{&p600, &p833, &p598}
+	var p603 = sequenceParser{id: 603, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p602 = sequenceParser{id: 602, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p602.items = []parser{&p833, &p14}
+	p603.items = []parser{&p833, &p14, &p602}
+	p604.items = []parser{&p601, &p603, &p833, &p192}
+	var p607 = sequenceParser{id: 607, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}, generalizations: []int{608}}
+	var p606 = sequenceParser{id: 606, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var p605 = sequenceParser{id: 605, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p605.items = []parser{&p833, &p14}
+	p606.items = []parser{&p14, &p605}
+	p607.items = []parser{&p606, &p833, &p192}
+	p608.options = []parser{&p604, &p607}
+	p609.items = []parser{&p583, &p833, &p608}
+	var p747 = choiceParser{id: 747, commit: 66, name: "definition", generalizations: []int{801}}
+	var p668 = sequenceParser{id: 668, commit: 64, name: "value-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{747, 801}}
+	var p650 = sequenceParser{id: 650, commit: 74, name: "let-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p649 = sequenceParser{id: 649, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p646 = charParser{id: 646, chars: []rune{108}}
+	var p647 = charParser{id: 647, chars: []rune{101}}
+	var p648 = charParser{id: 648, chars: []rune{116}}
+	p649.items = []parser{&p646, &p647, &p648}
+	p650.items = []parser{&p649, &p15}
+	var p667 = sequenceParser{id: 667, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p666 = sequenceParser{id: 666, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p666.items = []parser{&p833, &p14}
+	p667.items = []parser{&p833, &p14, &p666}
+	var p665 = choiceParser{id: 665, commit: 2}
+	var p659 = sequenceParser{id: 659, commit: 64, name: "value-capture", ranges: [][]int{{1, 1}}, generalizations: []int{665, 669, 670}}
+	var p658 = sequenceParser{id: 658, commit: 66, name: "value-capture-fact", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var p655 = sequenceParser{id: 655, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var p654 = sequenceParser{id: 654, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var p653 = sequenceParser{id: 653, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p653.items = []parser{&p833, &p14}
+	p654.items = []parser{&p14, &p653}
+	var p652 = sequenceParser{id: 652, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p651 = charParser{id: 651, chars: []rune{61}}
 	p652.items = []parser{&p651}
-	var p657 = sequenceParser{id: 657, commit: 64, name: "mutable-capture", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{658, 662, 663}}
-	var p654 = sequenceParser{id: 654, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p653 = charParser{id: 653, chars: []rune{126}}
-	p654.items = []parser{&p653}
-	var p656 = sequenceParser{id: 656, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p655 = sequenceParser{id: 655, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p655.items = []parser{&p826, &p14}
-	p656.items = []parser{&p826, &p14, &p655}
-	p657.items = []parser{&p654, &p656, &p826, &p651}
-	p658.options = []parser{&p652, &p657}
-	p661.items = []parser{&p643, &p660, &p826, &p658}
-	var p678 = sequenceParser{id: 678, commit: 64, name: "value-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{740, 794}}
-	var p677 = sequenceParser{id: 677, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p676 = sequenceParser{id: 676, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p676.items = []parser{&p826, &p14}
-	p677.items = []parser{&p826, &p14, &p676}
-	var p673 = sequenceParser{id: 673, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p672 = charParser{id: 672, chars: []rune{40}}
-	p673.items = []parser{&p672}
-	var p667 = sequenceParser{id: 667, commit: 66, name: "mixed-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
-	var p662 = choiceParser{id: 662, commit: 2}
-	p662.options = []parser{&p652, &p657}
-	var p666 = sequenceParser{id: 666, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p664 = sequenceParser{id: 664, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	var p663 = choiceParser{id: 663, commit: 2}
-	p663.options = []parser{&p652, &p657}
-	p664.items = []parser{&p114, &p826, &p663}
-	var p665 = sequenceParser{id: 665, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p665.items = []parser{&p826, &p664}
-	p666.items = []parser{&p826, &p664, &p665}
-	p667.items = []parser{&p662, &p666}
-	var p675 = sequenceParser{id: 675, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p674 = charParser{id: 674, chars: []rune{41}}
-	p675.items = []parser{&p674}
-	p678.items = []parser{&p643, &p677, &p826, &p673, &p826, &p114, &p826, &p667, &p826, &p114, &p826, &p675}
-	var p689 = sequenceParser{id: 689, commit: 64, name: "mutable-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{740, 794}}
-	var p686 = sequenceParser{id: 686, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p685 = sequenceParser{id: 685, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p685.items = []parser{&p826, &p14}
-	p686.items = []parser{&p826, &p14, &p685}
+	p655.items = []parser{&p654, &p833, &p652}
+	var p657 = sequenceParser{id: 657, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p656 = sequenceParser{id: 656, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p656.items = []parser{&p833, &p14}
+	p657.items = []parser{&p833, &p14, &p656}
+	p658.items = []parser{&p104, &p833, &p655, &p657, &p833, &p402}
+	p659.items = []parser{&p658}
+	var p664 = sequenceParser{id: 664, commit: 64, name: "mutable-capture", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{665, 669, 670}}
+	var p661 = sequenceParser{id: 661, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p660 = charParser{id: 660, chars: []rune{126}}
+	p661.items = []parser{&p660}
+	var p663 = sequenceParser{id: 663, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p662 = sequenceParser{id: 662, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p662.items = []parser{&p833, &p14}
+	p663.items = []parser{&p833, &p14, &p662}
+	p664.items = []parser{&p661, &p663, &p833, &p658}
+	p665.options = []parser{&p659, &p664}
+	p668.items = []parser{&p650, &p667, &p833, &p665}
+	var p685 = sequenceParser{id: 685, commit: 64, name: "value-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{747, 801}}
+	var p684 = sequenceParser{id: 684, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p683 = sequenceParser{id: 683, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p683.items = []parser{&p833, &p14}
+	p684.items = []parser{&p833, &p14, &p683}
 	var p680 = sequenceParser{id: 680, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p679 = charParser{id: 679, chars: []rune{126}}
+	var p679 = charParser{id: 679, chars: []rune{40}}
 	p680.items = []parser{&p679}
-	var p688 = sequenceParser{id: 688, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p687 = sequenceParser{id: 687, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p687.items = []parser{&p826, &p14}
-	p688.items = []parser{&p826, &p14, &p687}
+	var p674 = sequenceParser{id: 674, commit: 66, name: "mixed-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
+	var p669 = choiceParser{id: 669, commit: 2}
+	p669.options = []parser{&p659, &p664}
+	var p673 = sequenceParser{id: 673, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p671 = sequenceParser{id: 671, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	var p670 = choiceParser{id: 670, commit: 2}
+	p670.options = []parser{&p659, &p664}
+	p671.items = []parser{&p114, &p833, &p670}
+	var p672 = sequenceParser{id: 672, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p672.items = []parser{&p833, &p671}
+	p673.items = []parser{&p833, &p671, &p672}
+	p674.items = []parser{&p669, &p673}
 	var p682 = sequenceParser{id: 682, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p681 = charParser{id: 681, chars: []rune{40}}
+	var p681 = charParser{id: 681, chars: []rune{41}}
 	p682.items = []parser{&p681}
-	var p671 = sequenceParser{id: 671, commit: 66, name: "value-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
-	var p670 = sequenceParser{id: 670, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p668 = sequenceParser{id: 668, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	p668.items = []parser{&p114, &p826, &p652}
-	var p669 = sequenceParser{id: 669, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p669.items = []parser{&p826, &p668}
-	p670.items = []parser{&p826, &p668, &p669}
-	p671.items = []parser{&p652, &p670}
-	var p684 = sequenceParser{id: 684, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p683 = charParser{id: 683, chars: []rune{41}}
-	p684.items = []parser{&p683}
-	p689.items = []parser{&p643, &p686, &p826, &p680, &p688, &p826, &p682, &p826, &p114, &p826, &p671, &p826, &p114, &p826, &p684}
-	var p705 = sequenceParser{id: 705, commit: 64, name: "function-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{740, 794}}
-	var p701 = sequenceParser{id: 701, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p699 = charParser{id: 699, chars: []rune{102}}
-	var p700 = charParser{id: 700, chars: []rune{110}}
-	p701.items = []parser{&p699, &p700}
+	p685.items = []parser{&p650, &p684, &p833, &p680, &p833, &p114, &p833, &p674, &p833, &p114, &p833, &p682}
+	var p696 = sequenceParser{id: 696, commit: 64, name: "mutable-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{747, 801}}
+	var p693 = sequenceParser{id: 693, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p692 = sequenceParser{id: 692, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p692.items = []parser{&p833, &p14}
+	p693.items = []parser{&p833, &p14, &p692}
+	var p687 = sequenceParser{id: 687, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p686 = charParser{id: 686, chars: []rune{126}}
+	p687.items = []parser{&p686}
+	var p695 = sequenceParser{id: 695, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p694 = sequenceParser{id: 694, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p694.items = []parser{&p833, &p14}
+	p695.items = []parser{&p833, &p14, &p694}
+	var p689 = sequenceParser{id: 689, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p688 = charParser{id: 688, chars: []rune{40}}
+	p689.items = []parser{&p688}
+	var p678 = sequenceParser{id: 678, commit: 66, name: "value-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
+	var p677 = sequenceParser{id: 677, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p675 = sequenceParser{id: 675, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	p675.items = []parser{&p114, &p833, &p659}
+	var p676 = sequenceParser{id: 676, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p676.items = []parser{&p833, &p675}
+	p677.items = []parser{&p833, &p675, &p676}
+	p678.items = []parser{&p659, &p677}
+	var p691 = sequenceParser{id: 691, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p690 = charParser{id: 690, chars: []rune{41}}
+	p691.items = []parser{&p690}
+	p696.items = []parser{&p650, &p693, &p833, &p687, &p695, &p833, &p689, &p833, &p114, &p833, &p678, &p833, &p114, &p833, &p691}
+	var p712 = sequenceParser{id: 712, commit: 64, name: "function-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{747, 801}}
+	var p708 = sequenceParser{id: 708, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p706 = charParser{id: 706, chars: []rune{102}}
+	var p707 = charParser{id: 707, chars: []rune{110}}
+	p708.items = []parser{&p706, &p707}
+	var p711 = sequenceParser{id: 711, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p710 = sequenceParser{id: 710, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p710.items = []parser{&p833, &p14}
+	p711.items = []parser{&p833, &p14, &p710}
+	var p709 = choiceParser{id: 709, commit: 2}
+	var p700 = sequenceParser{id: 700, commit: 64, name: "function-capture", ranges: [][]int{{1, 1}}, generalizations: []int{709, 717, 718}}
+	var p699 = sequenceParser{id: 699, commit: 66, name: "function-definition-fact", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var p698 = sequenceParser{id: 698, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p697 = sequenceParser{id: 697, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p697.items = []parser{&p833, &p14}
+	p698.items = []parser{&p833, &p14, &p697}
+	p699.items = []parser{&p104, &p698, &p833, &p202}
+	p700.items = []parser{&p699}
+	var p705 = sequenceParser{id: 705, commit: 64, name: "effect-capture", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{709, 717, 718}}
+	var p702 = sequenceParser{id: 702, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p701 = charParser{id: 701, chars: []rune{126}}
+	p702.items = []parser{&p701}
 	var p704 = sequenceParser{id: 704, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p703 = sequenceParser{id: 703, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p703.items = []parser{&p826, &p14}
-	p704.items = []parser{&p826, &p14, &p703}
-	var p702 = choiceParser{id: 702, commit: 2}
-	var p693 = sequenceParser{id: 693, commit: 64, name: "function-capture", ranges: [][]int{{1, 1}}, generalizations: []int{702, 710, 711}}
-	var p692 = sequenceParser{id: 692, commit: 66, name: "function-definition-fact", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var p691 = sequenceParser{id: 691, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p690 = sequenceParser{id: 690, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p690.items = []parser{&p826, &p14}
-	p691.items = []parser{&p826, &p14, &p690}
-	p692.items = []parser{&p104, &p691, &p826, &p202}
-	p693.items = []parser{&p692}
-	var p698 = sequenceParser{id: 698, commit: 64, name: "effect-capture", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{702, 710, 711}}
-	var p695 = sequenceParser{id: 695, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p694 = charParser{id: 694, chars: []rune{126}}
-	p695.items = []parser{&p694}
-	var p697 = sequenceParser{id: 697, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p696 = sequenceParser{id: 696, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p696.items = []parser{&p826, &p14}
-	p697.items = []parser{&p826, &p14, &p696}
-	p698.items = []parser{&p695, &p697, &p826, &p692}
-	p702.options = []parser{&p693, &p698}
-	p705.items = []parser{&p701, &p704, &p826, &p702}
-	var p725 = sequenceParser{id: 725, commit: 64, name: "function-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{740, 794}}
-	var p718 = sequenceParser{id: 718, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p716 = charParser{id: 716, chars: []rune{102}}
-	var p717 = charParser{id: 717, chars: []rune{110}}
-	p718.items = []parser{&p716, &p717}
-	var p724 = sequenceParser{id: 724, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p723 = sequenceParser{id: 723, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p723.items = []parser{&p826, &p14}
-	p724.items = []parser{&p826, &p14, &p723}
-	var p720 = sequenceParser{id: 720, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p719 = charParser{id: 719, chars: []rune{40}}
-	p720.items = []parser{&p719}
-	var p715 = sequenceParser{id: 715, commit: 66, name: "mixed-function-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
-	var p710 = choiceParser{id: 710, commit: 2}
-	p710.options = []parser{&p693, &p698}
-	var p714 = sequenceParser{id: 714, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p712 = sequenceParser{id: 712, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	var p711 = choiceParser{id: 711, commit: 2}
-	p711.options = []parser{&p693, &p698}
-	p712.items = []parser{&p114, &p826, &p711}
-	var p713 = sequenceParser{id: 713, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p713.items = []parser{&p826, &p712}
-	p714.items = []parser{&p826, &p712, &p713}
-	p715.items = []parser{&p710, &p714}
-	var p722 = sequenceParser{id: 722, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p721 = charParser{id: 721, chars: []rune{41}}
-	p722.items = []parser{&p721}
-	p725.items = []parser{&p718, &p724, &p826, &p720, &p826, &p114, &p826, &p715, &p826, &p114, &p826, &p722}
-	var p739 = sequenceParser{id: 739, commit: 64, name: "effect-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{740, 794}}
-	var p728 = sequenceParser{id: 728, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p726 = charParser{id: 726, chars: []rune{102}}
-	var p727 = charParser{id: 727, chars: []rune{110}}
-	p728.items = []parser{&p726, &p727}
-	var p736 = sequenceParser{id: 736, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p735 = sequenceParser{id: 735, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p735.items = []parser{&p826, &p14}
-	p736.items = []parser{&p826, &p14, &p735}
-	var p730 = sequenceParser{id: 730, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p729 = charParser{id: 729, chars: []rune{126}}
-	p730.items = []parser{&p729}
-	var p738 = sequenceParser{id: 738, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p737 = sequenceParser{id: 737, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p737.items = []parser{&p826, &p14}
-	p738.items = []parser{&p826, &p14, &p737}
-	var p732 = sequenceParser{id: 732, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p731 = charParser{id: 731, chars: []rune{40}}
-	p732.items = []parser{&p731}
-	var p709 = sequenceParser{id: 709, commit: 66, name: "function-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
-	var p708 = sequenceParser{id: 708, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p706 = sequenceParser{id: 706, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	p706.items = []parser{&p114, &p826, &p693}
-	var p707 = sequenceParser{id: 707, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p707.items = []parser{&p826, &p706}
-	p708.items = []parser{&p826, &p706, &p707}
-	p709.items = []parser{&p693, &p708}
-	var p734 = sequenceParser{id: 734, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p733 = charParser{id: 733, chars: []rune{41}}
-	p734.items = []parser{&p733}
-	p739.items = []parser{&p728, &p736, &p826, &p730, &p738, &p826, &p732, &p826, &p114, &p826, &p709, &p826, &p114, &p826, &p734}
-	p740.options = []parser{&p661, &p678, &p689, &p705, &p725, &p739}
-	var p772 = choiceParser{id: 772, commit: 64, name: "use", generalizations: []int{794}}
-	var p764 = sequenceParser{id: 764, commit: 66, name: "use-statement", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{772, 794}}
-	var p745 = sequenceParser{id: 745, commit: 74, name: "use-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p744 = sequenceParser{id: 744, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p741 = charParser{id: 741, chars: []rune{117}}
-	var p742 = charParser{id: 742, chars: []rune{115}}
-	var p743 = charParser{id: 743, chars: []rune{101}}
-	p744.items = []parser{&p741, &p742, &p743}
-	p745.items = []parser{&p744, &p15}
-	var p763 = sequenceParser{id: 763, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p762 = sequenceParser{id: 762, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p762.items = []parser{&p826, &p14}
-	p763.items = []parser{&p826, &p14, &p762}
-	var p757 = choiceParser{id: 757, commit: 64, name: "use-fact"}
-	var p756 = sequenceParser{id: 756, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{757}}
-	var p748 = choiceParser{id: 748, commit: 2}
-	var p747 = sequenceParser{id: 747, commit: 72, name: "use-inline", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{748}}
-	var p746 = charParser{id: 746, chars: []rune{46}}
-	p747.items = []parser{&p746}
-	p748.options = []parser{&p104, &p747}
-	var p753 = sequenceParser{id: 753, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var p752 = sequenceParser{id: 752, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var p751 = sequenceParser{id: 751, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p751.items = []parser{&p826, &p14}
-	p752.items = []parser{&p14, &p751}
-	var p750 = sequenceParser{id: 750, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p749 = charParser{id: 749, chars: []rune{61}}
-	p750.items = []parser{&p749}
-	p753.items = []parser{&p752, &p826, &p750}
-	var p755 = sequenceParser{id: 755, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p754 = sequenceParser{id: 754, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p754.items = []parser{&p826, &p14}
-	p755.items = []parser{&p826, &p14, &p754}
-	p756.items = []parser{&p748, &p826, &p753, &p755, &p826, &p87}
-	p757.options = []parser{&p87, &p756}
-	p764.items = []parser{&p745, &p763, &p826, &p757}
-	var p771 = sequenceParser{id: 771, commit: 66, name: "use-statement-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{772, 794}}
+	p703.items = []parser{&p833, &p14}
+	p704.items = []parser{&p833, &p14, &p703}
+	p705.items = []parser{&p702, &p704, &p833, &p699}
+	p709.options = []parser{&p700, &p705}
+	p712.items = []parser{&p708, &p711, &p833, &p709}
+	var p732 = sequenceParser{id: 732, commit: 64, name: "function-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{747, 801}}
+	var p725 = sequenceParser{id: 725, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p723 = charParser{id: 723, chars: []rune{102}}
+	var p724 = charParser{id: 724, chars: []rune{110}}
+	p725.items = []parser{&p723, &p724}
+	var p731 = sequenceParser{id: 731, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p730 = sequenceParser{id: 730, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p730.items = []parser{&p833, &p14}
+	p731.items = []parser{&p833, &p14, &p730}
+	var p727 = sequenceParser{id: 727, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p726 = charParser{id: 726, chars: []rune{40}}
+	p727.items = []parser{&p726}
+	var p722 = sequenceParser{id: 722, commit: 66, name: "mixed-function-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
+	var p717 = choiceParser{id: 717, commit: 2}
+	p717.options = []parser{&p700, &p705}
+	var p721 = sequenceParser{id: 721, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p719 = sequenceParser{id: 719, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	var p718 = choiceParser{id: 718, commit: 2}
+	p718.options = []parser{&p700, &p705}
+	p719.items = []parser{&p114, &p833, &p718}
+	var p720 = sequenceParser{id: 720, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p720.items = []parser{&p833, &p719}
+	p721.items = []parser{&p833, &p719, &p720}
+	p722.items = []parser{&p717, &p721}
+	var p729 = sequenceParser{id: 729, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p728 = charParser{id: 728, chars: []rune{41}}
+	p729.items = []parser{&p728}
+	p732.items = []parser{&p725, &p731, &p833, &p727, &p833, &p114, &p833, &p722, &p833, &p114, &p833, &p729}
+	var p746 = sequenceParser{id: 746, commit: 64, name: "effect-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{747, 801}}
+	var p735 = sequenceParser{id: 735, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p733 = charParser{id: 733, chars: []rune{102}}
+	var p734 = charParser{id: 734, chars: []rune{110}}
+	p735.items = []parser{&p733, &p734}
+	var p743 = sequenceParser{id: 743, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p742 = sequenceParser{id: 742, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p742.items = []parser{&p833, &p14}
+	p743.items = []parser{&p833, &p14, &p742}
+	var p737 = sequenceParser{id: 737, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p736 = charParser{id: 736, chars: []rune{126}}
+	p737.items = []parser{&p736}
+	var p745 = sequenceParser{id: 745, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p744 = sequenceParser{id: 744, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p744.items = []parser{&p833, &p14}
+	p745.items = []parser{&p833, &p14, &p744}
+	var p739 = sequenceParser{id: 739, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p738 = charParser{id: 738, chars: []rune{40}}
+	p739.items = []parser{&p738}
+	var p716 = sequenceParser{id: 716, commit: 66, name: "function-capture-list", ranges: [][]int{{1, 1}, {0, 1}}}
+	var p715 = sequenceParser{id: 715, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p713 = sequenceParser{id: 713, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	p713.items = []parser{&p114, &p833, &p700}
+	var p714 = sequenceParser{id: 714, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p714.items = []parser{&p833, &p713}
+	p715.items = []parser{&p833, &p713, &p714}
+	p716.items = []parser{&p700, &p715}
+	var p741 = sequenceParser{id: 741, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p740 = charParser{id: 740, chars: []rune{41}}
+	p741.items = []parser{&p740}
+	p746.items = []parser{&p735, &p743, &p833, &p737, &p745, &p833, &p739, &p833, &p114, &p833, &p716, &p833, &p114, &p833, &p741}
+	p747.options = []parser{&p668, &p685, &p696, &p712, &p732, &p746}
+	var p779 = choiceParser{id: 779, commit: 64, name: "use", generalizations: []int{801}}
+	var p771 = sequenceParser{id: 771, commit: 66, name: "use-statement", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{779, 801}}
+	var p752 = sequenceParser{id: 752, commit: 74, name: "use-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p751 = sequenceParser{id: 751, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p748 = charParser{id: 748, chars: []rune{117}}
+	var p749 = charParser{id: 749, chars: []rune{115}}
+	var p750 = charParser{id: 750, chars: []rune{101}}
+	p751.items = []parser{&p748, &p749, &p750}
+	p752.items = []parser{&p751, &p15}
 	var p770 = sequenceParser{id: 770, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var p769 = sequenceParser{id: 769, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p769.items = []parser{&p826, &p14}
-	p770.items = []parser{&p826, &p14, &p769}
-	var p766 = sequenceParser{id: 766, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p765 = charParser{id: 765, chars: []rune{40}}
-	p766.items = []parser{&p765}
-	var p761 = sequenceParser{id: 761, commit: 66, name: "use-fact-list", ranges: [][]int{{1, 1}, {0, 1}}}
-	var p760 = sequenceParser{id: 760, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p758 = sequenceParser{id: 758, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	p758.items = []parser{&p114, &p826, &p757}
-	var p759 = sequenceParser{id: 759, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p759.items = []parser{&p826, &p758}
-	p760.items = []parser{&p826, &p758, &p759}
-	p761.items = []parser{&p757, &p760}
-	var p768 = sequenceParser{id: 768, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p767 = charParser{id: 767, chars: []rune{41}}
-	p768.items = []parser{&p767}
-	p771.items = []parser{&p745, &p770, &p826, &p766, &p826, &p114, &p826, &p761, &p826, &p114, &p826, &p768}
-	p772.options = []parser{&p764, &p771}
-	var p783 = sequenceParser{id: 783, commit: 64, name: "export", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794}}
-	var p780 = sequenceParser{id: 780, commit: 74, name: "export-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p779 = sequenceParser{id: 779, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var p773 = charParser{id: 773, chars: []rune{101}}
-	var p774 = charParser{id: 774, chars: []rune{120}}
-	var p775 = charParser{id: 775, chars: []rune{112}}
-	var p776 = charParser{id: 776, chars: []rune{111}}
-	var p777 = charParser{id: 777, chars: []rune{114}}
-	var p778 = charParser{id: 778, chars: []rune{116}}
-	p779.items = []parser{&p773, &p774, &p775, &p776, &p777, &p778}
-	p780.items = []parser{&p779, &p15}
-	var p782 = sequenceParser{id: 782, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p781 = sequenceParser{id: 781, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p781.items = []parser{&p826, &p14}
-	p782.items = []parser{&p826, &p14, &p781}
-	p783.items = []parser{&p780, &p782, &p826, &p740}
-	var p803 = sequenceParser{id: 803, commit: 66, name: "statement-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{794}}
-	var p796 = sequenceParser{id: 796, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p795 = charParser{id: 795, chars: []rune{40}}
-	p796.items = []parser{&p795}
-	var p800 = sequenceParser{id: 800, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p799 = sequenceParser{id: 799, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p799.items = []parser{&p826, &p14}
-	p800.items = []parser{&p826, &p14, &p799}
-	var p802 = sequenceParser{id: 802, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p801 = sequenceParser{id: 801, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p801.items = []parser{&p826, &p14}
-	p802.items = []parser{&p826, &p14, &p801}
-	var p798 = sequenceParser{id: 798, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var p797 = charParser{id: 797, chars: []rune{41}}
-	p798.items = []parser{&p797}
-	p803.items = []parser{&p796, &p800, &p826, &p794, &p802, &p826, &p798}
-	p794.options = []parser{&p187, &p433, &p492, &p551, &p602, &p740, &p772, &p783, &p803, &p784}
-	var p811 = sequenceParser{id: 811, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var p809 = sequenceParser{id: 809, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	p809.items = []parser{&p808, &p826, &p794}
-	var p810 = sequenceParser{id: 810, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	p810.items = []parser{&p826, &p809}
-	p811.items = []parser{&p826, &p809, &p810}
-	p812.items = []parser{&p794, &p811}
-	p827.items = []parser{&p823, &p826, &p808, &p826, &p812, &p826, &p808}
-	p828.items = []parser{&p826, &p827, &p826}
-	var b828 = sequenceBuilder{id: 828, commit: 32, name: "mml", ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b826 = choiceBuilder{id: 826, commit: 2}
-	var b824 = choiceBuilder{id: 824, commit: 70}
+	p769.items = []parser{&p833, &p14}
+	p770.items = []parser{&p833, &p14, &p769}
+	var p764 = choiceParser{id: 764, commit: 64, name: "use-fact"}
+	var p763 = sequenceParser{id: 763, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{764}}
+	var p755 = choiceParser{id: 755, commit: 2}
+	var p754 = sequenceParser{id: 754, commit: 72, name: "use-inline", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}, generalizations: []int{755}}
+	var p753 = charParser{id: 753, chars: []rune{46}}
+	p754.items = []parser{&p753}
+	p755.options = []parser{&p104, &p754}
+	var p760 = sequenceParser{id: 760, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var p759 = sequenceParser{id: 759, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var p758 = sequenceParser{id: 758, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p758.items = []parser{&p833, &p14}
+	p759.items = []parser{&p14, &p758}
+	var p757 = sequenceParser{id: 757, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p756 = charParser{id: 756, chars: []rune{61}}
+	p757.items = []parser{&p756}
+	p760.items = []parser{&p759, &p833, &p757}
+	var p762 = sequenceParser{id: 762, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p761 = sequenceParser{id: 761, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p761.items = []parser{&p833, &p14}
+	p762.items = []parser{&p833, &p14, &p761}
+	p763.items = []parser{&p755, &p833, &p760, &p762, &p833, &p87}
+	p764.options = []parser{&p87, &p763}
+	p771.items = []parser{&p752, &p770, &p833, &p764}
+	var p778 = sequenceParser{id: 778, commit: 66, name: "use-statement-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{779, 801}}
+	var p777 = sequenceParser{id: 777, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p776 = sequenceParser{id: 776, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p776.items = []parser{&p833, &p14}
+	p777.items = []parser{&p833, &p14, &p776}
+	var p773 = sequenceParser{id: 773, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p772 = charParser{id: 772, chars: []rune{40}}
+	p773.items = []parser{&p772}
+	var p768 = sequenceParser{id: 768, commit: 66, name: "use-fact-list", ranges: [][]int{{1, 1}, {0, 1}}}
+	var p767 = sequenceParser{id: 767, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p765 = sequenceParser{id: 765, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	p765.items = []parser{&p114, &p833, &p764}
+	var p766 = sequenceParser{id: 766, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p766.items = []parser{&p833, &p765}
+	p767.items = []parser{&p833, &p765, &p766}
+	p768.items = []parser{&p764, &p767}
+	var p775 = sequenceParser{id: 775, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p774 = charParser{id: 774, chars: []rune{41}}
+	p775.items = []parser{&p774}
+	p778.items = []parser{&p752, &p777, &p833, &p773, &p833, &p114, &p833, &p768, &p833, &p114, &p833, &p775}
+	p779.options = []parser{&p771, &p778}
+	var p790 = sequenceParser{id: 790, commit: 64, name: "export", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{801}}
+	var p787 = sequenceParser{id: 787, commit: 74, name: "export-mod", ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p786 = sequenceParser{id: 786, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var p780 = charParser{id: 780, chars: []rune{101}}
+	var p781 = charParser{id: 781, chars: []rune{120}}
+	var p782 = charParser{id: 782, chars: []rune{112}}
+	var p783 = charParser{id: 783, chars: []rune{111}}
+	var p784 = charParser{id: 784, chars: []rune{114}}
+	var p785 = charParser{id: 785, chars: []rune{116}}
+	p786.items = []parser{&p780, &p781, &p782, &p783, &p784, &p785}
+	p787.items = []parser{&p786, &p15}
+	var p789 = sequenceParser{id: 789, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p788 = sequenceParser{id: 788, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p788.items = []parser{&p833, &p14}
+	p789.items = []parser{&p833, &p14, &p788}
+	p790.items = []parser{&p787, &p789, &p833, &p747}
+	var p810 = sequenceParser{id: 810, commit: 66, name: "statement-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}, generalizations: []int{801}}
+	var p803 = sequenceParser{id: 803, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p802 = charParser{id: 802, chars: []rune{40}}
+	p803.items = []parser{&p802}
+	var p807 = sequenceParser{id: 807, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p806 = sequenceParser{id: 806, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p806.items = []parser{&p833, &p14}
+	p807.items = []parser{&p833, &p14, &p806}
+	var p809 = sequenceParser{id: 809, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p808 = sequenceParser{id: 808, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p808.items = []parser{&p833, &p14}
+	p809.items = []parser{&p833, &p14, &p808}
+	var p805 = sequenceParser{id: 805, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var p804 = charParser{id: 804, chars: []rune{41}}
+	p805.items = []parser{&p804}
+	p810.items = []parser{&p803, &p807, &p833, &p801, &p809, &p833, &p805}
+	p801.options = []parser{&p187, &p433, &p492, &p551, &p609, &p747, &p779, &p790, &p810, &p791}
+	var p818 = sequenceParser{id: 818, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var p816 = sequenceParser{id: 816, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	p816.items = []parser{&p815, &p833, &p801}
+	var p817 = sequenceParser{id: 817, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	p817.items = []parser{&p833, &p816}
+	p818.items = []parser{&p833, &p816, &p817}
+	p819.items = []parser{&p801, &p818}
+	p834.items = []parser{&p830, &p833, &p815, &p833, &p819, &p833, &p815}
+	p835.items = []parser{&p833, &p834, &p833}
+	var b835 = sequenceBuilder{id: 835, commit: 32, name: "mml", ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b833 = choiceBuilder{id: 833, commit: 2}
+	var b831 = choiceBuilder{id: 831, commit: 70}
 	var b2 = sequenceBuilder{id: 2, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b1 = charBuilder{}
 	b2.items = []builder{&b1}
@@ -2185,8 +2198,8 @@ func Parse(r io.Reader) (*Node, error) {
 	var b12 = sequenceBuilder{id: 12, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b11 = charBuilder{}
 	b12.items = []builder{&b11}
-	b824.options = []builder{&b2, &b4, &b6, &b8, &b10, &b12}
-	var b825 = sequenceBuilder{id: 825, commit: 70, ranges: [][]int{{1, 1}, {1, 1}}}
+	b831.options = []builder{&b2, &b4, &b6, &b8, &b10, &b12}
+	var b832 = sequenceBuilder{id: 832, commit: 70, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b43 = sequenceBuilder{id: 43, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
 	var b39 = choiceBuilder{id: 39, commit: 66}
 	var b22 = sequenceBuilder{id: 22, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -2231,43 +2244,43 @@ func Parse(r io.Reader) (*Node, error) {
 	var b14 = sequenceBuilder{id: 14, commit: 74, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b13 = charBuilder{}
 	b14.items = []builder{&b13}
-	b40.items = []builder{&b14, &b826, &b39}
+	b40.items = []builder{&b14, &b833, &b39}
 	var b41 = sequenceBuilder{id: 41, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b41.items = []builder{&b826, &b40}
-	b42.items = []builder{&b826, &b40, &b41}
+	b41.items = []builder{&b833, &b40}
+	b42.items = []builder{&b833, &b40, &b41}
 	b43.items = []builder{&b39, &b42}
-	b825.items = []builder{&b43}
-	b826.options = []builder{&b824, &b825}
-	var b827 = sequenceBuilder{id: 827, commit: 66, ranges: [][]int{{0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}}}
-	var b823 = sequenceBuilder{id: 823, commit: 64, name: "shebang", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {1, 1}}}
-	var b820 = sequenceBuilder{id: 820, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b818 = charBuilder{}
-	var b819 = charBuilder{}
-	b820.items = []builder{&b818, &b819}
-	var b817 = sequenceBuilder{id: 817, commit: 64, name: "shebang-command", ranges: [][]int{{0, 1}}}
-	var b816 = sequenceBuilder{id: 816, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var b814 = sequenceBuilder{id: 814, commit: 2, allChars: true, ranges: [][]int{{1, 1}}}
-	var b813 = charBuilder{}
-	b814.items = []builder{&b813}
-	var b815 = sequenceBuilder{id: 815, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b815.items = []builder{&b826, &b814}
-	b816.items = []builder{&b814, &b815}
-	b817.items = []builder{&b816}
-	var b822 = sequenceBuilder{id: 822, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b821 = charBuilder{}
-	b822.items = []builder{&b821}
-	b823.items = []builder{&b820, &b826, &b817, &b826, &b822}
-	var b808 = sequenceBuilder{id: 808, commit: 66, ranges: [][]int{{1, 1}, {0, -1}}}
-	var b806 = choiceBuilder{id: 806, commit: 2}
-	var b805 = sequenceBuilder{id: 805, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b804 = charBuilder{}
-	b805.items = []builder{&b804}
-	b806.options = []builder{&b805, &b14}
-	var b807 = sequenceBuilder{id: 807, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b807.items = []builder{&b826, &b806}
-	b808.items = []builder{&b806, &b807}
-	var b812 = sequenceBuilder{id: 812, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
-	var b794 = choiceBuilder{id: 794, commit: 66}
+	b832.items = []builder{&b43}
+	b833.options = []builder{&b831, &b832}
+	var b834 = sequenceBuilder{id: 834, commit: 66, ranges: [][]int{{0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}}}
+	var b830 = sequenceBuilder{id: 830, commit: 64, name: "shebang", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {1, 1}}}
+	var b827 = sequenceBuilder{id: 827, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b825 = charBuilder{}
+	var b826 = charBuilder{}
+	b827.items = []builder{&b825, &b826}
+	var b824 = sequenceBuilder{id: 824, commit: 64, name: "shebang-command", ranges: [][]int{{0, 1}}}
+	var b823 = sequenceBuilder{id: 823, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b821 = sequenceBuilder{id: 821, commit: 2, allChars: true, ranges: [][]int{{1, 1}}}
+	var b820 = charBuilder{}
+	b821.items = []builder{&b820}
+	var b822 = sequenceBuilder{id: 822, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b822.items = []builder{&b833, &b821}
+	b823.items = []builder{&b821, &b822}
+	b824.items = []builder{&b823}
+	var b829 = sequenceBuilder{id: 829, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b828 = charBuilder{}
+	b829.items = []builder{&b828}
+	b830.items = []builder{&b827, &b833, &b824, &b833, &b829}
+	var b815 = sequenceBuilder{id: 815, commit: 66, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b813 = choiceBuilder{id: 813, commit: 2}
+	var b812 = sequenceBuilder{id: 812, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b811 = charBuilder{}
+	b812.items = []builder{&b811}
+	b813.options = []builder{&b812, &b14}
+	var b814 = sequenceBuilder{id: 814, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b814.items = []builder{&b833, &b813}
+	b815.items = []builder{&b813, &b814}
+	var b819 = sequenceBuilder{id: 819, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
+	var b801 = choiceBuilder{id: 801, commit: 66}
 	var b187 = sequenceBuilder{id: 187, commit: 64, name: "return", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}}}
 	var b183 = sequenceBuilder{id: 183, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b182 = sequenceBuilder{id: 182, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -2279,12 +2292,12 @@ func Parse(r io.Reader) (*Node, error) {
 	var b181 = charBuilder{}
 	b182.items = []builder{&b176, &b177, &b178, &b179, &b180, &b181}
 	var b15 = choiceBuilder{id: 15, commit: 66}
-	b15.options = []builder{&b824, &b14}
+	b15.options = []builder{&b831, &b14}
 	b183.items = []builder{&b182, &b15}
 	var b186 = sequenceBuilder{id: 186, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
 	var b185 = sequenceBuilder{id: 185, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b184 = sequenceBuilder{id: 184, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b184.items = []builder{&b826, &b14}
+	b184.items = []builder{&b833, &b14}
 	b185.items = []builder{&b14, &b184}
 	var b402 = choiceBuilder{id: 402, commit: 66}
 	var b273 = choiceBuilder{id: 273, commit: 66}
@@ -2389,9 +2402,9 @@ func Parse(r io.Reader) (*Node, error) {
 	b507.items = []builder{&b506, &b15}
 	var b514 = sequenceBuilder{id: 514, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b513 = sequenceBuilder{id: 513, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b513.items = []builder{&b826, &b14}
-	b514.items = []builder{&b826, &b14, &b513}
-	b515.items = []builder{&b507, &b514, &b826, &b273}
+	b513.items = []builder{&b833, &b14}
+	b514.items = []builder{&b833, &b14, &b513}
+	b515.items = []builder{&b507, &b514, &b833, &b273}
 	var b104 = sequenceBuilder{id: 104, commit: 72, name: "symbol", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}}
 	var b101 = sequenceBuilder{id: 101, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b100 = charBuilder{}
@@ -2412,7 +2425,7 @@ func Parse(r io.Reader) (*Node, error) {
 	b111.items = []builder{&b110}
 	b112.options = []builder{&b14, &b111}
 	var b113 = sequenceBuilder{id: 113, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b113.items = []builder{&b826, &b112}
+	b113.items = []builder{&b833, &b112}
 	b114.items = []builder{&b112, &b113}
 	var b119 = sequenceBuilder{id: 119, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
 	var b115 = choiceBuilder{id: 115, commit: 66}
@@ -2422,19 +2435,19 @@ func Parse(r io.Reader) (*Node, error) {
 	var b106 = charBuilder{}
 	var b107 = charBuilder{}
 	b108.items = []builder{&b105, &b106, &b107}
-	b109.items = []builder{&b273, &b826, &b108}
+	b109.items = []builder{&b273, &b833, &b108}
 	b115.options = []builder{&b402, &b109}
 	var b118 = sequenceBuilder{id: 118, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b116 = sequenceBuilder{id: 116, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	b116.items = []builder{&b114, &b826, &b115}
+	b116.items = []builder{&b114, &b833, &b115}
 	var b117 = sequenceBuilder{id: 117, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b117.items = []builder{&b826, &b116}
-	b118.items = []builder{&b826, &b116, &b117}
+	b117.items = []builder{&b833, &b116}
+	b118.items = []builder{&b833, &b116, &b117}
 	b119.items = []builder{&b115, &b118}
 	var b123 = sequenceBuilder{id: 123, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b122 = charBuilder{}
 	b123.items = []builder{&b122}
-	b124.items = []builder{&b121, &b826, &b114, &b826, &b119, &b826, &b114, &b826, &b123}
+	b124.items = []builder{&b121, &b833, &b114, &b833, &b119, &b833, &b114, &b833, &b123}
 	b125.items = []builder{&b124}
 	var b130 = sequenceBuilder{id: 130, commit: 64, name: "mutable-list", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b127 = sequenceBuilder{id: 127, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
@@ -2442,9 +2455,9 @@ func Parse(r io.Reader) (*Node, error) {
 	b127.items = []builder{&b126}
 	var b129 = sequenceBuilder{id: 129, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b128 = sequenceBuilder{id: 128, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b128.items = []builder{&b826, &b14}
-	b129.items = []builder{&b826, &b14, &b128}
-	b130.items = []builder{&b127, &b129, &b826, &b124}
+	b128.items = []builder{&b833, &b14}
+	b129.items = []builder{&b833, &b14, &b128}
+	b130.items = []builder{&b127, &b129, &b833, &b124}
 	var b159 = sequenceBuilder{id: 159, commit: 64, name: "struct", ranges: [][]int{{1, 1}}}
 	var b158 = sequenceBuilder{id: 158, commit: 66, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b155 = sequenceBuilder{id: 155, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
@@ -2460,43 +2473,43 @@ func Parse(r io.Reader) (*Node, error) {
 	b132.items = []builder{&b131}
 	var b136 = sequenceBuilder{id: 136, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b135 = sequenceBuilder{id: 135, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b135.items = []builder{&b826, &b14}
-	b136.items = []builder{&b826, &b14, &b135}
+	b135.items = []builder{&b833, &b14}
+	b136.items = []builder{&b833, &b14, &b135}
 	var b138 = sequenceBuilder{id: 138, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b137 = sequenceBuilder{id: 137, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b137.items = []builder{&b826, &b14}
-	b138.items = []builder{&b826, &b14, &b137}
+	b137.items = []builder{&b833, &b14}
+	b138.items = []builder{&b833, &b14, &b137}
 	var b134 = sequenceBuilder{id: 134, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b133 = charBuilder{}
 	b134.items = []builder{&b133}
-	b139.items = []builder{&b132, &b136, &b826, &b402, &b138, &b826, &b134}
+	b139.items = []builder{&b132, &b136, &b833, &b402, &b138, &b833, &b134}
 	b140.options = []builder{&b104, &b87, &b139}
 	var b144 = sequenceBuilder{id: 144, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b143 = sequenceBuilder{id: 143, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b143.items = []builder{&b826, &b14}
-	b144.items = []builder{&b826, &b14, &b143}
+	b143.items = []builder{&b833, &b14}
+	b144.items = []builder{&b833, &b14, &b143}
 	var b142 = sequenceBuilder{id: 142, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b141 = charBuilder{}
 	b142.items = []builder{&b141}
 	var b146 = sequenceBuilder{id: 146, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b145 = sequenceBuilder{id: 145, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b145.items = []builder{&b826, &b14}
-	b146.items = []builder{&b826, &b14, &b145}
-	b147.items = []builder{&b140, &b144, &b826, &b142, &b146, &b826, &b402}
+	b145.items = []builder{&b833, &b14}
+	b146.items = []builder{&b833, &b14, &b145}
+	b147.items = []builder{&b140, &b144, &b833, &b142, &b146, &b833, &b402}
 	b148.options = []builder{&b147, &b109}
 	var b152 = sequenceBuilder{id: 152, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b150 = sequenceBuilder{id: 150, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
 	var b149 = choiceBuilder{id: 149, commit: 2}
 	b149.options = []builder{&b147, &b109}
-	b150.items = []builder{&b114, &b826, &b149}
+	b150.items = []builder{&b114, &b833, &b149}
 	var b151 = sequenceBuilder{id: 151, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b151.items = []builder{&b826, &b150}
-	b152.items = []builder{&b826, &b150, &b151}
+	b151.items = []builder{&b833, &b150}
+	b152.items = []builder{&b833, &b150, &b151}
 	b153.items = []builder{&b148, &b152}
 	var b157 = sequenceBuilder{id: 157, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b156 = charBuilder{}
 	b157.items = []builder{&b156}
-	b158.items = []builder{&b155, &b826, &b114, &b826, &b153, &b826, &b114, &b826, &b157}
+	b158.items = []builder{&b155, &b833, &b114, &b833, &b153, &b833, &b114, &b833, &b157}
 	b159.items = []builder{&b158}
 	var b164 = sequenceBuilder{id: 164, commit: 64, name: "mutable-struct", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b161 = sequenceBuilder{id: 161, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
@@ -2504,9 +2517,9 @@ func Parse(r io.Reader) (*Node, error) {
 	b161.items = []builder{&b160}
 	var b163 = sequenceBuilder{id: 163, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b162 = sequenceBuilder{id: 162, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b162.items = []builder{&b826, &b14}
-	b163.items = []builder{&b826, &b14, &b162}
-	b164.items = []builder{&b161, &b163, &b826, &b158}
+	b162.items = []builder{&b833, &b14}
+	b163.items = []builder{&b833, &b14, &b162}
+	b164.items = []builder{&b161, &b163, &b833, &b158}
 	var b208 = sequenceBuilder{id: 208, commit: 64, name: "function", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b205 = sequenceBuilder{id: 205, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b203 = charBuilder{}
@@ -2514,8 +2527,8 @@ func Parse(r io.Reader) (*Node, error) {
 	b205.items = []builder{&b203, &b204}
 	var b207 = sequenceBuilder{id: 207, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b206 = sequenceBuilder{id: 206, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b206.items = []builder{&b826, &b14}
-	b207.items = []builder{&b826, &b14, &b206}
+	b206.items = []builder{&b833, &b14}
+	b207.items = []builder{&b833, &b14, &b206}
 	var b202 = sequenceBuilder{id: 202, commit: 66, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b194 = sequenceBuilder{id: 194, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b193 = charBuilder{}
@@ -2524,10 +2537,10 @@ func Parse(r io.Reader) (*Node, error) {
 	var b168 = sequenceBuilder{id: 168, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
 	var b167 = sequenceBuilder{id: 167, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b165 = sequenceBuilder{id: 165, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	b165.items = []builder{&b114, &b826, &b104}
+	b165.items = []builder{&b114, &b833, &b104}
 	var b166 = sequenceBuilder{id: 166, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b166.items = []builder{&b826, &b165}
-	b167.items = []builder{&b826, &b165, &b166}
+	b166.items = []builder{&b833, &b165}
+	b167.items = []builder{&b833, &b165, &b166}
 	b168.items = []builder{&b104, &b167}
 	var b195 = sequenceBuilder{id: 195, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {1, 1}}}
 	var b175 = sequenceBuilder{id: 175, commit: 64, name: "collect-parameter", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
@@ -2538,20 +2551,20 @@ func Parse(r io.Reader) (*Node, error) {
 	b172.items = []builder{&b169, &b170, &b171}
 	var b174 = sequenceBuilder{id: 174, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b173 = sequenceBuilder{id: 173, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b173.items = []builder{&b826, &b14}
-	b174.items = []builder{&b826, &b14, &b173}
-	b175.items = []builder{&b172, &b174, &b826, &b104}
-	b195.items = []builder{&b168, &b826, &b114, &b826, &b175}
+	b173.items = []builder{&b833, &b14}
+	b174.items = []builder{&b833, &b14, &b173}
+	b175.items = []builder{&b172, &b174, &b833, &b104}
+	b195.items = []builder{&b168, &b833, &b114, &b833, &b175}
 	b196.options = []builder{&b168, &b195, &b175}
 	var b198 = sequenceBuilder{id: 198, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b197 = charBuilder{}
 	b198.items = []builder{&b197}
 	var b201 = sequenceBuilder{id: 201, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b200 = sequenceBuilder{id: 200, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b200.items = []builder{&b826, &b14}
-	b201.items = []builder{&b826, &b14, &b200}
+	b200.items = []builder{&b833, &b14}
+	b201.items = []builder{&b833, &b14, &b200}
 	var b199 = choiceBuilder{id: 199, commit: 2}
-	var b784 = choiceBuilder{id: 784, commit: 66}
+	var b791 = choiceBuilder{id: 791, commit: 66}
 	var b512 = sequenceBuilder{id: 512, commit: 64, name: "send", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b498 = sequenceBuilder{id: 498, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b497 = sequenceBuilder{id: 497, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -2563,13 +2576,13 @@ func Parse(r io.Reader) (*Node, error) {
 	b498.items = []builder{&b497, &b15}
 	var b509 = sequenceBuilder{id: 509, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b508 = sequenceBuilder{id: 508, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b508.items = []builder{&b826, &b14}
-	b509.items = []builder{&b826, &b14, &b508}
+	b508.items = []builder{&b833, &b14}
+	b509.items = []builder{&b833, &b14, &b508}
 	var b511 = sequenceBuilder{id: 511, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b510 = sequenceBuilder{id: 510, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b510.items = []builder{&b826, &b14}
-	b511.items = []builder{&b826, &b14, &b510}
-	b512.items = []builder{&b498, &b509, &b826, &b273, &b511, &b826, &b273}
+	b510.items = []builder{&b833, &b14}
+	b511.items = []builder{&b833, &b14, &b510}
+	b512.items = []builder{&b498, &b509, &b833, &b273, &b511, &b833, &b273}
 	var b565 = sequenceBuilder{id: 565, commit: 64, name: "go", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b555 = sequenceBuilder{id: 555, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b554 = sequenceBuilder{id: 554, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -2579,8 +2592,8 @@ func Parse(r io.Reader) (*Node, error) {
 	b555.items = []builder{&b554, &b15}
 	var b564 = sequenceBuilder{id: 564, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b563 = sequenceBuilder{id: 563, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b563.items = []builder{&b826, &b14}
-	b564.items = []builder{&b826, &b14, &b563}
+	b563.items = []builder{&b833, &b14}
+	b564.items = []builder{&b833, &b14, &b563}
 	var b263 = sequenceBuilder{id: 263, commit: 64, name: "function-application", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b260 = sequenceBuilder{id: 260, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b259 = charBuilder{}
@@ -2588,8 +2601,8 @@ func Parse(r io.Reader) (*Node, error) {
 	var b262 = sequenceBuilder{id: 262, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b261 = charBuilder{}
 	b262.items = []builder{&b261}
-	b263.items = []builder{&b273, &b826, &b260, &b826, &b114, &b826, &b119, &b826, &b114, &b826, &b262}
-	b565.items = []builder{&b555, &b564, &b826, &b263}
+	b263.items = []builder{&b273, &b833, &b260, &b833, &b114, &b833, &b119, &b833, &b114, &b833, &b262}
+	b565.items = []builder{&b555, &b564, &b833, &b263}
 	var b574 = sequenceBuilder{id: 574, commit: 64, name: "defer", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b571 = sequenceBuilder{id: 571, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b566 = charBuilder{}
@@ -2600,91 +2613,91 @@ func Parse(r io.Reader) (*Node, error) {
 	b571.items = []builder{&b566, &b567, &b568, &b569, &b570}
 	var b573 = sequenceBuilder{id: 573, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b572 = sequenceBuilder{id: 572, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b572.items = []builder{&b826, &b14}
-	b573.items = []builder{&b826, &b14, &b572}
-	b574.items = []builder{&b571, &b573, &b826, &b263}
-	var b638 = choiceBuilder{id: 638, commit: 64, name: "assignment"}
-	var b622 = sequenceBuilder{id: 622, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b607 = sequenceBuilder{id: 607, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b606 = sequenceBuilder{id: 606, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b603 = charBuilder{}
-	var b604 = charBuilder{}
-	var b605 = charBuilder{}
-	b606.items = []builder{&b603, &b604, &b605}
-	b607.items = []builder{&b606, &b15}
-	var b621 = sequenceBuilder{id: 621, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b620 = sequenceBuilder{id: 620, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b620.items = []builder{&b826, &b14}
-	b621.items = []builder{&b826, &b14, &b620}
-	var b615 = sequenceBuilder{id: 615, commit: 66, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b612 = sequenceBuilder{id: 612, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var b611 = sequenceBuilder{id: 611, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var b610 = sequenceBuilder{id: 610, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b610.items = []builder{&b826, &b14}
-	b611.items = []builder{&b14, &b610}
-	var b609 = sequenceBuilder{id: 609, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b608 = charBuilder{}
-	b609.items = []builder{&b608}
-	b612.items = []builder{&b611, &b826, &b609}
-	var b614 = sequenceBuilder{id: 614, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b613 = sequenceBuilder{id: 613, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b613.items = []builder{&b826, &b14}
-	b614.items = []builder{&b826, &b14, &b613}
-	b615.items = []builder{&b273, &b826, &b612, &b614, &b826, &b402}
-	b622.items = []builder{&b607, &b621, &b826, &b615}
-	var b629 = sequenceBuilder{id: 629, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b626 = sequenceBuilder{id: 626, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b625 = sequenceBuilder{id: 625, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b625.items = []builder{&b826, &b14}
-	b626.items = []builder{&b826, &b14, &b625}
-	var b624 = sequenceBuilder{id: 624, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b623 = charBuilder{}
-	b624.items = []builder{&b623}
+	b572.items = []builder{&b833, &b14}
+	b573.items = []builder{&b833, &b14, &b572}
+	b574.items = []builder{&b571, &b573, &b833, &b263}
+	var b645 = choiceBuilder{id: 645, commit: 64, name: "assignment"}
+	var b629 = sequenceBuilder{id: 629, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b614 = sequenceBuilder{id: 614, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b613 = sequenceBuilder{id: 613, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b610 = charBuilder{}
+	var b611 = charBuilder{}
+	var b612 = charBuilder{}
+	b613.items = []builder{&b610, &b611, &b612}
+	b614.items = []builder{&b613, &b15}
 	var b628 = sequenceBuilder{id: 628, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b627 = sequenceBuilder{id: 627, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b627.items = []builder{&b826, &b14}
-	b628.items = []builder{&b826, &b14, &b627}
-	b629.items = []builder{&b273, &b626, &b826, &b624, &b628, &b826, &b402}
-	var b637 = sequenceBuilder{id: 637, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b636 = sequenceBuilder{id: 636, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b635 = sequenceBuilder{id: 635, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b635.items = []builder{&b826, &b14}
-	b636.items = []builder{&b826, &b14, &b635}
+	b627.items = []builder{&b833, &b14}
+	b628.items = []builder{&b833, &b14, &b627}
+	var b622 = sequenceBuilder{id: 622, commit: 66, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b619 = sequenceBuilder{id: 619, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var b618 = sequenceBuilder{id: 618, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b617 = sequenceBuilder{id: 617, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b617.items = []builder{&b833, &b14}
+	b618.items = []builder{&b14, &b617}
+	var b616 = sequenceBuilder{id: 616, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b615 = charBuilder{}
+	b616.items = []builder{&b615}
+	b619.items = []builder{&b618, &b833, &b616}
+	var b621 = sequenceBuilder{id: 621, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b620 = sequenceBuilder{id: 620, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b620.items = []builder{&b833, &b14}
+	b621.items = []builder{&b833, &b14, &b620}
+	b622.items = []builder{&b273, &b833, &b619, &b621, &b833, &b402}
+	b629.items = []builder{&b614, &b628, &b833, &b622}
+	var b636 = sequenceBuilder{id: 636, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b633 = sequenceBuilder{id: 633, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b632 = sequenceBuilder{id: 632, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b632.items = []builder{&b833, &b14}
+	b633.items = []builder{&b833, &b14, &b632}
 	var b631 = sequenceBuilder{id: 631, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b630 = charBuilder{}
 	b631.items = []builder{&b630}
-	var b632 = sequenceBuilder{id: 632, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	var b619 = sequenceBuilder{id: 619, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
-	var b618 = sequenceBuilder{id: 618, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b616 = sequenceBuilder{id: 616, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	b616.items = []builder{&b114, &b826, &b615}
-	var b617 = sequenceBuilder{id: 617, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b617.items = []builder{&b826, &b616}
-	b618.items = []builder{&b826, &b616, &b617}
-	b619.items = []builder{&b615, &b618}
-	b632.items = []builder{&b114, &b826, &b619}
-	var b634 = sequenceBuilder{id: 634, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b633 = charBuilder{}
-	b634.items = []builder{&b633}
-	b637.items = []builder{&b607, &b636, &b826, &b631, &b826, &b632, &b826, &b114, &b826, &b634}
-	b638.options = []builder{&b622, &b629, &b637}
-	var b793 = sequenceBuilder{id: 793, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b786 = sequenceBuilder{id: 786, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b785 = charBuilder{}
-	b786.items = []builder{&b785}
-	var b790 = sequenceBuilder{id: 790, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b789 = sequenceBuilder{id: 789, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b789.items = []builder{&b826, &b14}
-	b790.items = []builder{&b826, &b14, &b789}
-	var b792 = sequenceBuilder{id: 792, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b791 = sequenceBuilder{id: 791, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b791.items = []builder{&b826, &b14}
-	b792.items = []builder{&b826, &b14, &b791}
-	var b788 = sequenceBuilder{id: 788, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b787 = charBuilder{}
-	b788.items = []builder{&b787}
-	b793.items = []builder{&b786, &b790, &b826, &b784, &b792, &b826, &b788}
-	b784.options = []builder{&b512, &b565, &b574, &b638, &b793, &b402}
+	var b635 = sequenceBuilder{id: 635, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b634 = sequenceBuilder{id: 634, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b634.items = []builder{&b833, &b14}
+	b635.items = []builder{&b833, &b14, &b634}
+	b636.items = []builder{&b273, &b633, &b833, &b631, &b635, &b833, &b402}
+	var b644 = sequenceBuilder{id: 644, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b643 = sequenceBuilder{id: 643, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b642 = sequenceBuilder{id: 642, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b642.items = []builder{&b833, &b14}
+	b643.items = []builder{&b833, &b14, &b642}
+	var b638 = sequenceBuilder{id: 638, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b637 = charBuilder{}
+	b638.items = []builder{&b637}
+	var b639 = sequenceBuilder{id: 639, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	var b626 = sequenceBuilder{id: 626, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
+	var b625 = sequenceBuilder{id: 625, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b623 = sequenceBuilder{id: 623, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	b623.items = []builder{&b114, &b833, &b622}
+	var b624 = sequenceBuilder{id: 624, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b624.items = []builder{&b833, &b623}
+	b625.items = []builder{&b833, &b623, &b624}
+	b626.items = []builder{&b622, &b625}
+	b639.items = []builder{&b114, &b833, &b626}
+	var b641 = sequenceBuilder{id: 641, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b640 = charBuilder{}
+	b641.items = []builder{&b640}
+	b644.items = []builder{&b614, &b643, &b833, &b638, &b833, &b639, &b833, &b114, &b833, &b641}
+	b645.options = []builder{&b629, &b636, &b644}
+	var b800 = sequenceBuilder{id: 800, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b793 = sequenceBuilder{id: 793, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b792 = charBuilder{}
+	b793.items = []builder{&b792}
+	var b797 = sequenceBuilder{id: 797, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b796 = sequenceBuilder{id: 796, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b796.items = []builder{&b833, &b14}
+	b797.items = []builder{&b833, &b14, &b796}
+	var b799 = sequenceBuilder{id: 799, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b798 = sequenceBuilder{id: 798, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b798.items = []builder{&b833, &b14}
+	b799.items = []builder{&b833, &b14, &b798}
+	var b795 = sequenceBuilder{id: 795, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b794 = charBuilder{}
+	b795.items = []builder{&b794}
+	b800.items = []builder{&b793, &b797, &b833, &b791, &b799, &b833, &b795}
+	b791.options = []builder{&b512, &b565, &b574, &b645, &b800, &b402}
 	var b192 = sequenceBuilder{id: 192, commit: 64, name: "block", ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b189 = sequenceBuilder{id: 189, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b188 = charBuilder{}
@@ -2692,10 +2705,10 @@ func Parse(r io.Reader) (*Node, error) {
 	var b191 = sequenceBuilder{id: 191, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b190 = charBuilder{}
 	b191.items = []builder{&b190}
-	b192.items = []builder{&b189, &b826, &b808, &b826, &b812, &b826, &b808, &b826, &b191}
-	b199.options = []builder{&b784, &b192}
-	b202.items = []builder{&b194, &b826, &b114, &b826, &b196, &b826, &b114, &b826, &b198, &b201, &b826, &b199}
-	b208.items = []builder{&b205, &b207, &b826, &b202}
+	b192.items = []builder{&b189, &b833, &b815, &b833, &b819, &b833, &b815, &b833, &b191}
+	b199.options = []builder{&b791, &b192}
+	b202.items = []builder{&b194, &b833, &b114, &b833, &b196, &b833, &b114, &b833, &b198, &b201, &b833, &b199}
+	b208.items = []builder{&b205, &b207, &b833, &b202}
 	var b218 = sequenceBuilder{id: 218, commit: 64, name: "effect", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b211 = sequenceBuilder{id: 211, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b209 = charBuilder{}
@@ -2703,21 +2716,21 @@ func Parse(r io.Reader) (*Node, error) {
 	b211.items = []builder{&b209, &b210}
 	var b215 = sequenceBuilder{id: 215, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b214 = sequenceBuilder{id: 214, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b214.items = []builder{&b826, &b14}
-	b215.items = []builder{&b826, &b14, &b214}
+	b214.items = []builder{&b833, &b14}
+	b215.items = []builder{&b833, &b14, &b214}
 	var b213 = sequenceBuilder{id: 213, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b212 = charBuilder{}
 	b213.items = []builder{&b212}
 	var b217 = sequenceBuilder{id: 217, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b216 = sequenceBuilder{id: 216, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b216.items = []builder{&b826, &b14}
-	b217.items = []builder{&b826, &b14, &b216}
-	b218.items = []builder{&b211, &b215, &b826, &b213, &b217, &b826, &b202}
+	b216.items = []builder{&b833, &b14}
+	b217.items = []builder{&b833, &b14, &b216}
+	b218.items = []builder{&b211, &b215, &b833, &b213, &b217, &b833, &b202}
 	var b258 = sequenceBuilder{id: 258, commit: 64, name: "indexer", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b257 = sequenceBuilder{id: 257, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b256 = sequenceBuilder{id: 256, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b256.items = []builder{&b826, &b14}
-	b257.items = []builder{&b826, &b14, &b256}
+	b256.items = []builder{&b833, &b14}
+	b257.items = []builder{&b833, &b14, &b256}
 	var b255 = sequenceBuilder{id: 255, commit: 66, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}}}
 	var b251 = choiceBuilder{id: 251, commit: 66}
 	var b232 = sequenceBuilder{id: 232, commit: 64, name: "symbol-index", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
@@ -2726,83 +2739,83 @@ func Parse(r io.Reader) (*Node, error) {
 	b229.items = []builder{&b228}
 	var b231 = sequenceBuilder{id: 231, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b230 = sequenceBuilder{id: 230, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b230.items = []builder{&b826, &b14}
-	b231.items = []builder{&b826, &b14, &b230}
-	b232.items = []builder{&b229, &b231, &b826, &b104}
+	b230.items = []builder{&b833, &b14}
+	b231.items = []builder{&b833, &b14, &b230}
+	b232.items = []builder{&b229, &b231, &b833, &b104}
 	var b241 = sequenceBuilder{id: 241, commit: 64, name: "expression-index", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b234 = sequenceBuilder{id: 234, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b233 = charBuilder{}
 	b234.items = []builder{&b233}
 	var b238 = sequenceBuilder{id: 238, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b237 = sequenceBuilder{id: 237, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b237.items = []builder{&b826, &b14}
-	b238.items = []builder{&b826, &b14, &b237}
+	b237.items = []builder{&b833, &b14}
+	b238.items = []builder{&b833, &b14, &b237}
 	var b240 = sequenceBuilder{id: 240, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b239 = sequenceBuilder{id: 239, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b239.items = []builder{&b826, &b14}
-	b240.items = []builder{&b826, &b14, &b239}
+	b239.items = []builder{&b833, &b14}
+	b240.items = []builder{&b833, &b14, &b239}
 	var b236 = sequenceBuilder{id: 236, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b235 = charBuilder{}
 	b236.items = []builder{&b235}
-	b241.items = []builder{&b234, &b238, &b826, &b402, &b240, &b826, &b236}
+	b241.items = []builder{&b234, &b238, &b833, &b402, &b240, &b833, &b236}
 	var b250 = sequenceBuilder{id: 250, commit: 64, name: "range-index", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b243 = sequenceBuilder{id: 243, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b242 = charBuilder{}
 	b243.items = []builder{&b242}
 	var b247 = sequenceBuilder{id: 247, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b246 = sequenceBuilder{id: 246, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b246.items = []builder{&b826, &b14}
-	b247.items = []builder{&b826, &b14, &b246}
+	b246.items = []builder{&b833, &b14}
+	b247.items = []builder{&b833, &b14, &b246}
 	var b227 = sequenceBuilder{id: 227, commit: 66, ranges: [][]int{{0, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {0, 1}}}
 	var b219 = sequenceBuilder{id: 219, commit: 64, name: "range-from", ranges: [][]int{{1, 1}}}
 	b219.items = []builder{&b402}
 	var b224 = sequenceBuilder{id: 224, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b223 = sequenceBuilder{id: 223, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b223.items = []builder{&b826, &b14}
-	b224.items = []builder{&b826, &b14, &b223}
+	b223.items = []builder{&b833, &b14}
+	b224.items = []builder{&b833, &b14, &b223}
 	var b222 = sequenceBuilder{id: 222, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b221 = charBuilder{}
 	b222.items = []builder{&b221}
 	var b226 = sequenceBuilder{id: 226, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b225 = sequenceBuilder{id: 225, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b225.items = []builder{&b826, &b14}
-	b226.items = []builder{&b826, &b14, &b225}
+	b225.items = []builder{&b833, &b14}
+	b226.items = []builder{&b833, &b14, &b225}
 	var b220 = sequenceBuilder{id: 220, commit: 64, name: "range-to", ranges: [][]int{{1, 1}}}
 	b220.items = []builder{&b402}
-	b227.items = []builder{&b219, &b224, &b826, &b222, &b226, &b826, &b220}
+	b227.items = []builder{&b219, &b224, &b833, &b222, &b226, &b833, &b220}
 	var b249 = sequenceBuilder{id: 249, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b248 = sequenceBuilder{id: 248, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b248.items = []builder{&b826, &b14}
-	b249.items = []builder{&b826, &b14, &b248}
+	b248.items = []builder{&b833, &b14}
+	b249.items = []builder{&b833, &b14, &b248}
 	var b245 = sequenceBuilder{id: 245, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b244 = charBuilder{}
 	b245.items = []builder{&b244}
-	b250.items = []builder{&b243, &b247, &b826, &b227, &b249, &b826, &b245}
+	b250.items = []builder{&b243, &b247, &b833, &b227, &b249, &b833, &b245}
 	b251.options = []builder{&b232, &b241, &b250}
 	var b254 = sequenceBuilder{id: 254, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
 	var b253 = sequenceBuilder{id: 253, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b252 = sequenceBuilder{id: 252, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b252.items = []builder{&b826, &b14}
+	b252.items = []builder{&b833, &b14}
 	b253.items = []builder{&b14, &b252}
-	b254.items = []builder{&b253, &b826, &b251}
-	b255.items = []builder{&b251, &b826, &b254}
-	b258.items = []builder{&b273, &b257, &b826, &b255}
+	b254.items = []builder{&b253, &b833, &b251}
+	b255.items = []builder{&b251, &b833, &b254}
+	b258.items = []builder{&b273, &b257, &b833, &b255}
 	var b272 = sequenceBuilder{id: 272, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b265 = sequenceBuilder{id: 265, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b264 = charBuilder{}
 	b265.items = []builder{&b264}
 	var b269 = sequenceBuilder{id: 269, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b268 = sequenceBuilder{id: 268, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b268.items = []builder{&b826, &b14}
-	b269.items = []builder{&b826, &b14, &b268}
+	b268.items = []builder{&b833, &b14}
+	b269.items = []builder{&b833, &b14, &b268}
 	var b271 = sequenceBuilder{id: 271, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b270 = sequenceBuilder{id: 270, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b270.items = []builder{&b826, &b14}
-	b271.items = []builder{&b826, &b14, &b270}
+	b270.items = []builder{&b833, &b14}
+	b271.items = []builder{&b833, &b14, &b270}
 	var b267 = sequenceBuilder{id: 267, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b266 = charBuilder{}
 	b267.items = []builder{&b266}
-	b272.items = []builder{&b265, &b269, &b826, &b402, &b271, &b826, &b267}
+	b272.items = []builder{&b265, &b269, &b833, &b402, &b271, &b833, &b267}
 	b273.options = []builder{&b61, &b74, &b87, &b99, &b515, &b104, &b125, &b130, &b159, &b164, &b208, &b218, &b258, &b263, &b272}
 	var b333 = sequenceBuilder{id: 333, commit: 64, name: "unary-expression", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
 	var b332 = choiceBuilder{id: 332, commit: 66}
@@ -2819,7 +2832,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var b305 = charBuilder{}
 	b306.items = []builder{&b305}
 	b332.options = []builder{&b292, &b294, &b275, &b306}
-	b333.items = []builder{&b332, &b826, &b273}
+	b333.items = []builder{&b332, &b833, &b273}
 	var b380 = choiceBuilder{id: 380, commit: 66}
 	var b351 = sequenceBuilder{id: 351, commit: 64, name: "binary0", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}}
 	var b339 = choiceBuilder{id: 339, commit: 66}
@@ -2827,7 +2840,7 @@ func Parse(r io.Reader) (*Node, error) {
 	var b349 = sequenceBuilder{id: 349, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b346 = sequenceBuilder{id: 346, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b345 = sequenceBuilder{id: 345, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b345.items = []builder{&b826, &b14}
+	b345.items = []builder{&b833, &b14}
 	b346.items = []builder{&b14, &b345}
 	var b334 = choiceBuilder{id: 334, commit: 66}
 	var b277 = sequenceBuilder{id: 277, commit: 72, name: "binary-and", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
@@ -2857,19 +2870,19 @@ func Parse(r io.Reader) (*Node, error) {
 	b334.options = []builder{&b277, &b284, &b287, &b290, &b296, &b298, &b300}
 	var b348 = sequenceBuilder{id: 348, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b347 = sequenceBuilder{id: 347, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b347.items = []builder{&b826, &b14}
-	b348.items = []builder{&b826, &b14, &b347}
-	b349.items = []builder{&b346, &b826, &b334, &b348, &b826, &b339}
+	b347.items = []builder{&b833, &b14}
+	b348.items = []builder{&b833, &b14, &b347}
+	b349.items = []builder{&b346, &b833, &b334, &b348, &b833, &b339}
 	var b350 = sequenceBuilder{id: 350, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b350.items = []builder{&b826, &b349}
-	b351.items = []builder{&b339, &b826, &b349, &b350}
+	b350.items = []builder{&b833, &b349}
+	b351.items = []builder{&b339, &b833, &b349, &b350}
 	var b358 = sequenceBuilder{id: 358, commit: 64, name: "binary1", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}}
 	var b340 = choiceBuilder{id: 340, commit: 66}
 	b340.options = []builder{&b339, &b351}
 	var b356 = sequenceBuilder{id: 356, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b353 = sequenceBuilder{id: 353, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b352 = sequenceBuilder{id: 352, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b352.items = []builder{&b826, &b14}
+	b352.items = []builder{&b833, &b14}
 	b353.items = []builder{&b14, &b352}
 	var b335 = choiceBuilder{id: 335, commit: 66}
 	var b279 = sequenceBuilder{id: 279, commit: 72, name: "binary-or", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
@@ -2887,19 +2900,19 @@ func Parse(r io.Reader) (*Node, error) {
 	b335.options = []builder{&b279, &b281, &b302, &b304}
 	var b355 = sequenceBuilder{id: 355, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b354 = sequenceBuilder{id: 354, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b354.items = []builder{&b826, &b14}
-	b355.items = []builder{&b826, &b14, &b354}
-	b356.items = []builder{&b353, &b826, &b335, &b355, &b826, &b340}
+	b354.items = []builder{&b833, &b14}
+	b355.items = []builder{&b833, &b14, &b354}
+	b356.items = []builder{&b353, &b833, &b335, &b355, &b833, &b340}
 	var b357 = sequenceBuilder{id: 357, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b357.items = []builder{&b826, &b356}
-	b358.items = []builder{&b340, &b826, &b356, &b357}
+	b357.items = []builder{&b833, &b356}
+	b358.items = []builder{&b340, &b833, &b356, &b357}
 	var b365 = sequenceBuilder{id: 365, commit: 64, name: "binary2", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}}
 	var b341 = choiceBuilder{id: 341, commit: 66}
 	b341.options = []builder{&b340, &b358}
 	var b363 = sequenceBuilder{id: 363, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b360 = sequenceBuilder{id: 360, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b359 = sequenceBuilder{id: 359, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b359.items = []builder{&b826, &b14}
+	b359.items = []builder{&b833, &b14}
 	b360.items = []builder{&b14, &b359}
 	var b336 = choiceBuilder{id: 336, commit: 66}
 	var b309 = sequenceBuilder{id: 309, commit: 72, name: "eq", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -2927,19 +2940,19 @@ func Parse(r io.Reader) (*Node, error) {
 	b336.options = []builder{&b309, &b312, &b314, &b317, &b319, &b322}
 	var b362 = sequenceBuilder{id: 362, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b361 = sequenceBuilder{id: 361, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b361.items = []builder{&b826, &b14}
-	b362.items = []builder{&b826, &b14, &b361}
-	b363.items = []builder{&b360, &b826, &b336, &b362, &b826, &b341}
+	b361.items = []builder{&b833, &b14}
+	b362.items = []builder{&b833, &b14, &b361}
+	b363.items = []builder{&b360, &b833, &b336, &b362, &b833, &b341}
 	var b364 = sequenceBuilder{id: 364, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b364.items = []builder{&b826, &b363}
-	b365.items = []builder{&b341, &b826, &b363, &b364}
+	b364.items = []builder{&b833, &b363}
+	b365.items = []builder{&b341, &b833, &b363, &b364}
 	var b372 = sequenceBuilder{id: 372, commit: 64, name: "binary3", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}}
 	var b342 = choiceBuilder{id: 342, commit: 66}
 	b342.options = []builder{&b341, &b365}
 	var b370 = sequenceBuilder{id: 370, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b367 = sequenceBuilder{id: 367, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b366 = sequenceBuilder{id: 366, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b366.items = []builder{&b826, &b14}
+	b366.items = []builder{&b833, &b14}
 	b367.items = []builder{&b14, &b366}
 	var b337 = sequenceBuilder{id: 337, commit: 66, ranges: [][]int{{1, 1}}}
 	var b325 = sequenceBuilder{id: 325, commit: 72, name: "logical-and", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -2949,19 +2962,19 @@ func Parse(r io.Reader) (*Node, error) {
 	b337.items = []builder{&b325}
 	var b369 = sequenceBuilder{id: 369, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b368 = sequenceBuilder{id: 368, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b368.items = []builder{&b826, &b14}
-	b369.items = []builder{&b826, &b14, &b368}
-	b370.items = []builder{&b367, &b826, &b337, &b369, &b826, &b342}
+	b368.items = []builder{&b833, &b14}
+	b369.items = []builder{&b833, &b14, &b368}
+	b370.items = []builder{&b367, &b833, &b337, &b369, &b833, &b342}
 	var b371 = sequenceBuilder{id: 371, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b371.items = []builder{&b826, &b370}
-	b372.items = []builder{&b342, &b826, &b370, &b371}
+	b371.items = []builder{&b833, &b370}
+	b372.items = []builder{&b342, &b833, &b370, &b371}
 	var b379 = sequenceBuilder{id: 379, commit: 64, name: "binary4", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}}
 	var b343 = choiceBuilder{id: 343, commit: 66}
 	b343.options = []builder{&b342, &b372}
 	var b377 = sequenceBuilder{id: 377, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b374 = sequenceBuilder{id: 374, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b373 = sequenceBuilder{id: 373, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b373.items = []builder{&b826, &b14}
+	b373.items = []builder{&b833, &b14}
 	b374.items = []builder{&b14, &b373}
 	var b338 = sequenceBuilder{id: 338, commit: 66, ranges: [][]int{{1, 1}}}
 	var b328 = sequenceBuilder{id: 328, commit: 72, name: "logical-or", allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -2971,44 +2984,44 @@ func Parse(r io.Reader) (*Node, error) {
 	b338.items = []builder{&b328}
 	var b376 = sequenceBuilder{id: 376, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b375 = sequenceBuilder{id: 375, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b375.items = []builder{&b826, &b14}
-	b376.items = []builder{&b826, &b14, &b375}
-	b377.items = []builder{&b374, &b826, &b338, &b376, &b826, &b343}
+	b375.items = []builder{&b833, &b14}
+	b376.items = []builder{&b833, &b14, &b375}
+	b377.items = []builder{&b374, &b833, &b338, &b376, &b833, &b343}
 	var b378 = sequenceBuilder{id: 378, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b378.items = []builder{&b826, &b377}
-	b379.items = []builder{&b343, &b826, &b377, &b378}
+	b378.items = []builder{&b833, &b377}
+	b379.items = []builder{&b343, &b833, &b377, &b378}
 	b380.options = []builder{&b351, &b358, &b365, &b372, &b379}
 	var b393 = sequenceBuilder{id: 393, commit: 64, name: "ternary-expression", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b386 = sequenceBuilder{id: 386, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b385 = sequenceBuilder{id: 385, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b385.items = []builder{&b826, &b14}
-	b386.items = []builder{&b826, &b14, &b385}
+	b385.items = []builder{&b833, &b14}
+	b386.items = []builder{&b833, &b14, &b385}
 	var b382 = sequenceBuilder{id: 382, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b381 = charBuilder{}
 	b382.items = []builder{&b381}
 	var b388 = sequenceBuilder{id: 388, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b387 = sequenceBuilder{id: 387, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b387.items = []builder{&b826, &b14}
-	b388.items = []builder{&b826, &b14, &b387}
+	b387.items = []builder{&b833, &b14}
+	b388.items = []builder{&b833, &b14, &b387}
 	var b390 = sequenceBuilder{id: 390, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b389 = sequenceBuilder{id: 389, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b389.items = []builder{&b826, &b14}
-	b390.items = []builder{&b826, &b14, &b389}
+	b389.items = []builder{&b833, &b14}
+	b390.items = []builder{&b833, &b14, &b389}
 	var b384 = sequenceBuilder{id: 384, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b383 = charBuilder{}
 	b384.items = []builder{&b383}
 	var b392 = sequenceBuilder{id: 392, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b391 = sequenceBuilder{id: 391, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b391.items = []builder{&b826, &b14}
-	b392.items = []builder{&b826, &b14, &b391}
-	b393.items = []builder{&b402, &b386, &b826, &b382, &b388, &b826, &b402, &b390, &b826, &b384, &b392, &b826, &b402}
+	b391.items = []builder{&b833, &b14}
+	b392.items = []builder{&b833, &b14, &b391}
+	b393.items = []builder{&b402, &b386, &b833, &b382, &b388, &b833, &b402, &b390, &b833, &b384, &b392, &b833, &b402}
 	var b401 = sequenceBuilder{id: 401, commit: 64, name: "chaining", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}, {0, -1}}}
 	var b394 = choiceBuilder{id: 394, commit: 66}
 	b394.options = []builder{&b273, &b333, &b380, &b393}
 	var b399 = sequenceBuilder{id: 399, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b396 = sequenceBuilder{id: 396, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b395 = sequenceBuilder{id: 395, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b395.items = []builder{&b826, &b14}
+	b395.items = []builder{&b833, &b14}
 	b396.items = []builder{&b14, &b395}
 	var b331 = sequenceBuilder{id: 331, commit: 74, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b329 = charBuilder{}
@@ -3016,15 +3029,15 @@ func Parse(r io.Reader) (*Node, error) {
 	b331.items = []builder{&b329, &b330}
 	var b398 = sequenceBuilder{id: 398, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b397 = sequenceBuilder{id: 397, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b397.items = []builder{&b826, &b14}
-	b398.items = []builder{&b826, &b14, &b397}
-	b399.items = []builder{&b396, &b826, &b331, &b398, &b826, &b394}
+	b397.items = []builder{&b833, &b14}
+	b398.items = []builder{&b833, &b14, &b397}
+	b399.items = []builder{&b396, &b833, &b331, &b398, &b833, &b394}
 	var b400 = sequenceBuilder{id: 400, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b400.items = []builder{&b826, &b399}
-	b401.items = []builder{&b394, &b826, &b399, &b400}
+	b400.items = []builder{&b833, &b399}
+	b401.items = []builder{&b394, &b833, &b399, &b400}
 	b402.options = []builder{&b273, &b333, &b380, &b393, &b401}
-	b186.items = []builder{&b185, &b826, &b402}
-	b187.items = []builder{&b183, &b826, &b186}
+	b186.items = []builder{&b185, &b833, &b402}
+	b187.items = []builder{&b183, &b833, &b186}
 	var b433 = sequenceBuilder{id: 433, commit: 64, name: "if", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {0, 1}}}
 	var b406 = sequenceBuilder{id: 406, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b405 = sequenceBuilder{id: 405, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -3034,17 +3047,17 @@ func Parse(r io.Reader) (*Node, error) {
 	b406.items = []builder{&b405, &b15}
 	var b428 = sequenceBuilder{id: 428, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b427 = sequenceBuilder{id: 427, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b427.items = []builder{&b826, &b14}
-	b428.items = []builder{&b826, &b14, &b427}
+	b427.items = []builder{&b833, &b14}
+	b428.items = []builder{&b833, &b14, &b427}
 	var b430 = sequenceBuilder{id: 430, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b429 = sequenceBuilder{id: 429, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b429.items = []builder{&b826, &b14}
-	b430.items = []builder{&b826, &b14, &b429}
+	b429.items = []builder{&b833, &b14}
+	b430.items = []builder{&b833, &b14, &b429}
 	var b432 = sequenceBuilder{id: 432, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b421 = sequenceBuilder{id: 421, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b414 = sequenceBuilder{id: 414, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b413 = sequenceBuilder{id: 413, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b413.items = []builder{&b826, &b14}
+	b413.items = []builder{&b833, &b14}
 	b414.items = []builder{&b14, &b413}
 	var b412 = sequenceBuilder{id: 412, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b411 = sequenceBuilder{id: 411, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -3056,31 +3069,31 @@ func Parse(r io.Reader) (*Node, error) {
 	b412.items = []builder{&b411, &b15}
 	var b416 = sequenceBuilder{id: 416, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b415 = sequenceBuilder{id: 415, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b415.items = []builder{&b826, &b14}
-	b416.items = []builder{&b826, &b14, &b415}
+	b415.items = []builder{&b833, &b14}
+	b416.items = []builder{&b833, &b14, &b415}
 	var b418 = sequenceBuilder{id: 418, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b417 = sequenceBuilder{id: 417, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b417.items = []builder{&b826, &b14}
-	b418.items = []builder{&b826, &b14, &b417}
+	b417.items = []builder{&b833, &b14}
+	b418.items = []builder{&b833, &b14, &b417}
 	var b420 = sequenceBuilder{id: 420, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b419 = sequenceBuilder{id: 419, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b419.items = []builder{&b826, &b14}
-	b420.items = []builder{&b826, &b14, &b419}
-	b421.items = []builder{&b414, &b826, &b412, &b416, &b826, &b406, &b418, &b826, &b402, &b420, &b826, &b192}
+	b419.items = []builder{&b833, &b14}
+	b420.items = []builder{&b833, &b14, &b419}
+	b421.items = []builder{&b414, &b833, &b412, &b416, &b833, &b406, &b418, &b833, &b402, &b420, &b833, &b192}
 	var b431 = sequenceBuilder{id: 431, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b431.items = []builder{&b826, &b421}
-	b432.items = []builder{&b826, &b421, &b431}
+	b431.items = []builder{&b833, &b421}
+	b432.items = []builder{&b833, &b421, &b431}
 	var b426 = sequenceBuilder{id: 426, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b423 = sequenceBuilder{id: 423, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
 	var b422 = sequenceBuilder{id: 422, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b422.items = []builder{&b826, &b14}
+	b422.items = []builder{&b833, &b14}
 	b423.items = []builder{&b14, &b422}
 	var b425 = sequenceBuilder{id: 425, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b424 = sequenceBuilder{id: 424, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b424.items = []builder{&b826, &b14}
-	b425.items = []builder{&b826, &b14, &b424}
-	b426.items = []builder{&b423, &b826, &b412, &b425, &b826, &b192}
-	b433.items = []builder{&b406, &b428, &b826, &b402, &b430, &b826, &b192, &b432, &b826, &b426}
+	b424.items = []builder{&b833, &b14}
+	b425.items = []builder{&b833, &b14, &b424}
+	b426.items = []builder{&b423, &b833, &b412, &b425, &b833, &b192}
+	b433.items = []builder{&b406, &b428, &b833, &b402, &b430, &b833, &b192, &b432, &b833, &b426}
 	var b492 = sequenceBuilder{id: 492, commit: 64, name: "switch", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b447 = sequenceBuilder{id: 447, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b446 = sequenceBuilder{id: 446, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -3094,12 +3107,12 @@ func Parse(r io.Reader) (*Node, error) {
 	b447.items = []builder{&b446, &b15}
 	var b489 = sequenceBuilder{id: 489, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b488 = sequenceBuilder{id: 488, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b488.items = []builder{&b826, &b14}
-	b489.items = []builder{&b826, &b14, &b488}
+	b488.items = []builder{&b833, &b14}
+	b489.items = []builder{&b833, &b14, &b488}
 	var b491 = sequenceBuilder{id: 491, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b490 = sequenceBuilder{id: 490, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b490.items = []builder{&b826, &b14}
-	b491.items = []builder{&b826, &b14, &b490}
+	b490.items = []builder{&b833, &b14}
+	b491.items = []builder{&b833, &b14, &b490}
 	var b479 = sequenceBuilder{id: 479, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b478 = charBuilder{}
 	b479.items = []builder{&b478}
@@ -3117,24 +3130,24 @@ func Parse(r io.Reader) (*Node, error) {
 	b439.items = []builder{&b438, &b15}
 	var b469 = sequenceBuilder{id: 469, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b468 = sequenceBuilder{id: 468, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b468.items = []builder{&b826, &b14}
-	b469.items = []builder{&b826, &b14, &b468}
+	b468.items = []builder{&b833, &b14}
+	b469.items = []builder{&b833, &b14, &b468}
 	var b471 = sequenceBuilder{id: 471, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b470 = sequenceBuilder{id: 470, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b470.items = []builder{&b826, &b14}
-	b471.items = []builder{&b826, &b14, &b470}
+	b470.items = []builder{&b833, &b14}
+	b471.items = []builder{&b833, &b14, &b470}
 	var b467 = sequenceBuilder{id: 467, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b466 = charBuilder{}
 	b467.items = []builder{&b466}
-	b472.items = []builder{&b439, &b469, &b826, &b402, &b471, &b826, &b467}
+	b472.items = []builder{&b439, &b469, &b833, &b402, &b471, &b833, &b467}
 	var b476 = sequenceBuilder{id: 476, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b474 = sequenceBuilder{id: 474, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b473 = charBuilder{}
 	b474.items = []builder{&b473}
 	var b475 = sequenceBuilder{id: 475, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b475.items = []builder{&b826, &b474}
-	b476.items = []builder{&b826, &b474, &b475}
-	b477.items = []builder{&b472, &b476, &b826, &b794}
+	b475.items = []builder{&b833, &b474}
+	b476.items = []builder{&b833, &b474, &b475}
+	b477.items = []builder{&b472, &b476, &b833, &b801}
 	var b465 = sequenceBuilder{id: 465, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {0, 1}}}
 	var b460 = sequenceBuilder{id: 460, commit: 64, name: "default", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b455 = sequenceBuilder{id: 455, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
@@ -3148,34 +3161,34 @@ func Parse(r io.Reader) (*Node, error) {
 	b455.items = []builder{&b448, &b449, &b450, &b451, &b452, &b453, &b454}
 	var b459 = sequenceBuilder{id: 459, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b458 = sequenceBuilder{id: 458, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b458.items = []builder{&b826, &b14}
-	b459.items = []builder{&b826, &b14, &b458}
+	b458.items = []builder{&b833, &b14}
+	b459.items = []builder{&b833, &b14, &b458}
 	var b457 = sequenceBuilder{id: 457, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b456 = charBuilder{}
 	b457.items = []builder{&b456}
-	b460.items = []builder{&b455, &b459, &b826, &b457}
+	b460.items = []builder{&b455, &b459, &b833, &b457}
 	var b464 = sequenceBuilder{id: 464, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b462 = sequenceBuilder{id: 462, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b461 = charBuilder{}
 	b462.items = []builder{&b461}
 	var b463 = sequenceBuilder{id: 463, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b463.items = []builder{&b826, &b462}
-	b464.items = []builder{&b826, &b462, &b463}
-	b465.items = []builder{&b460, &b464, &b826, &b794}
+	b463.items = []builder{&b833, &b462}
+	b464.items = []builder{&b833, &b462, &b463}
+	b465.items = []builder{&b460, &b464, &b833, &b801}
 	b480.options = []builder{&b477, &b465}
 	var b484 = sequenceBuilder{id: 484, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b482 = sequenceBuilder{id: 482, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
 	var b481 = choiceBuilder{id: 481, commit: 2}
-	b481.options = []builder{&b477, &b465, &b794}
-	b482.items = []builder{&b808, &b826, &b481}
+	b481.options = []builder{&b477, &b465, &b801}
+	b482.items = []builder{&b815, &b833, &b481}
 	var b483 = sequenceBuilder{id: 483, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b483.items = []builder{&b826, &b482}
-	b484.items = []builder{&b826, &b482, &b483}
+	b483.items = []builder{&b833, &b482}
+	b484.items = []builder{&b833, &b482, &b483}
 	b485.items = []builder{&b480, &b484}
 	var b487 = sequenceBuilder{id: 487, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b486 = charBuilder{}
 	b487.items = []builder{&b486}
-	b492.items = []builder{&b447, &b489, &b826, &b402, &b491, &b826, &b479, &b826, &b808, &b826, &b485, &b826, &b808, &b826, &b487}
+	b492.items = []builder{&b447, &b489, &b833, &b402, &b491, &b833, &b479, &b833, &b815, &b833, &b485, &b833, &b815, &b833, &b487}
 	var b551 = sequenceBuilder{id: 551, commit: 64, name: "select", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b538 = sequenceBuilder{id: 538, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b532 = charBuilder{}
@@ -3187,8 +3200,8 @@ func Parse(r io.Reader) (*Node, error) {
 	b538.items = []builder{&b532, &b533, &b534, &b535, &b536, &b537}
 	var b550 = sequenceBuilder{id: 550, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b549 = sequenceBuilder{id: 549, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b549.items = []builder{&b826, &b14}
-	b550.items = []builder{&b826, &b14, &b549}
+	b549.items = []builder{&b833, &b14}
+	b550.items = []builder{&b833, &b14, &b549}
 	var b540 = sequenceBuilder{id: 540, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b539 = charBuilder{}
 	b540.items = []builder{&b539}
@@ -3198,47 +3211,47 @@ func Parse(r io.Reader) (*Node, error) {
 	var b526 = sequenceBuilder{id: 526, commit: 64, name: "select-case", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b523 = sequenceBuilder{id: 523, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b522 = sequenceBuilder{id: 522, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b522.items = []builder{&b826, &b14}
-	b523.items = []builder{&b826, &b14, &b522}
+	b522.items = []builder{&b833, &b14}
+	b523.items = []builder{&b833, &b14, &b522}
 	var b519 = choiceBuilder{id: 519, commit: 66}
 	var b518 = sequenceBuilder{id: 518, commit: 64, name: "receive-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
 	var b517 = sequenceBuilder{id: 517, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b516 = sequenceBuilder{id: 516, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b516.items = []builder{&b826, &b14}
-	b517.items = []builder{&b826, &b14, &b516}
-	b518.items = []builder{&b104, &b517, &b826, &b515}
+	b516.items = []builder{&b833, &b14}
+	b517.items = []builder{&b833, &b14, &b516}
+	b518.items = []builder{&b104, &b517, &b833, &b515}
 	b519.options = []builder{&b512, &b515, &b518}
 	var b525 = sequenceBuilder{id: 525, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b524 = sequenceBuilder{id: 524, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b524.items = []builder{&b826, &b14}
-	b525.items = []builder{&b826, &b14, &b524}
+	b524.items = []builder{&b833, &b14}
+	b525.items = []builder{&b833, &b14, &b524}
 	var b521 = sequenceBuilder{id: 521, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b520 = charBuilder{}
 	b521.items = []builder{&b520}
-	b526.items = []builder{&b439, &b523, &b826, &b519, &b525, &b826, &b521}
+	b526.items = []builder{&b439, &b523, &b833, &b519, &b525, &b833, &b521}
 	var b530 = sequenceBuilder{id: 530, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b528 = sequenceBuilder{id: 528, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b527 = charBuilder{}
 	b528.items = []builder{&b527}
 	var b529 = sequenceBuilder{id: 529, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b529.items = []builder{&b826, &b528}
-	b530.items = []builder{&b826, &b528, &b529}
-	b531.items = []builder{&b526, &b530, &b826, &b794}
+	b529.items = []builder{&b833, &b528}
+	b530.items = []builder{&b833, &b528, &b529}
+	b531.items = []builder{&b526, &b530, &b833, &b801}
 	b541.options = []builder{&b531, &b465}
 	var b545 = sequenceBuilder{id: 545, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b543 = sequenceBuilder{id: 543, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
 	var b542 = choiceBuilder{id: 542, commit: 2}
-	b542.options = []builder{&b531, &b465, &b794}
-	b543.items = []builder{&b808, &b826, &b542}
+	b542.options = []builder{&b531, &b465, &b801}
+	b543.items = []builder{&b815, &b833, &b542}
 	var b544 = sequenceBuilder{id: 544, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b544.items = []builder{&b826, &b543}
-	b545.items = []builder{&b826, &b543, &b544}
+	b544.items = []builder{&b833, &b543}
+	b545.items = []builder{&b833, &b543, &b544}
 	b546.items = []builder{&b541, &b545}
 	var b548 = sequenceBuilder{id: 548, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b547 = charBuilder{}
 	b548.items = []builder{&b547}
-	b551.items = []builder{&b538, &b550, &b826, &b540, &b826, &b808, &b826, &b546, &b826, &b808, &b826, &b548}
-	var b602 = sequenceBuilder{id: 602, commit: 64, name: "loop", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	b551.items = []builder{&b538, &b550, &b833, &b540, &b833, &b815, &b833, &b546, &b833, &b815, &b833, &b548}
+	var b609 = sequenceBuilder{id: 609, commit: 64, name: "loop", ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
 	var b583 = sequenceBuilder{id: 583, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b582 = sequenceBuilder{id: 582, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b579 = charBuilder{}
@@ -3246,330 +3259,343 @@ func Parse(r io.Reader) (*Node, error) {
 	var b581 = charBuilder{}
 	b582.items = []builder{&b579, &b580, &b581}
 	b583.items = []builder{&b582, &b15}
-	var b601 = choiceBuilder{id: 601, commit: 2}
-	var b597 = sequenceBuilder{id: 597, commit: 2, ranges: [][]int{{0, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b594 = sequenceBuilder{id: 594, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var b593 = sequenceBuilder{id: 593, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b608 = choiceBuilder{id: 608, commit: 2}
+	var b604 = sequenceBuilder{id: 604, commit: 2, ranges: [][]int{{0, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b601 = sequenceBuilder{id: 601, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var b600 = sequenceBuilder{id: 600, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b599 = sequenceBuilder{id: 599, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b599.items = []builder{&b833, &b14}
+	b600.items = []builder{&b14, &b599}
+	var b598 = choiceBuilder{id: 598, commit: 66}
+	var b597 = choiceBuilder{id: 597, commit: 64, name: "range-over-expression"}
+	var b596 = sequenceBuilder{id: 596, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b590 = sequenceBuilder{id: 590, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b587 = sequenceBuilder{id: 587, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b586 = sequenceBuilder{id: 586, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b586.items = []builder{&b833, &b14}
+	b587.items = []builder{&b14, &b586}
+	var b585 = sequenceBuilder{id: 585, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b584 = charBuilder{}
+	b585.items = []builder{&b584}
+	var b589 = sequenceBuilder{id: 589, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b588 = sequenceBuilder{id: 588, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b588.items = []builder{&b833, &b14}
+	b589.items = []builder{&b833, &b14, &b588}
+	b590.items = []builder{&b587, &b833, &b585, &b589, &b833, &b104}
+	var b593 = sequenceBuilder{id: 593, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b592 = sequenceBuilder{id: 592, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b592.items = []builder{&b826, &b14}
-	b593.items = []builder{&b14, &b592}
-	var b591 = choiceBuilder{id: 591, commit: 66}
-	var b590 = choiceBuilder{id: 590, commit: 64, name: "range-over-expression"}
-	var b589 = sequenceBuilder{id: 589, commit: 2, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b586 = sequenceBuilder{id: 586, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b585 = sequenceBuilder{id: 585, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b585.items = []builder{&b826, &b14}
-	b586.items = []builder{&b826, &b14, &b585}
+	b592.items = []builder{&b833, &b14}
+	b593.items = []builder{&b833, &b14, &b592}
 	var b578 = sequenceBuilder{id: 578, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b577 = sequenceBuilder{id: 577, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b575 = charBuilder{}
 	var b576 = charBuilder{}
 	b577.items = []builder{&b575, &b576}
 	b578.items = []builder{&b577, &b15}
-	var b588 = sequenceBuilder{id: 588, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b587 = sequenceBuilder{id: 587, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b587.items = []builder{&b826, &b14}
-	b588.items = []builder{&b826, &b14, &b587}
-	var b584 = choiceBuilder{id: 584, commit: 2}
-	b584.options = []builder{&b402, &b227}
-	b589.items = []builder{&b104, &b586, &b826, &b578, &b588, &b826, &b584}
-	b590.options = []builder{&b589, &b227}
-	b591.options = []builder{&b402, &b590}
-	b594.items = []builder{&b593, &b826, &b591}
-	var b596 = sequenceBuilder{id: 596, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b595 = sequenceBuilder{id: 595, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b595.items = []builder{&b826, &b14}
-	b596.items = []builder{&b826, &b14, &b595}
-	b597.items = []builder{&b594, &b596, &b826, &b192}
-	var b600 = sequenceBuilder{id: 600, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var b599 = sequenceBuilder{id: 599, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var b598 = sequenceBuilder{id: 598, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b598.items = []builder{&b826, &b14}
-	b599.items = []builder{&b14, &b598}
-	b600.items = []builder{&b599, &b826, &b192}
-	b601.options = []builder{&b597, &b600}
-	b602.items = []builder{&b583, &b826, &b601}
-	var b740 = choiceBuilder{id: 740, commit: 66}
-	var b661 = sequenceBuilder{id: 661, commit: 64, name: "value-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b643 = sequenceBuilder{id: 643, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b642 = sequenceBuilder{id: 642, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b639 = charBuilder{}
-	var b640 = charBuilder{}
-	var b641 = charBuilder{}
-	b642.items = []builder{&b639, &b640, &b641}
-	b643.items = []builder{&b642, &b15}
-	var b660 = sequenceBuilder{id: 660, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b659 = sequenceBuilder{id: 659, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b659.items = []builder{&b826, &b14}
-	b660.items = []builder{&b826, &b14, &b659}
-	var b658 = choiceBuilder{id: 658, commit: 2}
-	var b652 = sequenceBuilder{id: 652, commit: 64, name: "value-capture", ranges: [][]int{{1, 1}}}
-	var b651 = sequenceBuilder{id: 651, commit: 66, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b648 = sequenceBuilder{id: 648, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var b647 = sequenceBuilder{id: 647, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var b646 = sequenceBuilder{id: 646, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b646.items = []builder{&b826, &b14}
-	b647.items = []builder{&b14, &b646}
-	var b645 = sequenceBuilder{id: 645, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b644 = charBuilder{}
-	b645.items = []builder{&b644}
-	b648.items = []builder{&b647, &b826, &b645}
-	var b650 = sequenceBuilder{id: 650, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b649 = sequenceBuilder{id: 649, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b649.items = []builder{&b826, &b14}
-	b650.items = []builder{&b826, &b14, &b649}
-	b651.items = []builder{&b104, &b826, &b648, &b650, &b826, &b402}
+	var b595 = sequenceBuilder{id: 595, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b594 = sequenceBuilder{id: 594, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b594.items = []builder{&b833, &b14}
+	b595.items = []builder{&b833, &b14, &b594}
+	var b591 = choiceBuilder{id: 591, commit: 2}
+	b591.options = []builder{&b402, &b227}
+	b596.items = []builder{&b104, &b833, &b590, &b593, &b833, &b578, &b595, &b833, &b591}
+	b597.options = []builder{&b596, &b227}
+	b598.options = []builder{&b402, &b597}
+	b601.items = []builder{&b600, &b833, &b598}
+	var b603 = sequenceBuilder{id: 603, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b602 = sequenceBuilder{id: 602, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b602.items = []builder{&b833, &b14}
+	b603.items = []builder{&b833, &b14, &b602}
+	b604.items = []builder{&b601, &b603, &b833, &b192}
+	var b607 = sequenceBuilder{id: 607, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var b606 = sequenceBuilder{id: 606, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b605 = sequenceBuilder{id: 605, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b605.items = []builder{&b833, &b14}
+	b606.items = []builder{&b14, &b605}
+	b607.items = []builder{&b606, &b833, &b192}
+	b608.options = []builder{&b604, &b607}
+	b609.items = []builder{&b583, &b833, &b608}
+	var b747 = choiceBuilder{id: 747, commit: 66}
+	var b668 = sequenceBuilder{id: 668, commit: 64, name: "value-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b650 = sequenceBuilder{id: 650, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b649 = sequenceBuilder{id: 649, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b646 = charBuilder{}
+	var b647 = charBuilder{}
+	var b648 = charBuilder{}
+	b649.items = []builder{&b646, &b647, &b648}
+	b650.items = []builder{&b649, &b15}
+	var b667 = sequenceBuilder{id: 667, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b666 = sequenceBuilder{id: 666, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b666.items = []builder{&b833, &b14}
+	b667.items = []builder{&b833, &b14, &b666}
+	var b665 = choiceBuilder{id: 665, commit: 2}
+	var b659 = sequenceBuilder{id: 659, commit: 64, name: "value-capture", ranges: [][]int{{1, 1}}}
+	var b658 = sequenceBuilder{id: 658, commit: 66, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b655 = sequenceBuilder{id: 655, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var b654 = sequenceBuilder{id: 654, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b653 = sequenceBuilder{id: 653, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b653.items = []builder{&b833, &b14}
+	b654.items = []builder{&b14, &b653}
+	var b652 = sequenceBuilder{id: 652, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b651 = charBuilder{}
 	b652.items = []builder{&b651}
-	var b657 = sequenceBuilder{id: 657, commit: 64, name: "mutable-capture", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b654 = sequenceBuilder{id: 654, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b653 = charBuilder{}
-	b654.items = []builder{&b653}
-	var b656 = sequenceBuilder{id: 656, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b655 = sequenceBuilder{id: 655, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b655.items = []builder{&b826, &b14}
-	b656.items = []builder{&b826, &b14, &b655}
-	b657.items = []builder{&b654, &b656, &b826, &b651}
-	b658.options = []builder{&b652, &b657}
-	b661.items = []builder{&b643, &b660, &b826, &b658}
-	var b678 = sequenceBuilder{id: 678, commit: 64, name: "value-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b677 = sequenceBuilder{id: 677, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b676 = sequenceBuilder{id: 676, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b676.items = []builder{&b826, &b14}
-	b677.items = []builder{&b826, &b14, &b676}
-	var b673 = sequenceBuilder{id: 673, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b672 = charBuilder{}
-	b673.items = []builder{&b672}
-	var b667 = sequenceBuilder{id: 667, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
-	var b662 = choiceBuilder{id: 662, commit: 2}
-	b662.options = []builder{&b652, &b657}
-	var b666 = sequenceBuilder{id: 666, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b664 = sequenceBuilder{id: 664, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	var b663 = choiceBuilder{id: 663, commit: 2}
-	b663.options = []builder{&b652, &b657}
-	b664.items = []builder{&b114, &b826, &b663}
-	var b665 = sequenceBuilder{id: 665, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b665.items = []builder{&b826, &b664}
-	b666.items = []builder{&b826, &b664, &b665}
-	b667.items = []builder{&b662, &b666}
-	var b675 = sequenceBuilder{id: 675, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b674 = charBuilder{}
-	b675.items = []builder{&b674}
-	b678.items = []builder{&b643, &b677, &b826, &b673, &b826, &b114, &b826, &b667, &b826, &b114, &b826, &b675}
-	var b689 = sequenceBuilder{id: 689, commit: 64, name: "mutable-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b686 = sequenceBuilder{id: 686, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b685 = sequenceBuilder{id: 685, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b685.items = []builder{&b826, &b14}
-	b686.items = []builder{&b826, &b14, &b685}
+	b655.items = []builder{&b654, &b833, &b652}
+	var b657 = sequenceBuilder{id: 657, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b656 = sequenceBuilder{id: 656, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b656.items = []builder{&b833, &b14}
+	b657.items = []builder{&b833, &b14, &b656}
+	b658.items = []builder{&b104, &b833, &b655, &b657, &b833, &b402}
+	b659.items = []builder{&b658}
+	var b664 = sequenceBuilder{id: 664, commit: 64, name: "mutable-capture", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b661 = sequenceBuilder{id: 661, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b660 = charBuilder{}
+	b661.items = []builder{&b660}
+	var b663 = sequenceBuilder{id: 663, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b662 = sequenceBuilder{id: 662, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b662.items = []builder{&b833, &b14}
+	b663.items = []builder{&b833, &b14, &b662}
+	b664.items = []builder{&b661, &b663, &b833, &b658}
+	b665.options = []builder{&b659, &b664}
+	b668.items = []builder{&b650, &b667, &b833, &b665}
+	var b685 = sequenceBuilder{id: 685, commit: 64, name: "value-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b684 = sequenceBuilder{id: 684, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b683 = sequenceBuilder{id: 683, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b683.items = []builder{&b833, &b14}
+	b684.items = []builder{&b833, &b14, &b683}
 	var b680 = sequenceBuilder{id: 680, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b679 = charBuilder{}
 	b680.items = []builder{&b679}
-	var b688 = sequenceBuilder{id: 688, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b687 = sequenceBuilder{id: 687, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b687.items = []builder{&b826, &b14}
-	b688.items = []builder{&b826, &b14, &b687}
+	var b674 = sequenceBuilder{id: 674, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
+	var b669 = choiceBuilder{id: 669, commit: 2}
+	b669.options = []builder{&b659, &b664}
+	var b673 = sequenceBuilder{id: 673, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b671 = sequenceBuilder{id: 671, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	var b670 = choiceBuilder{id: 670, commit: 2}
+	b670.options = []builder{&b659, &b664}
+	b671.items = []builder{&b114, &b833, &b670}
+	var b672 = sequenceBuilder{id: 672, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b672.items = []builder{&b833, &b671}
+	b673.items = []builder{&b833, &b671, &b672}
+	b674.items = []builder{&b669, &b673}
 	var b682 = sequenceBuilder{id: 682, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b681 = charBuilder{}
 	b682.items = []builder{&b681}
-	var b671 = sequenceBuilder{id: 671, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
-	var b670 = sequenceBuilder{id: 670, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b668 = sequenceBuilder{id: 668, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	b668.items = []builder{&b114, &b826, &b652}
-	var b669 = sequenceBuilder{id: 669, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b669.items = []builder{&b826, &b668}
-	b670.items = []builder{&b826, &b668, &b669}
-	b671.items = []builder{&b652, &b670}
-	var b684 = sequenceBuilder{id: 684, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b683 = charBuilder{}
-	b684.items = []builder{&b683}
-	b689.items = []builder{&b643, &b686, &b826, &b680, &b688, &b826, &b682, &b826, &b114, &b826, &b671, &b826, &b114, &b826, &b684}
-	var b705 = sequenceBuilder{id: 705, commit: 64, name: "function-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b701 = sequenceBuilder{id: 701, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b699 = charBuilder{}
-	var b700 = charBuilder{}
-	b701.items = []builder{&b699, &b700}
+	b685.items = []builder{&b650, &b684, &b833, &b680, &b833, &b114, &b833, &b674, &b833, &b114, &b833, &b682}
+	var b696 = sequenceBuilder{id: 696, commit: 64, name: "mutable-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b693 = sequenceBuilder{id: 693, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b692 = sequenceBuilder{id: 692, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b692.items = []builder{&b833, &b14}
+	b693.items = []builder{&b833, &b14, &b692}
+	var b687 = sequenceBuilder{id: 687, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b686 = charBuilder{}
+	b687.items = []builder{&b686}
+	var b695 = sequenceBuilder{id: 695, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b694 = sequenceBuilder{id: 694, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b694.items = []builder{&b833, &b14}
+	b695.items = []builder{&b833, &b14, &b694}
+	var b689 = sequenceBuilder{id: 689, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b688 = charBuilder{}
+	b689.items = []builder{&b688}
+	var b678 = sequenceBuilder{id: 678, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
+	var b677 = sequenceBuilder{id: 677, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b675 = sequenceBuilder{id: 675, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	b675.items = []builder{&b114, &b833, &b659}
+	var b676 = sequenceBuilder{id: 676, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b676.items = []builder{&b833, &b675}
+	b677.items = []builder{&b833, &b675, &b676}
+	b678.items = []builder{&b659, &b677}
+	var b691 = sequenceBuilder{id: 691, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b690 = charBuilder{}
+	b691.items = []builder{&b690}
+	b696.items = []builder{&b650, &b693, &b833, &b687, &b695, &b833, &b689, &b833, &b114, &b833, &b678, &b833, &b114, &b833, &b691}
+	var b712 = sequenceBuilder{id: 712, commit: 64, name: "function-definition", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b708 = sequenceBuilder{id: 708, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b706 = charBuilder{}
+	var b707 = charBuilder{}
+	b708.items = []builder{&b706, &b707}
+	var b711 = sequenceBuilder{id: 711, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b710 = sequenceBuilder{id: 710, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b710.items = []builder{&b833, &b14}
+	b711.items = []builder{&b833, &b14, &b710}
+	var b709 = choiceBuilder{id: 709, commit: 2}
+	var b700 = sequenceBuilder{id: 700, commit: 64, name: "function-capture", ranges: [][]int{{1, 1}}}
+	var b699 = sequenceBuilder{id: 699, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b698 = sequenceBuilder{id: 698, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b697 = sequenceBuilder{id: 697, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b697.items = []builder{&b833, &b14}
+	b698.items = []builder{&b833, &b14, &b697}
+	b699.items = []builder{&b104, &b698, &b833, &b202}
+	b700.items = []builder{&b699}
+	var b705 = sequenceBuilder{id: 705, commit: 64, name: "effect-capture", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b702 = sequenceBuilder{id: 702, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b701 = charBuilder{}
+	b702.items = []builder{&b701}
 	var b704 = sequenceBuilder{id: 704, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b703 = sequenceBuilder{id: 703, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b703.items = []builder{&b826, &b14}
-	b704.items = []builder{&b826, &b14, &b703}
-	var b702 = choiceBuilder{id: 702, commit: 2}
-	var b693 = sequenceBuilder{id: 693, commit: 64, name: "function-capture", ranges: [][]int{{1, 1}}}
-	var b692 = sequenceBuilder{id: 692, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b691 = sequenceBuilder{id: 691, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b690 = sequenceBuilder{id: 690, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b690.items = []builder{&b826, &b14}
-	b691.items = []builder{&b826, &b14, &b690}
-	b692.items = []builder{&b104, &b691, &b826, &b202}
-	b693.items = []builder{&b692}
-	var b698 = sequenceBuilder{id: 698, commit: 64, name: "effect-capture", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b695 = sequenceBuilder{id: 695, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b694 = charBuilder{}
-	b695.items = []builder{&b694}
-	var b697 = sequenceBuilder{id: 697, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b696 = sequenceBuilder{id: 696, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b696.items = []builder{&b826, &b14}
-	b697.items = []builder{&b826, &b14, &b696}
-	b698.items = []builder{&b695, &b697, &b826, &b692}
-	b702.options = []builder{&b693, &b698}
-	b705.items = []builder{&b701, &b704, &b826, &b702}
-	var b725 = sequenceBuilder{id: 725, commit: 64, name: "function-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b718 = sequenceBuilder{id: 718, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b716 = charBuilder{}
-	var b717 = charBuilder{}
-	b718.items = []builder{&b716, &b717}
-	var b724 = sequenceBuilder{id: 724, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b723 = sequenceBuilder{id: 723, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b723.items = []builder{&b826, &b14}
-	b724.items = []builder{&b826, &b14, &b723}
-	var b720 = sequenceBuilder{id: 720, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b719 = charBuilder{}
-	b720.items = []builder{&b719}
-	var b715 = sequenceBuilder{id: 715, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
-	var b710 = choiceBuilder{id: 710, commit: 2}
-	b710.options = []builder{&b693, &b698}
-	var b714 = sequenceBuilder{id: 714, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b712 = sequenceBuilder{id: 712, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	var b711 = choiceBuilder{id: 711, commit: 2}
-	b711.options = []builder{&b693, &b698}
-	b712.items = []builder{&b114, &b826, &b711}
-	var b713 = sequenceBuilder{id: 713, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b713.items = []builder{&b826, &b712}
-	b714.items = []builder{&b826, &b712, &b713}
-	b715.items = []builder{&b710, &b714}
-	var b722 = sequenceBuilder{id: 722, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b721 = charBuilder{}
-	b722.items = []builder{&b721}
-	b725.items = []builder{&b718, &b724, &b826, &b720, &b826, &b114, &b826, &b715, &b826, &b114, &b826, &b722}
-	var b739 = sequenceBuilder{id: 739, commit: 64, name: "effect-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b728 = sequenceBuilder{id: 728, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	b703.items = []builder{&b833, &b14}
+	b704.items = []builder{&b833, &b14, &b703}
+	b705.items = []builder{&b702, &b704, &b833, &b699}
+	b709.options = []builder{&b700, &b705}
+	b712.items = []builder{&b708, &b711, &b833, &b709}
+	var b732 = sequenceBuilder{id: 732, commit: 64, name: "function-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b725 = sequenceBuilder{id: 725, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b723 = charBuilder{}
+	var b724 = charBuilder{}
+	b725.items = []builder{&b723, &b724}
+	var b731 = sequenceBuilder{id: 731, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b730 = sequenceBuilder{id: 730, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b730.items = []builder{&b833, &b14}
+	b731.items = []builder{&b833, &b14, &b730}
+	var b727 = sequenceBuilder{id: 727, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b726 = charBuilder{}
-	var b727 = charBuilder{}
-	b728.items = []builder{&b726, &b727}
-	var b736 = sequenceBuilder{id: 736, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b735 = sequenceBuilder{id: 735, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b735.items = []builder{&b826, &b14}
-	b736.items = []builder{&b826, &b14, &b735}
-	var b730 = sequenceBuilder{id: 730, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b729 = charBuilder{}
-	b730.items = []builder{&b729}
-	var b738 = sequenceBuilder{id: 738, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b737 = sequenceBuilder{id: 737, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b737.items = []builder{&b826, &b14}
-	b738.items = []builder{&b826, &b14, &b737}
-	var b732 = sequenceBuilder{id: 732, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b731 = charBuilder{}
-	b732.items = []builder{&b731}
-	var b709 = sequenceBuilder{id: 709, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
-	var b708 = sequenceBuilder{id: 708, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b706 = sequenceBuilder{id: 706, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	b706.items = []builder{&b114, &b826, &b693}
-	var b707 = sequenceBuilder{id: 707, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b707.items = []builder{&b826, &b706}
-	b708.items = []builder{&b826, &b706, &b707}
-	b709.items = []builder{&b693, &b708}
-	var b734 = sequenceBuilder{id: 734, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	b727.items = []builder{&b726}
+	var b722 = sequenceBuilder{id: 722, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
+	var b717 = choiceBuilder{id: 717, commit: 2}
+	b717.options = []builder{&b700, &b705}
+	var b721 = sequenceBuilder{id: 721, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b719 = sequenceBuilder{id: 719, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	var b718 = choiceBuilder{id: 718, commit: 2}
+	b718.options = []builder{&b700, &b705}
+	b719.items = []builder{&b114, &b833, &b718}
+	var b720 = sequenceBuilder{id: 720, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b720.items = []builder{&b833, &b719}
+	b721.items = []builder{&b833, &b719, &b720}
+	b722.items = []builder{&b717, &b721}
+	var b729 = sequenceBuilder{id: 729, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b728 = charBuilder{}
+	b729.items = []builder{&b728}
+	b732.items = []builder{&b725, &b731, &b833, &b727, &b833, &b114, &b833, &b722, &b833, &b114, &b833, &b729}
+	var b746 = sequenceBuilder{id: 746, commit: 64, name: "effect-definition-group", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b735 = sequenceBuilder{id: 735, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
 	var b733 = charBuilder{}
-	b734.items = []builder{&b733}
-	b739.items = []builder{&b728, &b736, &b826, &b730, &b738, &b826, &b732, &b826, &b114, &b826, &b709, &b826, &b114, &b826, &b734}
-	b740.options = []builder{&b661, &b678, &b689, &b705, &b725, &b739}
-	var b772 = choiceBuilder{id: 772, commit: 64, name: "use"}
-	var b764 = sequenceBuilder{id: 764, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b745 = sequenceBuilder{id: 745, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b744 = sequenceBuilder{id: 744, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b741 = charBuilder{}
-	var b742 = charBuilder{}
-	var b743 = charBuilder{}
-	b744.items = []builder{&b741, &b742, &b743}
-	b745.items = []builder{&b744, &b15}
-	var b763 = sequenceBuilder{id: 763, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b762 = sequenceBuilder{id: 762, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b762.items = []builder{&b826, &b14}
-	b763.items = []builder{&b826, &b14, &b762}
-	var b757 = choiceBuilder{id: 757, commit: 64, name: "use-fact"}
-	var b756 = sequenceBuilder{id: 756, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b748 = choiceBuilder{id: 748, commit: 2}
-	var b747 = sequenceBuilder{id: 747, commit: 72, name: "use-inline", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b746 = charBuilder{}
-	b747.items = []builder{&b746}
-	b748.options = []builder{&b104, &b747}
-	var b753 = sequenceBuilder{id: 753, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
-	var b752 = sequenceBuilder{id: 752, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
-	var b751 = sequenceBuilder{id: 751, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b751.items = []builder{&b826, &b14}
-	b752.items = []builder{&b14, &b751}
-	var b750 = sequenceBuilder{id: 750, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b734 = charBuilder{}
+	b735.items = []builder{&b733, &b734}
+	var b743 = sequenceBuilder{id: 743, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b742 = sequenceBuilder{id: 742, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b742.items = []builder{&b833, &b14}
+	b743.items = []builder{&b833, &b14, &b742}
+	var b737 = sequenceBuilder{id: 737, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b736 = charBuilder{}
+	b737.items = []builder{&b736}
+	var b745 = sequenceBuilder{id: 745, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b744 = sequenceBuilder{id: 744, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b744.items = []builder{&b833, &b14}
+	b745.items = []builder{&b833, &b14, &b744}
+	var b739 = sequenceBuilder{id: 739, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b738 = charBuilder{}
+	b739.items = []builder{&b738}
+	var b716 = sequenceBuilder{id: 716, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
+	var b715 = sequenceBuilder{id: 715, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b713 = sequenceBuilder{id: 713, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	b713.items = []builder{&b114, &b833, &b700}
+	var b714 = sequenceBuilder{id: 714, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b714.items = []builder{&b833, &b713}
+	b715.items = []builder{&b833, &b713, &b714}
+	b716.items = []builder{&b700, &b715}
+	var b741 = sequenceBuilder{id: 741, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b740 = charBuilder{}
+	b741.items = []builder{&b740}
+	b746.items = []builder{&b735, &b743, &b833, &b737, &b745, &b833, &b739, &b833, &b114, &b833, &b716, &b833, &b114, &b833, &b741}
+	b747.options = []builder{&b668, &b685, &b696, &b712, &b732, &b746}
+	var b779 = choiceBuilder{id: 779, commit: 64, name: "use"}
+	var b771 = sequenceBuilder{id: 771, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b752 = sequenceBuilder{id: 752, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b751 = sequenceBuilder{id: 751, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b748 = charBuilder{}
 	var b749 = charBuilder{}
-	b750.items = []builder{&b749}
-	b753.items = []builder{&b752, &b826, &b750}
-	var b755 = sequenceBuilder{id: 755, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b754 = sequenceBuilder{id: 754, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b754.items = []builder{&b826, &b14}
-	b755.items = []builder{&b826, &b14, &b754}
-	b756.items = []builder{&b748, &b826, &b753, &b755, &b826, &b87}
-	b757.options = []builder{&b87, &b756}
-	b764.items = []builder{&b745, &b763, &b826, &b757}
-	var b771 = sequenceBuilder{id: 771, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b750 = charBuilder{}
+	b751.items = []builder{&b748, &b749, &b750}
+	b752.items = []builder{&b751, &b15}
 	var b770 = sequenceBuilder{id: 770, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
 	var b769 = sequenceBuilder{id: 769, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b769.items = []builder{&b826, &b14}
-	b770.items = []builder{&b826, &b14, &b769}
-	var b766 = sequenceBuilder{id: 766, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b765 = charBuilder{}
-	b766.items = []builder{&b765}
-	var b761 = sequenceBuilder{id: 761, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
-	var b760 = sequenceBuilder{id: 760, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b758 = sequenceBuilder{id: 758, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	b758.items = []builder{&b114, &b826, &b757}
-	var b759 = sequenceBuilder{id: 759, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b759.items = []builder{&b826, &b758}
-	b760.items = []builder{&b826, &b758, &b759}
-	b761.items = []builder{&b757, &b760}
-	var b768 = sequenceBuilder{id: 768, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b767 = charBuilder{}
-	b768.items = []builder{&b767}
-	b771.items = []builder{&b745, &b770, &b826, &b766, &b826, &b114, &b826, &b761, &b826, &b114, &b826, &b768}
-	b772.options = []builder{&b764, &b771}
-	var b783 = sequenceBuilder{id: 783, commit: 64, name: "export", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b780 = sequenceBuilder{id: 780, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b779 = sequenceBuilder{id: 779, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
-	var b773 = charBuilder{}
+	b769.items = []builder{&b833, &b14}
+	b770.items = []builder{&b833, &b14, &b769}
+	var b764 = choiceBuilder{id: 764, commit: 64, name: "use-fact"}
+	var b763 = sequenceBuilder{id: 763, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {0, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b755 = choiceBuilder{id: 755, commit: 2}
+	var b754 = sequenceBuilder{id: 754, commit: 72, name: "use-inline", allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b753 = charBuilder{}
+	b754.items = []builder{&b753}
+	b755.options = []builder{&b104, &b754}
+	var b760 = sequenceBuilder{id: 760, commit: 2, ranges: [][]int{{0, 1}, {0, -1}, {1, 1}}}
+	var b759 = sequenceBuilder{id: 759, commit: 2, ranges: [][]int{{1, 1}, {0, -1}}}
+	var b758 = sequenceBuilder{id: 758, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b758.items = []builder{&b833, &b14}
+	b759.items = []builder{&b14, &b758}
+	var b757 = sequenceBuilder{id: 757, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b756 = charBuilder{}
+	b757.items = []builder{&b756}
+	b760.items = []builder{&b759, &b833, &b757}
+	var b762 = sequenceBuilder{id: 762, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b761 = sequenceBuilder{id: 761, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b761.items = []builder{&b833, &b14}
+	b762.items = []builder{&b833, &b14, &b761}
+	b763.items = []builder{&b755, &b833, &b760, &b762, &b833, &b87}
+	b764.options = []builder{&b87, &b763}
+	b771.items = []builder{&b752, &b770, &b833, &b764}
+	var b778 = sequenceBuilder{id: 778, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b777 = sequenceBuilder{id: 777, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b776 = sequenceBuilder{id: 776, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b776.items = []builder{&b833, &b14}
+	b777.items = []builder{&b833, &b14, &b776}
+	var b773 = sequenceBuilder{id: 773, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b772 = charBuilder{}
+	b773.items = []builder{&b772}
+	var b768 = sequenceBuilder{id: 768, commit: 66, ranges: [][]int{{1, 1}, {0, 1}}}
+	var b767 = sequenceBuilder{id: 767, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b765 = sequenceBuilder{id: 765, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	b765.items = []builder{&b114, &b833, &b764}
+	var b766 = sequenceBuilder{id: 766, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b766.items = []builder{&b833, &b765}
+	b767.items = []builder{&b833, &b765, &b766}
+	b768.items = []builder{&b764, &b767}
+	var b775 = sequenceBuilder{id: 775, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
 	var b774 = charBuilder{}
-	var b775 = charBuilder{}
-	var b776 = charBuilder{}
-	var b777 = charBuilder{}
-	var b778 = charBuilder{}
-	b779.items = []builder{&b773, &b774, &b775, &b776, &b777, &b778}
-	b780.items = []builder{&b779, &b15}
-	var b782 = sequenceBuilder{id: 782, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b781 = sequenceBuilder{id: 781, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b781.items = []builder{&b826, &b14}
-	b782.items = []builder{&b826, &b14, &b781}
-	b783.items = []builder{&b780, &b782, &b826, &b740}
-	var b803 = sequenceBuilder{id: 803, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
-	var b796 = sequenceBuilder{id: 796, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b795 = charBuilder{}
-	b796.items = []builder{&b795}
-	var b800 = sequenceBuilder{id: 800, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b799 = sequenceBuilder{id: 799, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b799.items = []builder{&b826, &b14}
-	b800.items = []builder{&b826, &b14, &b799}
-	var b802 = sequenceBuilder{id: 802, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b801 = sequenceBuilder{id: 801, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b801.items = []builder{&b826, &b14}
-	b802.items = []builder{&b826, &b14, &b801}
-	var b798 = sequenceBuilder{id: 798, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
-	var b797 = charBuilder{}
-	b798.items = []builder{&b797}
-	b803.items = []builder{&b796, &b800, &b826, &b794, &b802, &b826, &b798}
-	b794.options = []builder{&b187, &b433, &b492, &b551, &b602, &b740, &b772, &b783, &b803, &b784}
-	var b811 = sequenceBuilder{id: 811, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
-	var b809 = sequenceBuilder{id: 809, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
-	b809.items = []builder{&b808, &b826, &b794}
-	var b810 = sequenceBuilder{id: 810, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
-	b810.items = []builder{&b826, &b809}
-	b811.items = []builder{&b826, &b809, &b810}
-	b812.items = []builder{&b794, &b811}
-	b827.items = []builder{&b823, &b826, &b808, &b826, &b812, &b826, &b808}
-	b828.items = []builder{&b826, &b827, &b826}
+	b775.items = []builder{&b774}
+	b778.items = []builder{&b752, &b777, &b833, &b773, &b833, &b114, &b833, &b768, &b833, &b114, &b833, &b775}
+	b779.options = []builder{&b771, &b778}
+	var b790 = sequenceBuilder{id: 790, commit: 64, name: "export", ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b787 = sequenceBuilder{id: 787, commit: 74, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b786 = sequenceBuilder{id: 786, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}, {1, 1}}}
+	var b780 = charBuilder{}
+	var b781 = charBuilder{}
+	var b782 = charBuilder{}
+	var b783 = charBuilder{}
+	var b784 = charBuilder{}
+	var b785 = charBuilder{}
+	b786.items = []builder{&b780, &b781, &b782, &b783, &b784, &b785}
+	b787.items = []builder{&b786, &b15}
+	var b789 = sequenceBuilder{id: 789, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b788 = sequenceBuilder{id: 788, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b788.items = []builder{&b833, &b14}
+	b789.items = []builder{&b833, &b14, &b788}
+	b790.items = []builder{&b787, &b789, &b833, &b747}
+	var b810 = sequenceBuilder{id: 810, commit: 66, ranges: [][]int{{1, 1}, {0, 1}, {0, -1}, {1, 1}, {0, 1}, {0, -1}, {1, 1}}}
+	var b803 = sequenceBuilder{id: 803, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b802 = charBuilder{}
+	b803.items = []builder{&b802}
+	var b807 = sequenceBuilder{id: 807, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b806 = sequenceBuilder{id: 806, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b806.items = []builder{&b833, &b14}
+	b807.items = []builder{&b833, &b14, &b806}
+	var b809 = sequenceBuilder{id: 809, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b808 = sequenceBuilder{id: 808, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b808.items = []builder{&b833, &b14}
+	b809.items = []builder{&b833, &b14, &b808}
+	var b805 = sequenceBuilder{id: 805, commit: 10, allChars: true, ranges: [][]int{{1, 1}, {1, 1}}}
+	var b804 = charBuilder{}
+	b805.items = []builder{&b804}
+	b810.items = []builder{&b803, &b807, &b833, &b801, &b809, &b833, &b805}
+	b801.options = []builder{&b187, &b433, &b492, &b551, &b609, &b747, &b779, &b790, &b810, &b791}
+	var b818 = sequenceBuilder{id: 818, commit: 2, ranges: [][]int{{0, -1}, {1, 1}, {0, -1}}}
+	var b816 = sequenceBuilder{id: 816, commit: 2, ranges: [][]int{{1, 1}, {0, -1}, {1, 1}}}
+	b816.items = []builder{&b815, &b833, &b801}
+	var b817 = sequenceBuilder{id: 817, commit: 2, ranges: [][]int{{0, -1}, {1, 1}}}
+	b817.items = []builder{&b833, &b816}
+	b818.items = []builder{&b833, &b816, &b817}
+	b819.items = []builder{&b801, &b818}
+	b834.items = []builder{&b830, &b833, &b815, &b833, &b819, &b833, &b815}
+	b835.items = []builder{&b833, &b834, &b833}
 
-	return parseInput(r, &p828, &b828)
+	return parseInput(r, &p835, &b835)
 }
@@ -0,0 +1,347 @@
+package mml
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// Stream is the value behind the standard input and output, the files and the pipes of the subprocesses. Reading
+// is buffered, so that the input can be read line by line.
+//
+// In mml, a stream is a function carrying the stream. Calling it follows the protocol of the earlier file
+// handles: an int argument reads at most that many bytes, a negative one reads everything, a string argument is
+// written, and close closes the stream.
+type Stream struct {
+	reader *bufio.Reader
+	writer io.Writer
+	closer io.Closer
+}
+
+func newStream(r io.Reader, w io.Writer, c io.Closer) *Function {
+	s := &Stream{writer: w, closer: c}
+	if r != nil {
+		s.reader = bufio.NewReader(r)
+	}
+
+	f := &Function{FixedArgs: 1, stream: s}
+	f.F = func(a []interface{}) interface{} {
+		switch at := a[0].(type) {
+		case int:
+			return s.read(at)
+		case string:
+			return s.write(at)
+		default:
+			if a[0] == Close {
+				return s.close()
+			}
+
+			return fmt.Errorf("stream: unsupported argument: %s", TypeName(a[0]))
+		}
+	}
+
+	return f
+}
+
+func streamArg(name string, v interface{}) (*Stream, error) {
+	if f, ok := v.(*Function); ok && f.stream != nil {
+		return f.stream, nil
+	}
+
+	return nil, fmt.Errorf("%s: not a stream: %s", name, TypeName(v))
+}
+
+func (s *Stream) readable() error {
+	if s.reader == nil {
+		return fmt.Errorf("read: stream is not readable")
+	}
+
+	return nil
+}
+
+// the line is returned without the line ending. At the end of the input, it returns io.EOF.
+func (s *Stream) readLine() interface{} {
+	if err := s.readable(); err != nil {
+		return err
+	}
+
+	line, err := s.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return err
+	}
+
+	line = strings.TrimSuffix(line, "\n")
+	return strings.TrimSuffix(line, "\r")
+}
+
+func (s *Stream) readAll() interface{} {
+	if err := s.readable(); err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	if _, err := io.Copy(&b, s.reader); err != nil {
+		return err
+	}
+
+	return b.String()
+}
+
+// at the end of the input, it returns io.EOF
+func (s *Stream) read(n int) interface{} {
+	if n < 0 {
+		return s.readAll()
+	}
+
+	if err := s.readable(); err != nil {
+		return err
+	}
+
+	b := make([]byte, n)
+	n, err := s.reader.Read(b)
+	if err != nil && (err != io.EOF || n == 0) {
+		return err
+	}
+
+	return string(b[:n])
+}
+
+func (s *Stream) write(data string) interface{} {
+	if s.writer == nil {
+		return fmt.Errorf("write: stream is not writable")
+	}
+
+	_, err := io.WriteString(s.writer, data)
+	return err
+}
+
+func (s *Stream) close() interface{} {
+	if s.closer == nil {
+		return nil
+	}
+
+	return s.closer.Close()
+}
+
+func openStream(name string, flag int) *Function {
+	return &Function{
+		F: func(a []interface{}) interface{} {
+			path, ok := a[0].(string)
+			if !ok {
+				return fmt.Errorf("%s: unsupported path: %s", name, TypeName(a[0]))
+			}
+
+			f, err := os.OpenFile(path, flag, 0666)
+			if err != nil {
+				return err
+			}
+
+			if flag == os.O_RDONLY {
+				return newStream(f, nil, f)
+			}
+
+			return newStream(nil, f, f)
+		},
+		FixedArgs: 1,
+	}
+}
+
+var (
+	Open       = openStream("open", os.O_RDONLY)
+	Create     = openStream("create", os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	OpenAppend = openStream("openAppend", os.O_WRONLY|os.O_CREATE|os.O_APPEND)
+)
+
+var (
+	Stdin  = newStream(os.Stdin, nil, nil)
+	Stdout = newStream(nil, os.Stdout, nil)
+	Stderr = newStream(nil, os.Stderr, nil)
+)
+
+// EOF is the error returned by the streams at the end of the input.
+var EOF = io.EOF
+
+var ReadLine = &Function{
+	F: func(a []interface{}) interface{} {
+		s, err := streamArg("readLine", a[0])
+		if err != nil {
+			return err
+		}
+
+		return s.readLine()
+	},
+	FixedArgs: 1,
+}
+
+var ReadAll = &Function{
+	F: func(a []interface{}) interface{} {
+		s, err := streamArg("readAll", a[0])
+		if err != nil {
+			return err
+		}
+
+		return s.readAll()
+	},
+	FixedArgs: 1,
+}
+
+var Read = &Function{
+	F: func(a []interface{}) interface{} {
+		n, ok := a[0].(int)
+		if !ok {
+			return fmt.Errorf("read: unsupported length: %s", TypeName(a[0]))
+		}
+
+		s, err := streamArg("read", a[1])
+		if err != nil {
+			return err
+		}
+
+		return s.read(n)
+	},
+	FixedArgs: 2,
+}
+
+var Write = &Function{
+	F: func(a []interface{}) interface{} {
+		s, err := streamArg("write", a[0])
+		if err != nil {
+			return err
+		}
+
+		data, ok := a[1].(string)
+		if !ok {
+			return fmt.Errorf("write: unsupported data: %s", TypeName(a[1]))
+		}
+
+		return s.write(data)
+	},
+	FixedArgs: 2,
+}
+
+// drained reads a pipe of a subprocess in the background into a buffer, so that the process is not blocked by
+// writing one of its outputs while the mml code is reading the other one.
+type drained struct {
+	mx   sync.Mutex
+	cond *sync.Cond
+	buf  bytes.Buffer
+	err  error
+	done chan struct{}
+}
+
+func drain(r io.Reader) *drained {
+	d := &drained{done: make(chan struct{})}
+	d.cond = sync.NewCond(&d.mx)
+	go func() {
+		defer close(d.done)
+		b := make([]byte, 32*1024)
+		for {
+			n, err := r.Read(b)
+			d.mx.Lock()
+			d.buf.Write(b[:n])
+			if err != nil {
+				d.err = err
+			}
+
+			d.cond.Broadcast()
+			d.mx.Unlock()
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return d
+}
+
+// at the end of the pipe, it returns io.EOF
+func (d *drained) Read(p []byte) (int, error) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+	for d.buf.Len() == 0 && d.err == nil {
+		d.cond.Wait()
+	}
+
+	if d.buf.Len() > 0 {
+		return d.buf.Read(p)
+	}
+
+	return 0, d.err
+}
+
+func closePipes(c []io.Closer) {
+	for _, ci := range c {
+		ci.Close()
+	}
+}
+
+// Exec starts a subprocess from a list of strings, the command and its arguments. It returns a structure with
+// the stdin, stdout and stderr streams of the process, and a wait function that waits for the process to exit,
+// and returns an error when it fails. The stdout and the stderr of the process are read concurrently, and
+// buffered until the mml code reads them.
+var Exec = &Function{
+	F: func(a []interface{}) interface{} {
+		l, ok := a[0].(*List)
+		if !ok || l.Len() == 0 {
+			return fmt.Errorf("exec: expected a list of the command and its arguments")
+		}
+
+		var args []string
+		for _, ai := range l.Items() {
+			s, ok := ai.(string)
+			if !ok {
+				return fmt.Errorf("exec: unsupported argument: %s", TypeName(ai))
+			}
+
+			args = append(args, s)
+		}
+
+		cmd := exec.Command(args[0], args[1:]...)
+		var pipes []io.Closer
+		stdin, err := cmd.StdinPipe()
+		if err != nil {
+			return err
+		}
+
+		pipes = append(pipes, stdin)
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			closePipes(pipes)
+			return err
+		}
+
+		pipes = append(pipes, stdout)
+		stderr, err := cmd.StderrPipe()
+		if err != nil {
+			closePipes(pipes)
+			return err
+		}
+
+		pipes = append(pipes, stderr)
+		if err := cmd.Start(); err != nil {
+			closePipes(pipes)
+			return err
+		}
+
+		dout, derr := drain(stdout), drain(stderr)
+		return NewStruct(nil).
+			With("stdin", newStream(nil, stdin, stdin)).
+			With("stdout", newStream(dout, nil, stdout)).
+			With("stderr", newStream(derr, nil, stderr)).
+			With("wait", &Function{
+				F: func([]interface{}) interface{} {
+					// the pipes need to be read to the end before calling Wait, because Wait closes
+					// them
+					<-dout.done
+					<-derr.done
+					return cmd.Wait()
+				},
+			})
+	},
+	FixedArgs: 1,
+}
@@ -0,0 +1,94 @@
+package mml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func field(s *Struct, key string) interface{} {
+	v, _ := s.Get(key)
+	return v
+}
+
+func TestExecDrainsConcurrently(t *testing.T) {
+	// the process fills the stderr pipe before writing to stdout
+	p := Exec.Call([]interface{}{NewList(
+		"sh", "-c", "head -c 1000000 /dev/zero >&2; echo done",
+	)})
+
+	s, ok := p.(*Struct)
+	if !ok {
+		t.Fatalf("failed to start: %v", p)
+	}
+
+	result := make(chan interface{})
+	go func() {
+		out := ReadAll.Call([]interface{}{field(s, "stdout")})
+		serr := ReadAll.Call([]interface{}{field(s, "stderr")})
+		if len(serr.(string)) != 1000000 {
+			result <- serr
+			return
+		}
+
+		if err := field(s, "wait").(*Function).Call(nil); err != nil {
+			result <- err
+			return
+		}
+
+		result <- out
+	}()
+
+	select {
+	case r := <-result:
+		if r != "done\n" {
+			t.Fatalf("unexpected result: %v", r)
+		}
+	case <-time.After(9 * time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+func TestExecStartFails(t *testing.T) {
+	err, ok := Exec.Call([]interface{}{NewList("/no/such/command")}).(error)
+	if !ok || !strings.Contains(err.Error(), "no such file") {
+		t.Fatalf("unexpected result: %v", err)
+	}
+}
+
+type failingReader struct{ data string }
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, errors.New("read failed")
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestIterateStream(t *testing.T) {
+	var lines []interface{}
+	for it := Iterate(Pos{}, newStream(strings.NewReader("foo\nbar\r\nbaz"), nil, nil)); it.Next(); {
+		lines = append(lines, it.Value())
+	}
+
+	checkItems(t, NewList(lines...), "foo", "bar", "baz")
+
+	p := Pos{Path: "foo.mml", Line: 3, Column: 9}
+	it := Iterate(p, newStream(&failingReader{data: "foo\n"}, nil, nil))
+	if !it.Next() || it.Value() != "foo" {
+		t.Fatalf("unexpected first line: %v", it.Value())
+	}
+
+	defer func() {
+		if err, ok := recover().(*RuntimeError); !ok || err.Error() != "foo.mml:3:9: range over stream: read failed" {
+			t.Fatalf("unexpected panic: %v", err)
+		}
+	}()
+
+	it.Next()
+	t.Fatal("failed to fail")
+}
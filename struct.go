@@ -0,0 +1,273 @@
+package mml
+
+import (
+	"maps"
+	"math/bits"
+	"sort"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+
+	// below this depth, the hash is used up, and the nodes store the colliding keys in a list
+	hamtMaxShift = 32
+)
+
+type hamtEntry struct {
+	key   string
+	value interface{}
+	node  *hamtNode
+}
+
+// the entries of a node are either key-value pairs or child nodes, ordered by their bit in the bitmap
+type hamtNode struct {
+	bitmap  uint32
+	entries []hamtEntry
+}
+
+// hamt is a persistent hash array mapped trie. Getting and setting a key takes O(log32 n) steps, and the
+// unchanged nodes are shared between the versions.
+type hamt struct {
+	root  *hamtNode
+	count int
+}
+
+// Struct is an immutable mapping from string keys to values, backed by a persistent hash trie. Creating a
+// new version of it with a changed key takes O(log n) steps. The keys are kept in the order of their first
+// insertion, stored in a persistent vector.
+type Struct struct {
+	// Values contains the keys and the values of the structure.
+	//
+	// Deprecated: use Get, Keys and Map. Values is kept in sync with the structure, and it must not be changed.
+	// Keeping it in sync makes With and Merge copy it, taking O(n) steps.
+	Values map[string]interface{}
+
+	values hamt
+	keys   *vector
+}
+
+var emptyHamtNode = &hamtNode{}
+
+// FNV-1a
+func hashKey(key string) uint32 {
+	h := uint32(2166136261)
+	for i := 0; i < len(key); i++ {
+		h ^= uint32(key[i])
+		h *= 16777619
+	}
+
+	return h
+}
+
+func (n *hamtNode) get(shift uint, hash uint32, key string) (interface{}, bool) {
+	for {
+		if shift >= hamtMaxShift {
+			for _, e := range n.entries {
+				if e.key == key {
+					return e.value, true
+				}
+			}
+
+			return nil, false
+		}
+
+		bit := uint32(1) << ((hash >> shift) & hamtMask)
+		if n.bitmap&bit == 0 {
+			return nil, false
+		}
+
+		e := n.entries[bits.OnesCount32(n.bitmap&(bit-1))]
+		if e.node == nil {
+			if e.key != key {
+				return nil, false
+			}
+
+			return e.value, true
+		}
+
+		n = e.node
+		shift += hamtBits
+	}
+}
+
+func (n *hamtNode) withEntry(i int, e hamtEntry) *hamtNode {
+	entries := make([]hamtEntry, len(n.entries))
+	copy(entries, n.entries)
+	entries[i] = e
+	return &hamtNode{bitmap: n.bitmap, entries: entries}
+}
+
+func (n *hamtNode) set(shift uint, hash uint32, key string, value interface{}) (*hamtNode, bool) {
+	if shift >= hamtMaxShift {
+		for i, e := range n.entries {
+			if e.key == key {
+				return n.withEntry(i, hamtEntry{key: key, value: value}), false
+			}
+		}
+
+		entries := make([]hamtEntry, len(n.entries), len(n.entries)+1)
+		copy(entries, n.entries)
+		return &hamtNode{entries: append(entries, hamtEntry{key: key, value: value})}, true
+	}
+
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+	i := bits.OnesCount32(n.bitmap & (bit - 1))
+	if n.bitmap&bit == 0 {
+		entries := make([]hamtEntry, len(n.entries)+1)
+		copy(entries, n.entries[:i])
+		entries[i] = hamtEntry{key: key, value: value}
+		copy(entries[i+1:], n.entries[i:])
+		return &hamtNode{bitmap: n.bitmap | bit, entries: entries}, true
+	}
+
+	e := n.entries[i]
+	switch {
+	case e.node != nil:
+		child, added := e.node.set(shift+hamtBits, hash, key, value)
+		return n.withEntry(i, hamtEntry{node: child}), added
+	case e.key == key:
+		return n.withEntry(i, hamtEntry{key: key, value: value}), false
+	default:
+		child, _ := emptyHamtNode.set(shift+hamtBits, hashKey(e.key), e.key, e.value)
+		child, _ = child.set(shift+hamtBits, hash, key, value)
+		return n.withEntry(i, hamtEntry{node: child}), true
+	}
+}
+
+func (h hamt) get(key string) (interface{}, bool) {
+	if h.root == nil {
+		return nil, false
+	}
+
+	return h.root.get(0, hashKey(key), key)
+}
+
+func (h hamt) set(key string, value interface{}) (hamt, bool) {
+	root := h.root
+	if root == nil {
+		root = emptyHamtNode
+	}
+
+	root, added := root.set(0, hashKey(key), key, value)
+	if added {
+		return hamt{root: root, count: h.count + 1}, true
+	}
+
+	return hamt{root: root, count: h.count}, false
+}
+
+// NewStruct creates a structure from a Go map. Since Go maps are not ordered, the keys of the structure are
+// sorted.
+func NewStruct(values map[string]interface{}) *Struct {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	s := &Struct{}
+	for _, k := range keys {
+		s.Set(k, values[k])
+	}
+
+	return s
+}
+
+func (s *Struct) with(key string, value interface{}) (hamt, *vector) {
+	values, added := s.values.set(key, value)
+	if !added {
+		return values, s.keys
+	}
+
+	keys := s.keys
+	if keys == nil {
+		keys = emptyVector
+	}
+
+	return values, keys.push(key)
+}
+
+func (s *Struct) each(f func(string, interface{})) {
+	if s.keys == nil {
+		return
+	}
+
+	for i := 0; i < s.keys.count; i++ {
+		k := s.keys.get(i).(string)
+		v, _ := s.values.get(k)
+		f(k, v)
+	}
+}
+
+// Len returns the number of keys in the structure.
+func (s *Struct) Len() int {
+	return s.values.count
+}
+
+// Get returns the value stored with a key, and whether the key exists.
+func (s *Struct) Get(key string) (interface{}, bool) {
+	return s.values.get(key)
+}
+
+// With returns a new structure with the key set to the value. A new key is placed after the existing ones,
+// while an existing key keeps its position.
+func (s *Struct) With(key string, value interface{}) *Struct {
+	values, keys := s.with(key, value)
+	m := copyValues(s.Values)
+	m[key] = value
+	return &Struct{Values: m, values: values, keys: keys}
+}
+
+// Set stores the value with the key in place. It is used only with mutable structures.
+func (s *Struct) Set(key string, value interface{}) {
+	s.values, s.keys = s.with(key, value)
+	if s.Values == nil {
+		s.Values = make(map[string]interface{})
+	}
+
+	s.Values[key] = value
+}
+
+// every structure has its own map, because the mutable ones change it in place
+func copyValues(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return make(map[string]interface{})
+	}
+
+	return maps.Clone(values)
+}
+
+// Merge returns a new structure containing the keys of both structures. When a key exists in both, the value of
+// the argument structure is used, but the order of the keys in the current structure is kept.
+func (s *Struct) Merge(m *Struct) *Struct {
+	if s.Len() == 0 {
+		return &Struct{Values: copyValues(m.Values), values: m.values, keys: m.keys}
+	}
+
+	merged := &Struct{Values: copyValues(s.Values), values: s.values, keys: s.keys}
+	m.each(merged.Set)
+	return merged
+}
+
+// Keys returns the keys of the structure in the order of their insertion.
+func (s *Struct) Keys() []string {
+	keys := make([]string, 0, s.Len())
+	s.each(func(k string, _ interface{}) {
+		keys = append(keys, k)
+	})
+
+	return keys
+}
+
+// Map returns the keys and values of the structure as a Go map. Changing the returned map doesn't change the
+// structure.
+func (s *Struct) Map() map[string]interface{} {
+	values := make(map[string]interface{}, s.Len())
+	s.each(func(k string, v interface{}) {
+		values[k] = v
+	})
+
+	return values
+}
@@ -0,0 +1,150 @@
+package mml
+
+import (
+	"strconv"
+	"testing"
+)
+
+// key pairs with the same FNV-1a hash
+var collisions = [][2]string{
+	{"key583084", "key1092000"},
+	{"key583085", "key1092001"},
+}
+
+func checkStruct(t *testing.T, s *Struct, keys []string, values map[string]interface{}) {
+	t.Helper()
+	if s.Len() != len(keys) {
+		t.Fatalf("invalid length: %d, expected: %d", s.Len(), len(keys))
+	}
+
+	k := s.Keys()
+	if len(k) != len(keys) {
+		t.Fatalf("invalid number of keys: %d, expected: %d", len(k), len(keys))
+	}
+
+	for i := range keys {
+		if k[i] != keys[i] {
+			t.Fatalf("invalid key at %d: %s, expected: %s", i, k[i], keys[i])
+		}
+
+		v, ok := s.Get(keys[i])
+		if !ok || v != values[keys[i]] {
+			t.Fatalf("invalid value of %s: %v, expected: %v", keys[i], v, values[keys[i]])
+		}
+	}
+
+	if v := s.Map(); len(v) != len(keys) {
+		t.Fatalf("invalid number of values: %d, expected: %d", len(v), len(keys))
+	}
+
+	if len(s.Values) != len(keys) {
+		t.Fatalf("invalid number of keys in the values field: %d, expected: %d", len(s.Values), len(keys))
+	}
+
+	for _, k := range keys {
+		if v, ok := s.Values[k]; !ok || v != values[k] {
+			t.Fatalf("invalid value of %s in the values field: %v, expected: %v", k, v, values[k])
+		}
+	}
+}
+
+func TestStructCollisionKeys(t *testing.T) {
+	for _, c := range collisions {
+		if hashKey(c[0]) != hashKey(c[1]) {
+			t.Fatalf("no collision: %s, %s", c[0], c[1])
+		}
+	}
+}
+
+func TestStructWithManyKeys(t *testing.T) {
+	const n = 1 << 16
+	var (
+		keys     []string
+		values   = make(map[string]interface{})
+		versions []*Struct
+	)
+
+	// With copies the deprecated Values map, so the structure is built in place, and the versions are taken
+	// with With
+	s := NewStruct(nil)
+	for i := 0; i < n; i++ {
+		k := "key" + strconv.Itoa(i)
+		keys = append(keys, k)
+		values[k] = i
+		if i == 0 || i == hamtMask || i == 1<<10 {
+			versions = append(versions, s.With(k, i))
+		}
+
+		s.Set(k, i)
+	}
+
+	checkStruct(t, s, keys, values)
+	for _, v := range versions {
+		checkStruct(t, v, keys[:v.Len()], values)
+	}
+
+	if _, ok := s.Get("key" + strconv.Itoa(n)); ok {
+		t.Fatal("unexpected key found")
+	}
+}
+
+func TestStructSetExistingKey(t *testing.T) {
+	s := NewStruct(nil).With("foo", 1).With("bar", 2).With("baz", 3)
+	updated := s.With("bar", 42)
+
+	checkStruct(t, s, []string{"foo", "bar", "baz"}, map[string]interface{}{"foo": 1, "bar": 2, "baz": 3})
+	checkStruct(t, updated, []string{"foo", "bar", "baz"}, map[string]interface{}{"foo": 1, "bar": 42, "baz": 3})
+
+	updated.Set("foo", 36)
+	updated.Set("qux", 4)
+	checkStruct(
+		t,
+		updated,
+		[]string{"foo", "bar", "baz", "qux"},
+		map[string]interface{}{"foo": 36, "bar": 42, "baz": 3, "qux": 4},
+	)
+
+	checkStruct(t, s, []string{"foo", "bar", "baz"}, map[string]interface{}{"foo": 1, "bar": 2, "baz": 3})
+}
+
+func TestStructHashCollision(t *testing.T) {
+	c := collisions[0]
+	s := NewStruct(nil).With(c[0], 1)
+	withCollision := s.With(c[1], 2)
+	updated := withCollision.With(c[0], 3)
+	other := updated.With(collisions[1][0], 4).With(collisions[1][1], 5)
+
+	checkStruct(t, s, []string{c[0]}, map[string]interface{}{c[0]: 1})
+	checkStruct(t, withCollision, []string{c[0], c[1]}, map[string]interface{}{c[0]: 1, c[1]: 2})
+	checkStruct(t, updated, []string{c[0], c[1]}, map[string]interface{}{c[0]: 3, c[1]: 2})
+	checkStruct(
+		t,
+		other,
+		[]string{c[0], c[1], collisions[1][0], collisions[1][1]},
+		map[string]interface{}{c[0]: 3, c[1]: 2, collisions[1][0]: 4, collisions[1][1]: 5},
+	)
+
+	if _, ok := withCollision.Get(collisions[1][0]); ok {
+		t.Fatal("unexpected key found")
+	}
+}
+
+func TestStructMerge(t *testing.T) {
+	left := NewStruct(nil).With("foo", 1).With("bar", 2)
+	right := NewStruct(nil).With("baz", 3).With("foo", 4)
+
+	checkStruct(
+		t,
+		left.Merge(right),
+		[]string{"foo", "bar", "baz"},
+		map[string]interface{}{"foo": 4, "bar": 2, "baz": 3},
+	)
+
+	checkStruct(t, NewStruct(nil).Merge(right), []string{"baz", "foo"}, map[string]interface{}{"baz": 3, "foo": 4})
+	checkStruct(t, left, []string{"foo", "bar"}, map[string]interface{}{"foo": 1, "bar": 2})
+}
+
+func TestNewStructSortsKeys(t *testing.T) {
+	values := map[string]interface{}{"c": 3, "a": 1, "b": 2}
+	checkStruct(t, NewStruct(values), []string{"a", "b", "c"}, values)
+}
@@ -0,0 +1,84 @@
+// Package time implements the Go side of the time module of the mml standard library. Durations and points in
+// time are represented in nanoseconds.
+package time
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aryszka/mml"
+)
+
+var start = time.Now()
+
+func duration(v interface{}) time.Duration {
+	return time.Duration(v.(int))
+}
+
+var Sleep = mml.NewGoFunction(mml.Signature(mml.NilType, mml.IntType), func(a, _ []interface{}) interface{} {
+	time.Sleep(duration(a[0]))
+	return nil
+})
+
+// the channel is buffered, so that the timer can fire even if nobody receives from it
+var After = mml.NewGoFunction(mml.Signature(mml.ChannelType, mml.IntType), func(a, _ []interface{}) interface{} {
+	c := make(chan interface{}, 1)
+	time.AfterFunc(duration(a[0]), func() {
+		c <- int(time.Now().UnixNano())
+	})
+
+	return &mml.Channel{C: c}
+})
+
+// like in Go, the ticks are dropped when the receiver is slow. The interval needs to be positive, and the
+// position of the invalid use is set by mml.Interop.
+var Ticker = mml.NewGoFunction(mml.Signature(mml.StructType, mml.IntType), func(a, _ []interface{}) interface{} {
+	d := duration(a[0])
+	if d <= 0 {
+		panic(&mml.RuntimeError{Message: fmt.Sprintf("ticker: the interval must be positive, got %d", a[0])})
+	}
+
+	var (
+		t    = time.NewTicker(d)
+		c    = make(chan interface{}, 1)
+		stop = make(chan struct{})
+	)
+
+	go func() {
+		for {
+			select {
+			case now := <-t.C:
+				select {
+				case c <- int(now.UnixNano()):
+				default:
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return mml.NewStruct(map[string]interface{}{
+		"channel": &mml.Channel{C: c},
+		"stop": &mml.Function{
+			F: func([]interface{}) interface{} {
+				once.Do(func() {
+					t.Stop()
+					close(stop)
+				})
+
+				return nil
+			},
+		},
+	})
+})
+
+var Now = mml.NewGoFunction(mml.Signature(mml.IntType), func([]interface{}, []interface{}) interface{} {
+	return int(time.Now().UnixNano())
+})
+
+var Monotonic = mml.NewGoFunction(mml.Signature(mml.IntType), func([]interface{}, []interface{}) interface{} {
+	return int(time.Since(start))
+})